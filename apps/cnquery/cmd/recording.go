@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"go.mondoo.com/cnquery/cli/theme"
+	"go.mondoo.com/cnquery/providers"
+)
+
+func init() {
+	recordingDiffCmd.Flags().BoolP("json", "j", false, "Print the differences in a JSON structure.")
+	recordingCmd.AddCommand(recordingDiffCmd)
+
+	recordingRedactCmd.Flags().StringSlice("rule", nil, "Add a redaction rule: resource.field or resource.field:regex. Supports * as wildcard.")
+	recordingRedactCmd.Flags().Bool("no-default-rules", false, "Don't apply the default redaction rules.")
+	recordingRedactCmd.Flags().Bool("compact", false, "Drop resources that were never created or referenced and merge duplicate assets.")
+	recordingRedactCmd.Flags().Bool("pretty", false, "Pretty-print JSON.")
+	recordingCmd.AddCommand(recordingRedactCmd)
+
+	rootCmd.AddCommand(recordingCmd)
+}
+
+var recordingCmd = &cobra.Command{
+	Use:   "recording",
+	Short: "Work with recordings of assets.",
+}
+
+var recordingDiffCmd = &cobra.Command{
+	Use:   "diff OLD NEW",
+	Short: "Show the differences between two recordings.",
+	Long: `
+This command compares two recordings. Assets are matched by their platform IDs
+and resources by their name and ID. It shows all resource fields that were
+added, removed or changed:
+
+		$ cnquery recording diff before.json after.json
+
+`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		diff, err := providers.DiffRecordingFiles(args[0], args[1])
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to compare recordings")
+		}
+
+		if ok, _ := cmd.Flags().GetBool("json"); ok {
+			raw, err := json.Marshal(diff)
+			if err != nil {
+				log.Fatal().Err(err).Msg("failed to marshal differences")
+			}
+			fmt.Println(string(raw))
+			return
+		}
+
+		printRecordingDiff(diff)
+	},
+}
+
+var recordingRedactCmd = &cobra.Command{
+	Use:   "redact SOURCE DESTINATION",
+	Short: "Scrub secrets from a recording so it can be shared.",
+	Long: `
+This command stores a copy of a recording with secrets removed. By default it
+redacts file contents, command output, environment variables, private keys and
+anything that looks like a password or token. Redacted fields are listed with
+their resource in the new recording:
+
+		$ cnquery recording redact recording.json shared.json --compact
+
+Add your own rules for resource fields, optionally with a regex that limits
+what is replaced:
+
+		$ cnquery recording redact recording.json shared.json --rule "user.name" --rule "*.*:10\.0\.\d+\.\d+"
+
+`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		rawRules, _ := cmd.Flags().GetStringSlice("rule")
+		noDefaults, _ := cmd.Flags().GetBool("no-default-rules")
+		compact, _ := cmd.Flags().GetBool("compact")
+		pretty, _ := cmd.Flags().GetBool("pretty")
+
+		var rules []providers.RedactRule
+		if !noDefaults {
+			rules = append(rules, providers.DefaultRedactRules...)
+		}
+		for i := range rawRules {
+			rule, err := providers.ParseRedactRule(rawRules[i])
+			if err != nil {
+				log.Fatal().Err(err).Msg("failed to parse redaction rule")
+			}
+			rules = append(rules, rule)
+		}
+
+		err := providers.SaveRecordingFile(args[0], args[1], providers.RecordingOptions{
+			PrettyPrintJSON: pretty,
+			RedactRules:     rules,
+			Compact:         compact,
+		})
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to redact recording")
+		}
+	},
+}
+
+func printRecordingDiff(diff *providers.RecordingDiff) {
+	if diff.IsEmpty() {
+		log.Info().Msg("no differences found")
+		return
+	}
+
+	out := strings.Builder{}
+	for _, asset := range diff.Assets {
+		name := asset.Name
+		if name == "" {
+			name = asset.ID
+		}
+		out.WriteString(diffPrefix(asset.Status) + theme.DefaultTheme.Primary("asset "+name) + "\n")
+
+		for _, resource := range asset.Resources {
+			id := resource.Resource
+			if resource.ID != "" {
+				id += " id = " + resource.ID
+			}
+			out.WriteString("  " + diffPrefix(resource.Status) + theme.DefaultTheme.Secondary(id) + "\n")
+
+			for _, field := range resource.Fields {
+				switch field.Status {
+				case providers.DiffAdded:
+					out.WriteString("    " + diffPrefix(field.Status) + field.Field + ": " + field.New.String() + "\n")
+				case providers.DiffRemoved:
+					out.WriteString("    " + diffPrefix(field.Status) + field.Field + ": " + field.Old.String() + "\n")
+				default:
+					out.WriteString("    " + diffPrefix(field.Status) + field.Field + ": " +
+						field.Old.String() + " => " + field.New.String() + "\n")
+				}
+			}
+		}
+	}
+
+	fmt.Fprint(os.Stdout, out.String())
+}
+
+func diffPrefix(status providers.DiffStatus) string {
+	switch status {
+	case providers.DiffAdded:
+		return theme.DefaultTheme.Success("+ ")
+	case providers.DiffRemoved:
+		return theme.DefaultTheme.Error("- ")
+	default:
+		return "~ "
+	}
+}
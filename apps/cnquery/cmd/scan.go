@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"os/signal"
 	"sort"
+	"syscall"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/rs/zerolog/log"
@@ -45,6 +48,9 @@ func init() {
 	scanCmd.Flags().String("asset-name", "", "User-override for the asset name")
 	scanCmd.Flags().StringToString("annotation", nil, "Add an annotation to the asset.") // user-added, editable
 	scanCmd.Flags().StringToString("props", nil, "Custom values for properties")
+	scanCmd.Flags().Int("parallel", 1, "Set the number of assets that are scanned in parallel.")
+	scanCmd.Flags().Duration("timeout", 0, "Set the time providers have to compute a single value, e.g. 30s. No limit by default.")
+	scanCmd.Flags().Bool("exit-on-error", false, "Exit with a non-zero code if any asset could not be scanned.")
 
 	// v6 should make detect-cicd and category flag public
 	scanCmd.Flags().Bool("detect-cicd", true, "Try to detect CI/CD environments. If detected, set the asset category to 'cicd'.")
@@ -93,6 +99,9 @@ To manually configure a query pack, use this:
 		viper.BindPFlag("record", cmd.Flags().Lookup("record"))
 
 		viper.BindPFlag("output", cmd.Flags().Lookup("output"))
+		viper.BindPFlag("parallel", cmd.Flags().Lookup("parallel"))
+		viper.BindPFlag("timeout", cmd.Flags().Lookup("timeout"))
+		viper.BindPFlag("exit-on-error", cmd.Flags().Lookup("exit-on-error"))
 	},
 	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		if len(args) == 0 {
@@ -119,6 +128,10 @@ var scanCmdRun = func(cmd *cobra.Command, runtime *providers.Runtime, cliRes *pl
 	}
 
 	printReports(report, conf, cmd)
+
+	if conf.ExitOnError && len(report.Errors) != 0 {
+		os.Exit(1)
+	}
 }
 
 // helper method to retrieve the list of query packs for autocomplete
@@ -139,6 +152,9 @@ type scanConfig struct {
 	QueryPackNames []string
 	Props          map[string]string
 	Bundle         *explorer.Bundle
+	Parallel       int
+	FieldTimeout   time.Duration
+	ExitOnError    bool
 	runtime        *providers.Runtime
 
 	IsIncognito bool
@@ -168,6 +184,9 @@ func getCobraScanConfig(cmd *cobra.Command, runtime *providers.Runtime, cliRes *
 		QueryPackPaths: viper.GetStringSlice("querypack-bundle"),
 		QueryPackNames: viper.GetStringSlice("querypacks"),
 		Props:          props,
+		Parallel:       viper.GetInt("parallel"),
+		FieldTimeout:   viper.GetDuration("timeout"),
+		ExitOnError:    viper.GetBool("exit-on-error"),
 		runtime:        runtime,
 	}
 
@@ -268,8 +287,17 @@ func RunScan(config *scanConfig) (*explorer.ReportCollection, error) {
 		opts = append(opts, scan.WithRecording(config.runtime.Recording))
 	}
 
+	if config.Parallel > 1 {
+		opts = append(opts, scan.WithParallel(config.Parallel))
+	}
+	if config.FieldTimeout > 0 {
+		opts = append(opts, scan.WithFieldTimeout(config.FieldTimeout))
+	}
+
 	scanner := scan.NewLocalScanner(opts...)
-	ctx := cnquery.SetFeatures(context.Background(), config.Features)
+	// stop all running requests when the scan is interrupted
+	ctx, stop := signal.NotifyContext(cnquery.SetFeatures(context.Background(), config.Features), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
 	if config.IsIncognito {
 		return scanner.RunIncognito(
@@ -24,6 +24,9 @@ func init() {
 	vaultCmd.AddCommand(vaultResetCmd)
 
 	vaultCmd.AddCommand(vaultAddSecretCmd)
+	vaultCmd.AddCommand(vaultGetSecretCmd)
+	vaultCmd.AddCommand(vaultListSecretsCmd)
+	vaultCmd.AddCommand(vaultDeleteSecretCmd)
 
 	rootCmd.AddCommand(vaultCmd)
 }
@@ -89,6 +92,10 @@ var vaultConfigureCmd = &cobra.Command{
 
 cnquery vault set mondoo-client-vault --type linux-kernel-keyring
 
+To store secrets in a portable OpenPGP encrypted file, e.g. on systems without a keyring:
+
+cnquery vault set mondoo-file-vault --type encrypted-file --option file=/etc/mondoo/secrets.asc --option password=<password>
+
 `,
 	Args: cobra.ExactArgs(1),
 	PreRun: func(cmd *cobra.Command, args []string) {
@@ -242,3 +249,65 @@ var vaultAddSecretCmd = &cobra.Command{
 		log.Info().Msg("stored secret successfully")
 	},
 }
+
+var vaultGetSecretCmd = &cobra.Command{
+	Use:   "get-secret VAULTNAME SECRETID",
+	Short: "Print a secret stored in a vault.",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		selectedVault, err := config.GetConfiguredVault(args[0])
+		if err != nil {
+			log.Fatal().Err(err).Str("vault", args[0]).Msg("could not open vault")
+		}
+
+		secret, err := selectedVault.Get(context.Background(), &vault.SecretID{
+			Key: args[1],
+		})
+		if err != nil {
+			log.Fatal().Err(err).Str("secret", args[1]).Msg("could not retrieve secret")
+		}
+
+		fmt.Println(string(secret.Data))
+	},
+}
+
+var vaultListSecretsCmd = &cobra.Command{
+	Use:   "list-secrets VAULTNAME",
+	Short: "List the ids of all secrets stored in a vault.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		selectedVault, err := config.GetConfiguredVault(args[0])
+		if err != nil {
+			log.Fatal().Err(err).Str("vault", args[0]).Msg("could not open vault")
+		}
+
+		ids, err := vault.ListSecrets(context.Background(), selectedVault)
+		if err != nil {
+			log.Fatal().Err(err).Str("vault", args[0]).Msg("could not list secrets")
+		}
+
+		for i := range ids {
+			fmt.Println(ids[i].Key)
+		}
+	},
+}
+
+var vaultDeleteSecretCmd = &cobra.Command{
+	Use:   "delete-secret VAULTNAME SECRETID",
+	Short: "Delete a secret from a vault.",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		selectedVault, err := config.GetConfiguredVault(args[0])
+		if err != nil {
+			log.Fatal().Err(err).Str("vault", args[0]).Msg("could not open vault")
+		}
+
+		err = vault.DeleteSecret(context.Background(), selectedVault, &vault.SecretID{
+			Key: args[1],
+		})
+		if err != nil {
+			log.Fatal().Err(err).Str("secret", args[1]).Msg("could not delete secret")
+		}
+		log.Info().Msg("deleted secret successfully")
+	},
+}
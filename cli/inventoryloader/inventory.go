@@ -107,6 +107,14 @@ func Parse() (*inventory.Inventory, error) {
 		res.Metadata.Labels = map[string]string{}
 	}
 	res.Metadata.Labels[inventory.InventoryFilePath] = inventoryFilePath
+
+	// expand templates first, so that defaults and host ranges result into
+	// individual assets with their own credentials
+	err = res.ExpandTemplates()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not expand inventory templates")
+	}
+
 	err = res.PreProcess()
 	if err != nil {
 		return nil, err
@@ -177,6 +177,16 @@ func setConnector(provider *plugin.Provider, connector *plugin.Connector, run fu
 			Type: plugin.FlagType_String,
 			Desc: "Use a recording to inject resouces data (read-only)",
 		},
+		{
+			Long: "redact-recording",
+			Type: plugin.FlagType_Bool,
+			Desc: "Scrub secrets from the recording before it is stored",
+		},
+		{
+			Long: "compact-recording",
+			Type: plugin.FlagType_Bool,
+			Desc: "Drop unused resources and merge duplicate assets in the recording",
+		},
 		{
 			Long:   "pretty",
 			Type:   plugin.FlagType_Bool,
@@ -242,13 +252,23 @@ func setConnector(provider *plugin.Provider, connector *plugin.Connector, run fu
 		if err != nil {
 			log.Warn().Msg("failed to get flag --pretty")
 		}
+		redact, err := cc.Flags().GetBool("redact-recording")
+		if err != nil {
+			log.Warn().Msg("failed to get flag --redact-recording")
+		}
+		compact, err := cc.Flags().GetBool("compact-recording")
+		if err != nil {
+			log.Warn().Msg("failed to get flag --compact-recording")
+		}
 
 		// the following flags are not processed by the provider; we handle them
 		// here instead
 		skipFlags := map[string]struct{}{
-			"ask-pass":      {},
-			"record":        {},
-			"use-recording": {},
+			"ask-pass":          {},
+			"record":            {},
+			"use-recording":     {},
+			"redact-recording":  {},
+			"compact-recording": {},
 		}
 
 		flagVals := map[string]*llx.Primitive{}
@@ -305,10 +325,15 @@ func setConnector(provider *plugin.Provider, connector *plugin.Connector, run fu
 			recordingPath = useRecording
 		}
 
-		runtime.Recording, err = providers.NewRecording(recordingPath, providers.RecordingOptions{
+		recordingOpts := providers.RecordingOptions{
 			DoRecord:        record != "",
 			PrettyPrintJSON: pretty,
-		})
+			Compact:         compact,
+		}
+		if redact {
+			recordingOpts.RedactRules = providers.DefaultRedactRules
+		}
+		runtime.Recording, err = providers.NewRecording(recordingPath, recordingOpts)
 		if err != nil {
 			log.Fatal().Msg(err.Error())
 		}
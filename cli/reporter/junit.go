@@ -0,0 +1,178 @@
+package reporter
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"sort"
+
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/shared"
+)
+
+type junitTestsuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []junitTestsuite `xml:"testsuite"`
+}
+
+type junitTestsuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Errors     int             `xml:"errors,attr"`
+	Skipped    int             `xml:"skipped,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	Testcases  []junitTestcase `xml:"testcase"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestcase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// ReportCollectionToJUnit writes the given report collection as JUnit XML.
+// Every asset is a testsuite and every query of a query pack is a testcase.
+// Queries fail if any of their results has an error.
+func ReportCollectionToJUnit(data *explorer.ReportCollection, out shared.OutputHelper) error {
+	res := junitTestsuites{Name: "cnquery"}
+
+	for _, assetMrn := range sortedAssetMrns(data) {
+		asset := data.Assets[assetMrn]
+		suite := junitTestsuite{
+			Name: asset.Name,
+			Properties: []junitProperty{
+				{Name: "asset.mrn", Value: asset.Mrn},
+			},
+		}
+		if suite.Name == "" {
+			suite.Name = assetMrn
+		}
+
+		if errStatus, ok := data.Errors[assetMrn]; ok {
+			suite.Testcases = append(suite.Testcases, junitTestcase{
+				Name:      "scan",
+				Classname: suite.Name,
+				Error:     &junitMessage{Message: errStatus.Message},
+			})
+			suite.Errors++
+		}
+
+		report := data.Reports[assetMrn]
+		resolved := data.Resolved[assetMrn]
+		if report != nil && data.Bundle != nil {
+			results := report.RawResults()
+			for _, pack := range data.Bundle.Packs {
+				for _, query := range packQueries(pack) {
+					testcase := junitTestcase{
+						Name:      queryTitle(query),
+						Classname: pack.Name,
+					}
+
+					var executionQuery *explorer.ExecutionQuery
+					if resolved != nil && resolved.ExecutionJob != nil {
+						executionQuery = resolved.ExecutionJob.Queries[query.CodeId]
+					}
+					if executionQuery == nil {
+						testcase.Skipped = &junitMessage{Message: "query was not executed on this asset"}
+						suite.Skipped++
+						suite.Testcases = append(suite.Testcases, testcase)
+						continue
+					}
+
+					buf := bytes.Buffer{}
+					if err := BundleResultsToJSON(executionQuery.Code, results, &shared.IOWriter{Writer: &buf}); err != nil {
+						return err
+					}
+					testcase.SystemOut = buf.String()
+
+					if err := queryResultsError(executionQuery.Code, results); err != nil {
+						testcase.Failure = &junitMessage{Message: err.Error(), Text: query.Mql}
+						suite.Failures++
+					}
+					suite.Testcases = append(suite.Testcases, testcase)
+				}
+			}
+		}
+
+		suite.Tests = len(suite.Testcases)
+		res.Tests += suite.Tests
+		res.Failures += suite.Failures
+		res.Errors += suite.Errors
+		res.Skipped += suite.Skipped
+		res.Suites = append(res.Suites, suite)
+	}
+
+	raw, err := xml.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return err
+	}
+	out.WriteString(xml.Header)
+	out.Write(raw)
+	out.WriteString("\n")
+	return nil
+}
+
+// sortedAssetMrns returns the MRNs of all assets in the report in a stable order
+func sortedAssetMrns(data *explorer.ReportCollection) []string {
+	res := make([]string, 0, len(data.Assets))
+	for mrn := range data.Assets {
+		res = append(res, mrn)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// packQueries returns all queries of a query pack, including those in groups
+func packQueries(pack *explorer.QueryPack) []*explorer.Mquery {
+	res := make([]*explorer.Mquery, 0, len(pack.Queries))
+	res = append(res, pack.Queries...)
+	for i := range pack.Groups {
+		res = append(res, pack.Groups[i].Queries...)
+	}
+	return res
+}
+
+func queryTitle(query *explorer.Mquery) string {
+	if query.Title != "" {
+		return query.Title
+	}
+	if query.Mrn != "" {
+		return query.Mrn
+	}
+	return query.CodeId
+}
+
+// queryResultsError returns the first error of all entrypoints of a query
+func queryResultsError(code *llx.CodeBundle, results map[string]*llx.RawResult) error {
+	for _, ref := range code.CodeV2.Entrypoints() {
+		checksum := code.CodeV2.Checksums[ref]
+		result := results[checksum]
+		if result == nil {
+			return errors.New("cannot find result for this query")
+		}
+		if result.Data != nil && result.Data.Error != nil {
+			return result.Data.Error
+		}
+	}
+	return nil
+}
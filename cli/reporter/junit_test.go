@@ -0,0 +1,58 @@
+package reporter
+
+import (
+	"bytes"
+	"encoding/xml"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/shared"
+	"sigs.k8s.io/yaml"
+)
+
+func loadKubernetesReport(t *testing.T) *explorer.ReportCollection {
+	data, err := os.ReadFile("testdata/kubernetes_report.yaml")
+	require.NoError(t, err)
+
+	var report *explorer.ReportCollection
+	err = yaml.Unmarshal(data, &report)
+	require.NoError(t, err)
+	return report
+}
+
+func TestJUnitExport(t *testing.T) {
+	report := loadKubernetesReport(t)
+
+	buf := bytes.Buffer{}
+	err := ReportCollectionToJUnit(report, &shared.IOWriter{Writer: &buf})
+	require.NoError(t, err)
+
+	var res junitTestsuites
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &res))
+	require.Len(t, res.Suites, len(report.Assets))
+	assert.Equal(t, len(report.Errors), res.Errors)
+
+	var cluster *junitTestsuite
+	for i := range res.Suites {
+		if res.Suites[i].Name == "K8s Cluster minikube" {
+			cluster = &res.Suites[i]
+		}
+	}
+	require.NotNil(t, cluster)
+	assert.Equal(t, 0, cluster.Failures)
+	assert.Equal(t, cluster.Tests, len(cluster.Testcases))
+
+	var version *junitTestcase
+	for i := range cluster.Testcases {
+		if cluster.Testcases[i].Name == "Gather Kubernetes Cluster Version" {
+			version = &cluster.Testcases[i]
+		}
+	}
+	require.NotNil(t, version)
+	assert.Equal(t, "Kubernetes Cluster Incident Response Pack by Mondoo", version.Classname)
+	assert.Nil(t, version.Skipped)
+	assert.Contains(t, version.SystemOut, "k8s.serverVersion")
+}
@@ -26,6 +26,7 @@ const (
 	JSON
 	JUnit
 	CSV
+	SARIF
 )
 
 // Formats that are supported by the reporter
@@ -38,6 +39,8 @@ var Formats = map[string]Format{
 	"yml":     YAML,
 	"json":    JSON,
 	"csv":     CSV,
+	"junit":   JUnit,
+	"sarif":   SARIF,
 }
 
 func AllFormats() string {
@@ -109,6 +112,12 @@ func (r *Reporter) Print(data *explorer.ReportCollection, out io.Writer) error {
 	case CSV:
 		w := shared.IOWriter{Writer: out}
 		return ReportCollectionToCSV(data, &w)
+	case JUnit:
+		w := shared.IOWriter{Writer: out}
+		return ReportCollectionToJUnit(data, &w)
+	case SARIF:
+		w := shared.IOWriter{Writer: out}
+		return ReportCollectionToSARIF(data, &w)
 	case YAML:
 		raw := bytes.Buffer{}
 		writer := shared.IOWriter{Writer: &raw}
@@ -0,0 +1,266 @@
+package reporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"go.mondoo.com/cnquery"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/shared"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool        sarifTool         `json:"tool"`
+	Invocations []sarifInvocation `json:"invocations"`
+	Results     []sarifResult     `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri,omitempty"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string                 `json:"id"`
+	Name                 string                 `json:"name,omitempty"`
+	ShortDescription     *sarifMessage          `json:"shortDescription,omitempty"`
+	FullDescription      *sarifMessage          `json:"fullDescription,omitempty"`
+	Help                 *sarifMessage          `json:"help,omitempty"`
+	HelpURI              string                 `json:"helpUri,omitempty"`
+	DefaultConfiguration sarifConfiguration     `json:"defaultConfiguration"`
+	Properties           map[string]interface{} `json:"properties,omitempty"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifInvocation struct {
+	ExecutionSuccessful        bool                `json:"executionSuccessful"`
+	ToolExecutionNotifications []sarifNotification `json:"toolExecutionNotifications,omitempty"`
+}
+
+type sarifNotification struct {
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Kind      string          `json:"kind"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifLogicalLocation struct {
+	Name               string `json:"name"`
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+// ReportCollectionToSARIF writes the given report collection as a SARIF log.
+// Every query of a query pack is a rule, which carries the query's docs and
+// impact. Every query that ran on an asset is a result for that asset.
+// Assets that couldn't be scanned are reported as tool notifications.
+func ReportCollectionToSARIF(data *explorer.ReportCollection, out shared.OutputHelper) error {
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           "cnquery",
+			Version:        cnquery.GetVersion(),
+			InformationURI: "https://github.com/mondoohq/cnquery",
+			Rules:          []sarifRule{},
+		}},
+		Invocations: []sarifInvocation{{ExecutionSuccessful: len(data.Errors) == 0}},
+		Results:     []sarifResult{},
+	}
+
+	type ruleRef struct {
+		idx   int
+		query *explorer.Mquery
+	}
+	rules := []ruleRef{}
+	ruleIdx := map[string]int{}
+	if data.Bundle != nil {
+		for _, pack := range data.Bundle.Packs {
+			for _, query := range packQueries(pack) {
+				rule := sarifQueryRule(query)
+				if _, ok := ruleIdx[rule.ID]; ok {
+					continue
+				}
+				ruleIdx[rule.ID] = len(run.Tool.Driver.Rules)
+				rules = append(rules, ruleRef{idx: len(run.Tool.Driver.Rules), query: query})
+				run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, rule)
+			}
+		}
+	}
+
+	for _, assetMrn := range sortedAssetMrns(data) {
+		asset := data.Assets[assetMrn]
+		location := sarifAssetLocation(asset)
+
+		if errStatus, ok := data.Errors[assetMrn]; ok {
+			run.Invocations[0].ToolExecutionNotifications = append(run.Invocations[0].ToolExecutionNotifications, sarifNotification{
+				Level:     "error",
+				Message:   sarifMessage{Text: errStatus.Message},
+				Locations: []sarifLocation{location},
+			})
+		}
+
+		report := data.Reports[assetMrn]
+		resolved := data.Resolved[assetMrn]
+		if report == nil || resolved == nil || resolved.ExecutionJob == nil {
+			continue
+		}
+
+		results := report.RawResults()
+		for _, rule := range rules {
+			executionQuery := resolved.ExecutionJob.Queries[rule.query.CodeId]
+			if executionQuery == nil {
+				continue
+			}
+
+			result := sarifResult{
+				RuleID:    run.Tool.Driver.Rules[rule.idx].ID,
+				RuleIndex: rule.idx,
+				Locations: []sarifLocation{location},
+			}
+
+			if err := queryResultsError(executionQuery.Code, results); err != nil {
+				result.Kind = "fail"
+				result.Level = sarifImpactLevel(rule.query.Impact)
+				result.Message = sarifMessage{Text: err.Error()}
+			} else {
+				buf := bytes.Buffer{}
+				if err := BundleResultsToJSON(executionQuery.Code, results, &shared.IOWriter{Writer: &buf}); err != nil {
+					return err
+				}
+				result.Kind = "informational"
+				result.Level = "none"
+				result.Message = sarifMessage{Text: buf.String()}
+			}
+			run.Results = append(run.Results, result)
+		}
+	}
+
+	raw, err := json.MarshalIndent(sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []sarifRun{run},
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	out.Write(raw)
+	out.WriteString("\n")
+	return nil
+}
+
+func sarifQueryRule(query *explorer.Mquery) sarifRule {
+	rule := sarifRule{
+		ID:                   query.Mrn,
+		Name:                 query.Title,
+		DefaultConfiguration: sarifConfiguration{Level: sarifImpactLevel(query.Impact)},
+	}
+	if rule.ID == "" {
+		rule.ID = query.CodeId
+	}
+	if query.Title != "" {
+		rule.ShortDescription = &sarifMessage{Text: query.Title}
+	}
+
+	desc := query.Desc
+	refs := query.Refs
+	var help []string
+	if query.Docs != nil {
+		if query.Docs.Desc != "" {
+			desc = query.Docs.Desc
+		}
+		if query.Docs.Audit != "" {
+			help = append(help, query.Docs.Audit)
+		}
+		if query.Docs.Remediation != nil {
+			for _, item := range query.Docs.Remediation.Items {
+				help = append(help, item.Desc)
+			}
+		}
+		if len(query.Docs.Refs) != 0 {
+			refs = query.Docs.Refs
+		}
+	}
+	if desc != "" {
+		rule.FullDescription = &sarifMessage{Text: desc}
+	}
+	if len(help) != 0 {
+		rule.Help = &sarifMessage{Text: strings.Join(help, "\n\n")}
+	}
+	if len(refs) != 0 {
+		rule.HelpURI = refs[0].Url
+	}
+
+	if query.Impact != nil && query.Impact.Value != nil {
+		impact := query.Impact.Value.Value
+		rule.Properties = map[string]interface{}{
+			"impact": impact,
+			// used by some dashboards to rank results, on a scale of 0 to 10
+			"security-severity": strconv.FormatFloat(float64(impact)/10, 'f', 1, 64),
+		}
+	}
+	return rule
+}
+
+// sarifImpactLevel maps the impact of a query to a SARIF level
+func sarifImpactLevel(impact *explorer.Impact) string {
+	if impact == nil || impact.Value == nil {
+		return "note"
+	}
+	switch v := impact.Value.Value; {
+	case v >= 70:
+		return "error"
+	case v >= 40:
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+func sarifAssetLocation(asset *explorer.Asset) sarifLocation {
+	name := asset.Name
+	if name == "" {
+		name = asset.Mrn
+	}
+	return sarifLocation{LogicalLocations: []sarifLogicalLocation{{
+		Name:               name,
+		FullyQualifiedName: asset.Mrn,
+		Kind:               "resource",
+	}}}
+}
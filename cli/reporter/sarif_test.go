@@ -0,0 +1,56 @@
+package reporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/shared"
+)
+
+func TestSARIFExport(t *testing.T) {
+	report := loadKubernetesReport(t)
+
+	buf := bytes.Buffer{}
+	err := ReportCollectionToSARIF(report, &shared.IOWriter{Writer: &buf})
+	require.NoError(t, err)
+
+	var res sarifLog
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &res))
+	assert.Equal(t, "2.1.0", res.Version)
+	require.Len(t, res.Runs, 1)
+
+	run := res.Runs[0]
+	assert.NotEmpty(t, run.Tool.Driver.Rules)
+	assert.NotEmpty(t, run.Results)
+	assert.False(t, run.Invocations[0].ExecutionSuccessful)
+	assert.Len(t, run.Invocations[0].ToolExecutionNotifications, len(report.Errors))
+
+	for _, result := range run.Results {
+		assert.Equal(t, run.Tool.Driver.Rules[result.RuleIndex].ID, result.RuleID)
+		require.Len(t, result.Locations, 1)
+	}
+}
+
+func TestSARIFQueryRule(t *testing.T) {
+	rule := sarifQueryRule(&explorer.Mquery{
+		Mrn:   "//local.cnquery.io/run/local-execution/queries/sshd",
+		Title: "Check SSH config",
+		Docs: &explorer.MqueryDocs{
+			Desc:  "SSH must be configured securely.",
+			Audit: "Run sshd -T",
+			Refs:  []*explorer.MqueryRef{{Title: "docs", Url: "https://example.com/sshd"}},
+		},
+		Impact: &explorer.Impact{Value: &explorer.ImpactValue{Value: 80}},
+	})
+
+	assert.Equal(t, "//local.cnquery.io/run/local-execution/queries/sshd", rule.ID)
+	assert.Equal(t, "SSH must be configured securely.", rule.FullDescription.Text)
+	assert.Equal(t, "Run sshd -T", rule.Help.Text)
+	assert.Equal(t, "https://example.com/sshd", rule.HelpURI)
+	assert.Equal(t, "error", rule.DefaultConfiguration.Level)
+	assert.Equal(t, "8.0", rule.Properties["security-severity"])
+}
@@ -0,0 +1,178 @@
+package scan
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery"
+	"go.mondoo.com/cnquery/providers"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/providers-sdk/v1/upstream"
+	"go.mondoo.com/cnquery/utils/multierr"
+)
+
+// assetWithError is an asset we failed to connect to
+type assetWithError struct {
+	asset *inventory.Asset
+	err   error
+}
+
+// discoveredAsset is an asset that still needs to be connected. The
+// discovery config is the connection it was discovered by, if any.
+type discoveredAsset struct {
+	asset     *inventory.Asset
+	discovery *inventory.Config
+}
+
+// connectedAsset is the result of connecting to a discovered asset
+type connectedAsset struct {
+	asset     *inventory.Asset
+	inventory *inventory.Inventory
+	err       error
+}
+
+// connectAsset creates a new runtime for the asset and connects to it.
+// Cancelling the context also cancels all requests to its providers.
+func connectAsset(ctx context.Context, asset *inventory.Asset, upstream *upstream.UpstreamConfig) (*providers.Runtime, error) {
+	runtime := providers.Coordinator.NewRuntime()
+	runtime.SetContext(ctx)
+	err := runtime.DetectProvider(asset)
+	if err == nil {
+		err = runtime.Connect(&plugin.ConnectReq{
+			Features: cnquery.GetFeatures(ctx),
+			Asset:    asset,
+			Upstream: upstream,
+		})
+	}
+	if err != nil {
+		runtime.Close()
+		return nil, err
+	}
+	return runtime, nil
+}
+
+// discoverAssets connects to all assets in the inventory and expands the
+// inventories they discover into additional assets. Up to workers assets
+// are connected in parallel. Connections are closed again once the asset's
+// metadata is detected, assets are connected again when they are scanned.
+// Assets are de-duplicated by their platform IDs. Assets we cannot
+// connect to are returned separately, so that the remaining assets can
+// still be scanned.
+func discoverAssets(ctx context.Context, inv *inventory.Inventory, upstream *upstream.UpstreamConfig, workers int) ([]*inventory.Asset, []*assetWithError) {
+	queue := make([]discoveredAsset, len(inv.Spec.Assets))
+	for i := range inv.Spec.Assets {
+		queue[i] = discoveredAsset{asset: inv.Spec.Assets[i]}
+	}
+
+	var res []*inventory.Asset
+	var failed []*assetWithError
+	platformIDs := map[string]struct{}{}
+	isDuplicate := func(asset *inventory.Asset) bool {
+		for _, id := range asset.PlatformIds {
+			if _, ok := platformIDs[id]; ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	// every round connects to all assets that were discovered by the
+	// previous one
+	for len(queue) != 0 {
+		batch := make([]discoveredAsset, 0, len(queue))
+		for _, cur := range queue {
+			if cur.discovery != nil && !cur.discovery.IncludesDiscoveredAsset(cur.asset) {
+				log.Debug().Str("asset", cur.asset.Name).Msg("skip discovered asset, it doesn't match discovery targets")
+				continue
+			}
+			if isDuplicate(cur.asset) {
+				log.Debug().Str("asset", cur.asset.Name).Msg("skip asset, it was already discovered")
+				continue
+			}
+			batch = append(batch, cur)
+		}
+		queue = nil
+
+		connected := make([]connectedAsset, len(batch))
+		runParallel(workers, len(batch), func(i int) {
+			runtime, err := connectAsset(ctx, batch[i].asset, upstream)
+			if err != nil {
+				connected[i].err = err
+				return
+			}
+			defer runtime.Close()
+
+			// we grab the asset from the connection, because it contains all the
+			// detected metadata (and IDs)
+			connected[i].asset = runtime.Provider.Connection.Asset
+			connected[i].inventory = runtime.Provider.Connection.Inventory
+		})
+
+		for i := range batch {
+			cur := batch[i]
+			if err := connected[i].err; err != nil {
+				log.Error().Err(err).Str("asset", cur.asset.Name).Msg("failed to connect to asset")
+				failed = append(failed, &assetWithError{
+					asset: cur.asset,
+					err:   multierr.Wrap(err, "failed to connect to asset"),
+				})
+				continue
+			}
+
+			asset := connected[i].asset
+			if isDuplicate(asset) {
+				log.Debug().Str("asset", asset.Name).Msg("skip asset, it was already discovered")
+				continue
+			}
+			for _, id := range asset.PlatformIds {
+				platformIDs[id] = struct{}{}
+			}
+			res = append(res, asset)
+
+			discovered := connected[i].inventory
+			if discovered == nil || discovered.Spec == nil || len(cur.asset.Connections) == 0 {
+				continue
+			}
+			conf := cur.asset.Connections[0]
+			for j := range discovered.Spec.Assets {
+				queue = append(queue, discoveredAsset{
+					asset:     discovered.Spec.Assets[j],
+					discovery: conf,
+				})
+			}
+		}
+	}
+
+	return res, failed
+}
+
+// runParallel calls f for all indexes from 0 to n, with up to workers
+// calls running at the same time
+func runParallel(workers int, n int, f func(i int)) {
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > n {
+		workers = n
+	}
+
+	queue := make(chan int, n)
+	for i := 0; i < n; i++ {
+		queue <- i
+	}
+	close(queue)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				f(i)
+			}
+		}()
+	}
+	wg.Wait()
+}
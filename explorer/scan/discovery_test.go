@@ -0,0 +1,59 @@
+package scan
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+)
+
+func TestDiscoverAssets_ConnectError(t *testing.T) {
+	inv := &inventory.Inventory{
+		Spec: &inventory.InventorySpec{
+			Assets: []*inventory.Asset{{
+				Name: "unreachable",
+				Connections: []*inventory.Config{{
+					Type: "does-not-exist",
+					Host: "unreachable.example.com",
+				}},
+			}},
+		},
+	}
+
+	assets, failed := discoverAssets(context.Background(), inv, nil, 2)
+	assert.Empty(t, assets)
+	require.Len(t, failed, 1)
+	assert.Equal(t, "unreachable", failed[0].asset.Name)
+	assert.ErrorContains(t, failed[0].err, "failed to connect to asset")
+}
+
+func TestRunParallel(t *testing.T) {
+	var lock sync.Mutex
+	running, maxRunning := 0, 0
+	done := make([]bool, 10)
+
+	runParallel(3, len(done), func(i int) {
+		lock.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		lock.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		lock.Lock()
+		running--
+		done[i] = true
+		lock.Unlock()
+	})
+
+	assert.LessOrEqual(t, maxRunning, 3)
+	for i := range done {
+		assert.True(t, done[i], "index %d wasn't run", i)
+	}
+}
@@ -24,7 +24,6 @@ import (
 	"go.mondoo.com/cnquery/mrn"
 	"go.mondoo.com/cnquery/providers"
 	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
-	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
 	"go.mondoo.com/cnquery/providers-sdk/v1/upstream"
 	"go.mondoo.com/cnquery/utils/multierr"
 	"go.mondoo.com/ranger-rpc/codes"
@@ -37,6 +36,10 @@ type LocalScanner struct {
 	fetcher   *fetcher
 	upstream  *upstream.UpstreamConfig
 	recording providers.Recording
+	// number of assets that are scanned in parallel
+	parallel int
+	// time providers get to compute a field, 0 means no limit
+	fieldTimeout time.Duration
 }
 
 type ScannerOption func(*LocalScanner)
@@ -53,9 +56,26 @@ func WithRecording(r providers.Recording) func(s *LocalScanner) {
 	}
 }
 
+// WithParallel sets the number of assets that are scanned in parallel.
+// Values below 1 scan one asset at a time.
+func WithParallel(n int) func(s *LocalScanner) {
+	return func(s *LocalScanner) {
+		s.parallel = n
+	}
+}
+
+// WithFieldTimeout sets the time providers get to compute a field while
+// assets are scanned. A value of 0 means there is no limit.
+func WithFieldTimeout(d time.Duration) func(s *LocalScanner) {
+	return func(s *LocalScanner) {
+		s.fieldTimeout = d
+	}
+}
+
 func NewLocalScanner(opts ...ScannerOption) *LocalScanner {
 	ls := &LocalScanner{
-		fetcher: newFetcher(),
+		fetcher:  newFetcher(),
+		parallel: 1,
 	}
 
 	for i := range opts {
@@ -144,30 +164,10 @@ func preprocessQueryPackFilters(filters []string) []string {
 func (s *LocalScanner) distributeJob(job *Job, ctx context.Context, upstream *upstream.UpstreamConfig) (*explorer.ReportCollection, bool, error) {
 	log.Info().Msgf("discover related assets for %d asset(s)", len(job.Inventory.Spec.Assets))
 
-	var assets []*inventory.Asset
-	var runtimes []*providers.Runtime
-	for i := range job.Inventory.Spec.Assets {
-		asset := job.Inventory.Spec.Assets[i]
-		runtime := providers.Coordinator.NewRuntime()
-		runtime.DetectProvider(asset)
-
-		if err := runtime.Connect(&plugin.ConnectReq{
-			Features: cnquery.GetFeatures(ctx),
-			Asset:    asset,
-			Upstream: upstream,
-		}); err != nil {
-			return nil, false, err
-		}
-
-		// TODO: grab full discovered inventory from: runtime.Provider.Connection.Inventory
-		// TODO: we want to keep better track of errors, since there may be
-		// multiple assets coming in. It's annoying to abort the scan if we get one
-		// error at this stage.
-
-		// we grab the asset from the connection, because it contains all the
-		// detected metadata (and IDs)
-		assets = append(assets, runtime.Provider.Connection.Asset)
-		runtimes = append(runtimes, runtime)
+	assets, failed := discoverAssets(ctx, job.Inventory, upstream, s.parallel)
+	log.Info().Msgf("discovered %d asset(s)", len(assets))
+	if len(failed) != 0 {
+		log.Warn().Msgf("failed to connect to %d asset(s)", len(failed))
 	}
 
 	// sync assets
@@ -205,27 +205,43 @@ func (s *LocalScanner) distributeJob(job *Job, ctx context.Context, upstream *up
 		}
 	} else {
 		// ensure we have non-empty asset MRNs
-		for i := range assets {
-			cur := assets[i]
-			if cur.Mrn == "" && cur.Id == "" {
-				randID := "//" + explorer.SERVICE_NAME + "/" + explorer.MRN_RESOURCE_ASSET + "/" + ksuid.New().String()
-				x, err := mrn.NewMRN(randID)
-				if err != nil {
-					return nil, false, multierr.Wrap(err, "failed to generate a random asset MRN")
-				}
-				cur.Mrn = x.String()
-			}
+		if err := ensureAssetMrns(assets); err != nil {
+			return nil, false, err
+		}
+	}
+
+	// assets we failed to connect to are not synchronized, but we still
+	// need their MRNs to report their errors
+	failedAssets := make([]*inventory.Asset, len(failed))
+	for i := range failed {
+		failedAssets[i] = failed[i].asset
+		if failedAssets[i].Name == "" && len(failedAssets[i].Connections) != 0 {
+			failedAssets[i].Name = failedAssets[i].Connections[0].Host
 		}
 	}
+	if err := ensureAssetMrns(failedAssets); err != nil {
+		return nil, false, err
+	}
 
 	// plan scan jobs
-	reporter := NewAggregateReporter(assets)
+	reportAssets := make([]*inventory.Asset, 0, len(assets)+len(failedAssets))
+	reportAssets = append(reportAssets, assets...)
+	reportAssets = append(reportAssets, failedAssets...)
+	reporter := NewAggregateReporter(reportAssets)
+	for i := range failed {
+		reporter.AddScanError(failed[i].asset, failed[i].err)
+	}
 	// if a bundle was provided check that it matches the filter, bundles can also be downloaded
 	// later therefore we do not want to stop execution here
 	if job.Bundle != nil && job.Bundle.FilterQueryPacks(job.QueryPackFilters) {
 		return nil, false, errors.New("all available packs filtered out. nothing to do.")
 	}
 
+	// nothing left to scan if we couldn't connect to any asset
+	if len(assets) == 0 {
+		return reporter.Reports(), true, nil
+	}
+
 	progressBarElements := map[string]string{}
 	orderedKeys := []string{}
 	for i := range assets {
@@ -250,43 +266,14 @@ func (s *LocalScanner) distributeJob(job *Job, ctx context.Context, upstream *up
 	}
 
 	scanGroup := sync.WaitGroup{}
-	scanGroup.Add(1)
 	finished := false
+	scanGroup.Add(1)
 	go func() {
 		defer scanGroup.Done()
-		for i := range assets {
-			asset := assets[i]
-			runtime := runtimes[i]
-
-			// Make sure the context has not been canceled in the meantime. Note that this approach works only for single threaded execution. If we have more than 1 thread calling this function,
-			// we need to solve this at a different level.
-			select {
-			case <-ctx.Done():
-				log.Warn().Msg("request context has been canceled")
-				// When we scan concurrently, we need to call Errored(asset.Mrn) status for this asset
-				multiprogress.Close()
-				return
-			default:
-			}
-
-			p := &progress.MultiProgressAdapter{Key: asset.PlatformIds[0], Multi: multiprogress}
-			s.RunAssetJob(&AssetJob{
-				DoRecord:         job.DoRecord,
-				UpstreamConfig:   upstream,
-				Asset:            asset,
-				Bundle:           job.Bundle,
-				Props:            job.Props,
-				QueryPackFilters: preprocessQueryPackFilters(job.QueryPackFilters),
-				Ctx:              ctx,
-				Reporter:         reporter,
-				ProgressReporter: p,
-				runtime:          runtime,
-			})
-
-			// we don't need the runtime anymore, so close it
-			runtime.Close()
-		}
-		finished = true
+		runParallel(s.parallel, len(assets), func(i int) {
+			s.runQueuedAsset(ctx, job, upstream, assets[i], reporter, multiprogress)
+		})
+		finished = ctx.Err() == nil
 	}()
 
 	scanGroup.Add(1)
@@ -298,6 +285,68 @@ func (s *LocalScanner) distributeJob(job *Job, ctx context.Context, upstream *up
 	return reporter.Reports(), finished, nil
 }
 
+// ensureAssetMrns generates random MRNs for all assets that have neither
+// an MRN nor an ID
+func ensureAssetMrns(assets []*inventory.Asset) error {
+	for i := range assets {
+		cur := assets[i]
+		if cur.Mrn == "" && cur.Id == "" {
+			randID := "//" + explorer.SERVICE_NAME + "/" + explorer.MRN_RESOURCE_ASSET + "/" + ksuid.New().String()
+			x, err := mrn.NewMRN(randID)
+			if err != nil {
+				return multierr.Wrap(err, "failed to generate a random asset MRN")
+			}
+			cur.Mrn = x.String()
+		}
+	}
+	return nil
+}
+
+// runQueuedAsset connects to one asset of a distributed job, scans it and
+// closes its runtime afterwards. Assets that are still queued when the
+// request context is canceled are marked as errored.
+func (s *LocalScanner) runQueuedAsset(ctx context.Context, job *Job, upstream *upstream.UpstreamConfig,
+	asset *inventory.Asset, reporter Reporter, multiprogress progress.MultiProgress,
+) {
+	p := &progress.MultiProgressAdapter{Key: asset.PlatformIds[0], Multi: multiprogress}
+
+	// make sure the context has not been canceled in the meantime
+	if err := ctx.Err(); err != nil {
+		log.Warn().Str("asset", asset.Name).Msg("request context has been canceled")
+		reporter.AddScanError(asset, err)
+		p.Errored()
+		return
+	}
+
+	// stops all requests to the asset's providers once its scan is done
+	assetCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	runtime, err := connectAsset(assetCtx, asset, upstream)
+	if err != nil {
+		log.Error().Err(err).Str("asset", asset.Name).Msg("failed to connect to asset")
+		reporter.AddScanError(asset, multierr.Wrap(err, "failed to connect to asset"))
+		p.Errored()
+		return
+	}
+	// we don't need the runtime anymore after this, so close it
+	defer runtime.Close()
+	runtime.FieldTimeout = s.fieldTimeout
+
+	s.RunAssetJob(&AssetJob{
+		DoRecord:         job.DoRecord,
+		UpstreamConfig:   upstream,
+		Asset:            asset,
+		Bundle:           job.Bundle,
+		Props:            job.Props,
+		QueryPackFilters: preprocessQueryPackFilters(job.QueryPackFilters),
+		Ctx:              assetCtx,
+		Reporter:         reporter,
+		ProgressReporter: p,
+		runtime:          runtime,
+	})
+}
+
 func (s *LocalScanner) RunAssetJob(job *AssetJob) {
 	log.Debug().Msgf("connecting to asset %s", job.Asset.HumanName())
 	results, err := s.runMotorizedAsset(job)
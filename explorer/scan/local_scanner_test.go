@@ -2,6 +2,7 @@ package scan
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -24,3 +25,13 @@ func TestFilterPreprocess(t *testing.T) {
 		"//registry.mondoo.com/namespace/namespace3/querypacks/pack3",
 	}, preprocessed)
 }
+
+func TestNewLocalScanner_Options(t *testing.T) {
+	s := NewLocalScanner()
+	assert.Equal(t, 1, s.parallel)
+	assert.Equal(t, time.Duration(0), s.fieldTimeout)
+
+	s = NewLocalScanner(WithParallel(4), WithFieldTimeout(30*time.Second))
+	assert.Equal(t, 4, s.parallel)
+	assert.Equal(t, 30*time.Second, s.fieldTimeout)
+}
@@ -1,6 +1,8 @@
 package scan
 
 import (
+	"sync"
+
 	"go.mondoo.com/cnquery/explorer"
 	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
 	"go.mondoo.com/cnquery/utils/multierr"
@@ -18,7 +20,10 @@ type AssetReport struct {
 	Resolved *explorer.ResolvedPack
 }
 
+// AggregateReporter collects the reports of all scanned assets. It is safe
+// for concurrent use.
 type AggregateReporter struct {
+	lock         sync.Mutex
 	assets       map[string]*explorer.Asset
 	assetReports map[string]*explorer.Report
 	assetErrors  map[string]error
@@ -45,16 +50,22 @@ func NewAggregateReporter(assetList []*inventory.Asset) *AggregateReporter {
 }
 
 func (r *AggregateReporter) AddReport(asset *inventory.Asset, results *AssetReport) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
 	r.assetReports[asset.Mrn] = results.Report
 	r.resolved[asset.Mrn] = results.Resolved
 	r.bundle = results.Bundle
 }
 
 func (r *AggregateReporter) AddScanError(asset *inventory.Asset, err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
 	r.assetErrors[asset.Mrn] = err
 }
 
 func (r *AggregateReporter) Reports() *explorer.ReportCollection {
+	r.lock.Lock()
+	defer r.lock.Unlock()
 	errors := make(map[string]*explorer.ErrorStatus, len(r.assetErrors))
 	for k, v := range r.assetErrors {
 		errors[k] = explorer.NewErrorStatus(v)
@@ -70,6 +81,8 @@ func (r *AggregateReporter) Reports() *explorer.ReportCollection {
 }
 
 func (r *AggregateReporter) Error() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
 	var err multierr.Errors
 	for _, curError := range r.assetErrors {
 		err.Add(curError)
@@ -0,0 +1,43 @@
+package scan
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+)
+
+func TestAggregateReporter_Concurrent(t *testing.T) {
+	assets := make([]*inventory.Asset, 20)
+	for i := range assets {
+		assets[i] = &inventory.Asset{Mrn: "//asset/" + strconv.Itoa(i), Name: "asset" + strconv.Itoa(i)}
+	}
+	reporter := NewAggregateReporter(assets)
+
+	wg := sync.WaitGroup{}
+	for i := range assets {
+		wg.Add(1)
+		go func(asset *inventory.Asset, fail bool) {
+			defer wg.Done()
+			if fail {
+				reporter.AddScanError(asset, errors.New("failed to scan "+asset.Name))
+				return
+			}
+			reporter.AddReport(asset, &AssetReport{
+				Mrn:    asset.Mrn,
+				Report: &explorer.Report{EntityMrn: asset.Mrn},
+			})
+		}(assets[i], i%2 == 0)
+	}
+	wg.Wait()
+
+	reports := reporter.Reports()
+	assert.Len(t, reports.Assets, 20)
+	assert.Len(t, reports.Reports, 10)
+	assert.Len(t, reports.Errors, 10)
+	assert.Error(t, reporter.Error())
+}
@@ -304,14 +304,20 @@ func init() {
 			string("contains" + types.Array(types.Int)):    {f: stringContainsArrayIntV2, Label: "contains"},
 			string("contains" + types.Regex):               {f: stringContainsRegex, Label: "contains"},
 			string("contains" + types.Array(types.Regex)):  {f: stringContainsArrayRegex, Label: "contains"},
-			string("find"):      {f: stringFindV2, Label: "find"},
-			string("camelcase"): {f: stringCamelcaseV2, Label: "camelcase"},
-			string("downcase"):  {f: stringDowncaseV2, Label: "downcase"},
-			string("upcase"):    {f: stringUpcaseV2, Label: "upcase"},
-			string("length"):    {f: stringLengthV2, Label: "length"},
-			string("lines"):     {f: stringLinesV2, Label: "lines"},
-			string("split"):     {f: stringSplitV2, Label: "split"},
-			string("trim"):      {f: stringTrimV2, Label: "trim"},
+			string("find"):       {f: stringFindV2, Label: "find"},
+			string("camelcase"):  {f: stringCamelcaseV2, Label: "camelcase"},
+			string("downcase"):   {f: stringDowncaseV2, Label: "downcase"},
+			string("upcase"):     {f: stringUpcaseV2, Label: "upcase"},
+			string("length"):     {f: stringLengthV2, Label: "length"},
+			string("lines"):      {f: stringLinesV2, Label: "lines"},
+			string("split"):      {f: stringSplitV2, Label: "split"},
+			string("trim"):       {f: stringTrimV2, Label: "trim"},
+			string("replace"):    {f: stringReplaceV2, Label: "replace"},
+			string("substring"):  {f: stringSubstringV2, Label: "substring"},
+			string("startsWith"): {f: stringStartsWithV2, Label: "startsWith"},
+			string("endsWith"):   {f: stringEndsWithV2, Label: "endsWith"},
+			string("in"):         {f: stringInV2, Label: "in"},
+			string("capture"):    {f: stringCaptureV2, Label: "capture"},
 		},
 		types.StringSlice: {
 			// TODO: implement the remaining calls for this type
@@ -496,6 +502,12 @@ func init() {
 			"lines":                           {f: dictLinesV2, Label: "lines"},
 			"split":                           {f: dictSplitV2, Label: "split"},
 			"trim":                            {f: dictTrimV2, Label: "trim"},
+			"replace":                         {f: dictReplaceV2, Label: "replace"},
+			"substring":                       {f: dictSubstringV2, Label: "substring"},
+			"startsWith":                      {f: dictStartsWithV2, Label: "startsWith"},
+			"endsWith":                        {f: dictEndsWithV2, Label: "endsWith"},
+			"in":                              {f: dictInV2, Label: "in"},
+			"capture":                         {f: dictCaptureV2, Label: "capture"},
 			"keys":                            {f: dictKeysV2, Label: "keys"},
 			"values":                          {f: dictValuesV2, Label: "values"},
 			"where":                           {f: dictWhereV2, Label: "where"},
@@ -506,6 +518,13 @@ func init() {
 			"$one":                            {f: dictOneV2},
 			"map":                             {f: dictMapV2},
 			"flat":                            {f: dictFlat},
+			"sort":                            {f: arraySortV2},
+			"fieldSort":                       {f: arrayFieldSortV2},
+			"groupBy":                         {f: arrayGroupByV2},
+			"min":                             {f: arrayAggregateV2},
+			"max":                             {f: arrayAggregateV2},
+			"sum":                             {f: arrayAggregateV2},
+			"avg":                             {f: arrayAggregateV2},
 			"difference":                      {f: dictDifferenceV2},
 			"containsNone":                    {f: dictContainsNoneV2},
 			string("contains" + types.String): {f: dictContainsStringV2, Label: "contains"},
@@ -537,6 +556,13 @@ func init() {
 			"duplicates":             {f: arrayDuplicatesV2},
 			"fieldDuplicates":        {f: arrayFieldDuplicatesV2},
 			"unique":                 {f: arrayUniqueV2},
+			"sort":                   {f: arraySortV2},
+			"fieldSort":              {f: arrayFieldSortV2},
+			"groupBy":                {f: arrayGroupByV2},
+			"min":                    {f: arrayAggregateV2},
+			"max":                    {f: arrayAggregateV2},
+			"sum":                    {f: arrayAggregateV2},
+			"avg":                    {f: arrayAggregateV2},
 			"difference":             {f: arrayDifferenceV2},
 			"containsNone":           {f: arrayContainsNoneV2},
 			"==":                     {Compiler: compileArrayOpArray("=="), f: tarrayCmpTarrayV2, Label: "=="},
@@ -2,7 +2,9 @@ package llx
 
 import (
 	"errors"
+	"sort"
 	"strconv"
+	"time"
 
 	"go.mondoo.com/cnquery/types"
 	"go.mondoo.com/cnquery/utils/multierr"
@@ -1407,3 +1409,345 @@ func floatarrayNotRegexV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uin
 		return cmpArrayOne(left, right, opFloatCmpRegex)
 	})
 }
+
+// compareValues orders 2 values of the given type. Nil values are sorted
+// before all other values.
+func compareValues(typ types.Type, left interface{}, right interface{}) (int, error) {
+	switch {
+	case left == nil && right == nil:
+		return 0, nil
+	case left == nil:
+		return -1, nil
+	case right == nil:
+		return 1, nil
+	}
+
+	if typ == types.Dict || typ == types.Any {
+		return compareDictValues(left, right)
+	}
+
+	cmp, ok := types.Compare[typ]
+	if !ok {
+		return 0, errors.New("cannot compare values of type " + typ.Label())
+	}
+	return cmp(left, right), nil
+}
+
+// compareDictValues orders 2 dict values, which can be of any basic type.
+// Numbers are compared independent of them being ints or floats.
+func compareDictValues(left interface{}, right interface{}) (int, error) {
+	if l, ok := dictNumber(left); ok {
+		if r, ok := dictNumber(right); ok {
+			return types.Compare[types.Float](l, r), nil
+		}
+	}
+
+	switch l := left.(type) {
+	case string:
+		if r, ok := right.(string); ok {
+			return types.Compare[types.String](l, r), nil
+		}
+	case bool:
+		if r, ok := right.(bool); ok {
+			return types.Compare[types.Bool](l, r), nil
+		}
+	case *time.Time:
+		if r, ok := right.(*time.Time); ok {
+			return types.Compare[types.Time](l, r), nil
+		}
+	}
+
+	return 0, errors.New("cannot compare dict values of different or unsupported types")
+}
+
+func dictNumber(v interface{}) (float64, bool) {
+	switch x := v.(type) {
+	case int64:
+		return float64(x), true
+	case float64:
+		return x, true
+	default:
+		return 0, false
+	}
+}
+
+func sortList(typ types.Type, list []interface{}) ([]interface{}, error) {
+	res := make([]interface{}, len(list))
+	copy(res, list)
+
+	var err error
+	sort.SliceStable(res, func(i, j int) bool {
+		cmp, cerr := compareValues(typ, res[i], res[j])
+		if cerr != nil {
+			err = cerr
+		}
+		return cmp < 0
+	})
+	return res, err
+}
+
+func arraySortV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: bind.Type, Error: bind.Error}, 0, nil
+	}
+
+	list, ok := bind.Value.([]interface{})
+	if !ok {
+		return &RawData{Type: bind.Type, Error: errors.New("failed to call 'sort' on a non-list value")}, 0, nil
+	}
+
+	res, err := sortList(bind.Type.Child(), list)
+	if err != nil {
+		return &RawData{Type: bind.Type, Error: err}, 0, nil
+	}
+	return &RawData{Type: bind.Type, Value: res}, 0, nil
+}
+
+// arrayFieldCall is the shared implementation for list functions that are
+// called with a field, like sort(field) and groupBy(field). It runs the
+// function block on every entry of the list and hands the list together
+// with the field values of all entries to f. The result of f is stored as
+// the result of this chunk.
+func arrayFieldCall(e *blockExecutor, chunk *Chunk, ref uint64, f func(items *RawData, list []interface{}, fields []*RawData) *RawData) (*RawData, uint64, error) {
+	itemsRef := chunk.Function.Args[0]
+	items, rref, err := e.resolveValue(itemsRef, ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	if items.Value == nil {
+		return &RawData{Type: types.Type(chunk.Function.Type), Error: items.Error}, 0, nil
+	}
+
+	list, ok := items.Value.([]interface{})
+	if !ok {
+		return nil, 0, errors.New("failed to call '" + chunk.Id + "' on a non-list value")
+	}
+	if len(list) == 0 {
+		return f(items, list, nil), 0, nil
+	}
+
+	arg1 := chunk.Function.Args[1]
+	fref, ok := arg1.RefV2()
+	if !ok {
+		return nil, 0, errors.New("Failed to retrieve function reference of '" + chunk.Id + "' call")
+	}
+
+	dref, err := e.ensureArgsResolved(chunk.Function.Args[2:], ref)
+	if dref != 0 || err != nil {
+		return nil, dref, err
+	}
+
+	ct := items.Type.Child()
+
+	argsList := make([][]*RawData, len(list))
+	for i := range list {
+		argsList[i] = []*RawData{
+			{
+				Type:  ct,
+				Value: list[i],
+			},
+		}
+	}
+
+	err = e.runFunctionBlocks(argsList, fref, func(results []arrayBlockCallResult, errs []error) {
+		block := e.ctx.code.Block(fref)
+		epChecksum := e.ctx.code.Checksums[block.Entrypoints[0]]
+
+		fields := make([]*RawData, len(results))
+		for i, res := range results {
+			if epVal, ok := res.entrypoints[epChecksum]; ok {
+				fields[i] = epVal.(*RawData)
+			} else {
+				fields[i] = &RawData{Type: types.Nil}
+			}
+		}
+
+		data := f(items, list, fields)
+		e.cache.Store(ref, &stepCache{
+			Result:   data,
+			IsStatic: false,
+		})
+		e.triggerChain(ref, data)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return nil, 0, nil
+}
+
+// fieldsError returns the first error found in a list of field values
+func fieldsError(fields []*RawData) error {
+	for i := range fields {
+		if fields[i].Error != nil {
+			return fields[i].Error
+		}
+	}
+	return nil
+}
+
+// Takes an array and a field and sorts the array by the values of that field
+func arrayFieldSortV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return arrayFieldCall(e, chunk, ref, func(items *RawData, list []interface{}, fields []*RawData) *RawData {
+		if err := fieldsError(fields); err != nil {
+			return &RawData{Type: items.Type, Error: err}
+		}
+
+		fieldType := types.Nil
+		for i := range fields {
+			if fields[i].Type != types.Nil {
+				fieldType = fields[i].Type
+				break
+			}
+		}
+
+		idx := make([]int, len(list))
+		for i := range idx {
+			idx[i] = i
+		}
+
+		var err error
+		sort.SliceStable(idx, func(i, j int) bool {
+			cmp, cerr := compareValues(fieldType, fields[idx[i]].Value, fields[idx[j]].Value)
+			if cerr != nil {
+				err = cerr
+			}
+			return cmp < 0
+		})
+		if err != nil {
+			return &RawData{Type: items.Type, Error: err}
+		}
+
+		res := make([]interface{}, len(list))
+		for i := range idx {
+			res[i] = list[idx[i]]
+		}
+		return &RawData{Type: items.Type, Value: res}
+	})
+}
+
+// groupKey turns the value of a groupBy field into the key of its group
+func groupKey(field *RawData) (string, error) {
+	switch v := field.Value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case *time.Time:
+		if v == nil {
+			return "", nil
+		}
+		return v.Format(time.RFC3339), nil
+	default:
+		return "", errors.New("cannot group by a field of type " + field.Type.Label())
+	}
+}
+
+// Takes an array and a field and groups all entries by the values of that
+// field. The result is a map from field values to lists of entries.
+func arrayGroupByV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return arrayFieldCall(e, chunk, ref, func(items *RawData, list []interface{}, fields []*RawData) *RawData {
+		typ := types.Type(chunk.Function.Type)
+		if err := fieldsError(fields); err != nil {
+			return &RawData{Type: typ, Error: err}
+		}
+
+		groups := map[string][]interface{}{}
+		for i := range list {
+			key, err := groupKey(fields[i])
+			if err != nil {
+				return &RawData{Type: typ, Error: err}
+			}
+			groups[key] = append(groups[key], list[i])
+		}
+
+		res := make(map[string]interface{}, len(groups))
+		for k, v := range groups {
+			res[k] = v
+		}
+		return &RawData{Type: typ, Value: res}
+	})
+}
+
+func listAggregate(id string, typ types.Type, list []interface{}) (*RawData, error) {
+	switch id {
+	case "min", "max":
+		var res interface{}
+		for i := range list {
+			if list[i] == nil {
+				continue
+			}
+			if res == nil {
+				res = list[i]
+				continue
+			}
+			cmp, err := compareValues(typ, list[i], res)
+			if err != nil {
+				return nil, err
+			}
+			if (id == "min" && cmp < 0) || (id == "max" && cmp > 0) {
+				res = list[i]
+			}
+		}
+		return &RawData{Type: typ, Value: res}, nil
+
+	case "sum", "avg":
+		var isum int64
+		var fsum float64
+		isFloat := typ == types.Float
+		cnt := 0
+		for i := range list {
+			switch v := list[i].(type) {
+			case nil:
+				continue
+			case int64:
+				isum += v
+			case float64:
+				fsum += v
+				isFloat = true
+			default:
+				return nil, errors.New("cannot compute " + id + " of non-numeric values")
+			}
+			cnt++
+		}
+
+		if id == "avg" {
+			if cnt == 0 {
+				return &RawData{Type: types.Float}, nil
+			}
+			return FloatData((fsum + float64(isum)) / float64(cnt)), nil
+		}
+		if isFloat {
+			return &RawData{Type: typ, Value: fsum + float64(isum)}, nil
+		}
+		return &RawData{Type: typ, Value: isum}, nil
+	}
+
+	return nil, errors.New("unknown aggregate function '" + id + "'")
+}
+
+// arrayAggregateV2 handles min, max, sum, and avg
+func arrayAggregateV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	typ := types.Type(chunk.Function.Type)
+	if bind.Value == nil {
+		return &RawData{Type: typ, Error: bind.Error}, 0, nil
+	}
+
+	list, ok := bind.Value.([]interface{})
+	if !ok {
+		return &RawData{Type: typ, Error: errors.New("failed to call '" + chunk.Id + "' on a non-list value")}, 0, nil
+	}
+
+	res, err := listAggregate(chunk.Id, bind.Type.Child(), list)
+	if err != nil {
+		return &RawData{Type: typ, Error: err}, 0, nil
+	}
+	return res, 0, nil
+}
@@ -2,7 +2,9 @@ package llx
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
+	"time"
 
 	"go.mondoo.com/cnquery/types"
 )
@@ -31,6 +33,7 @@ func init() {
 		"switch":         switchCallV2,
 		"score":          scoreCallV2,
 		"typeof":         typeofCallV2,
+		"sprintf":        sprintfCallV2,
 		"{}":             blockV2,
 		"return":         returnCallV2,
 		"createResource": globalCreateResource,
@@ -179,6 +182,41 @@ func typeofCallV2(e *blockExecutor, f *Function, ref uint64) (*RawData, uint64,
 	return StringData(res.Type.Label()), 0, nil
 }
 
+// sprintfCallV2 formats its arguments according to the format string
+// in the first argument, the same way Go's fmt.Sprintf does.
+func sprintfCallV2(e *blockExecutor, f *Function, ref uint64) (*RawData, uint64, error) {
+	if len(f.Args) < 1 {
+		return nil, 0, errors.New("Called `sprintf` without a format string")
+	}
+
+	values := make([]interface{}, len(f.Args))
+	for i := range f.Args {
+		res, dref, err := e.resolveValue(f.Args[i], ref)
+		if err != nil || dref != 0 {
+			return nil, dref, err
+		}
+		if res.Error != nil {
+			return &RawData{Type: types.String, Error: res.Error}, 0, nil
+		}
+
+		switch v := res.Value.(type) {
+		case *time.Time:
+			if v != nil {
+				values[i] = *v
+			}
+		default:
+			values[i] = v
+		}
+	}
+
+	format, ok := values[0].(string)
+	if !ok {
+		return &RawData{Type: types.String, Error: errors.New("the format string for `sprintf` must be a string")}, 0, nil
+	}
+
+	return StringData(fmt.Sprintf(format, values[1:]...)), 0, nil
+}
+
 func expectV2(e *blockExecutor, f *Function, ref uint64) (*RawData, uint64, error) {
 	if len(f.Args) != 1 {
 		return nil, 0, errors.New("Called expect with " + strconv.Itoa(len(f.Args)) + " arguments, expected 1")
@@ -434,6 +434,60 @@ func dictTrimV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*Raw
 	return stringTrimV2(e, bind, chunk, ref)
 }
 
+func dictReplaceV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `replace`")
+	}
+
+	return stringReplaceV2(e, bind, chunk, ref)
+}
+
+func dictSubstringV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `substring`")
+	}
+
+	return stringSubstringV2(e, bind, chunk, ref)
+}
+
+func dictStartsWithV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `startsWith`")
+	}
+
+	return stringStartsWithV2(e, bind, chunk, ref)
+}
+
+func dictEndsWithV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `endsWith`")
+	}
+
+	return stringEndsWithV2(e, bind, chunk, ref)
+}
+
+func dictInV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `in`")
+	}
+
+	return stringInV2(e, bind, chunk, ref)
+}
+
+func dictCaptureV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `capture`")
+	}
+
+	return stringCaptureV2(e, bind, chunk, ref)
+}
+
 func dictKeysV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
 	if bind.Value == nil {
 		return &RawData{
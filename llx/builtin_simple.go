@@ -2273,6 +2273,170 @@ func stringTrimV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*R
 	return StringData(res), 0, nil
 }
 
+func stringReplaceV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	old, rref, err := e.resolveValue(chunk.Function.Args[0], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	replacement, rref, err := e.resolveValue(chunk.Function.Args[1], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	oldS, ok := old.Value.(string)
+	if !ok {
+		return &RawData{
+			Type:  types.String,
+			Error: errors.New("failed to replace in string, search value must be a string or regex"),
+		}, 0, nil
+	}
+	newS, ok := replacement.Value.(string)
+	if !ok {
+		return &RawData{
+			Type:  types.String,
+			Error: errors.New("failed to replace in string, replacement must be a string"),
+		}, 0, nil
+	}
+
+	if old.Type == types.Regex {
+		re, err := regexp.Compile(oldS)
+		if err != nil {
+			return nil, 0, errors.New("Failed to compile regular expression: " + oldS)
+		}
+		return StringData(re.ReplaceAllString(bind.Value.(string), newS)), 0, nil
+	}
+
+	return StringData(strings.ReplaceAll(bind.Value.(string), oldS, newS)), 0, nil
+}
+
+// stringSubstringV2 returns the characters from index i up to but
+// excluding index j. If j is not provided, it returns everything from i
+// to the end of the string. Indexes are clamped to the string's length.
+func stringSubstringV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	runes := []rune(bind.Value.(string))
+	bounds := []int{0, len(runes)}
+	for i := range chunk.Function.Args {
+		arg, rref, err := e.resolveValue(chunk.Function.Args[i], ref)
+		if err != nil || rref > 0 {
+			return nil, rref, err
+		}
+
+		idx, ok := arg.Value.(int64)
+		if !ok {
+			return &RawData{
+				Type:  types.String,
+				Error: errors.New("failed to get substring, index must be an int"),
+			}, 0, nil
+		}
+
+		switch {
+		case idx < 0:
+			bounds[i] = 0
+		case idx > int64(len(runes)):
+			bounds[i] = len(runes)
+		default:
+			bounds[i] = int(idx)
+		}
+	}
+
+	if bounds[1] < bounds[0] {
+		return StringData(""), 0, nil
+	}
+	return StringData(string(runes[bounds[0]:bounds[1]])), 0, nil
+}
+
+func stringStartsWithV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return rawboolOpV2(e, bind, chunk, ref, func(left *RawData, right *RawData) bool {
+		l, ok := left.Value.(string)
+		if !ok {
+			return false
+		}
+		r, ok := right.Value.(string)
+		if !ok {
+			return false
+		}
+		return strings.HasPrefix(l, r)
+	})
+}
+
+func stringEndsWithV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return rawboolOpV2(e, bind, chunk, ref, func(left *RawData, right *RawData) bool {
+		l, ok := left.Value.(string)
+		if !ok {
+			return false
+		}
+		r, ok := right.Value.(string)
+		if !ok {
+			return false
+		}
+		return strings.HasSuffix(l, r)
+	})
+}
+
+func stringInV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return rawboolOpV2(e, bind, chunk, ref, func(left *RawData, right *RawData) bool {
+		s, ok := left.Value.(string)
+		if !ok {
+			return false
+		}
+		list, ok := right.Value.([]interface{})
+		if !ok {
+			return false
+		}
+		for i := range list {
+			if v, ok := list[i].(string); ok && v == s {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// stringCaptureV2 matches a regex against the string and returns all
+// named groups of the first match
+func stringCaptureV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	typ := types.Map(types.String, types.String)
+	if bind.Value == nil {
+		return &RawData{Type: typ}, 0, nil
+	}
+
+	arg, rref, err := e.resolveValue(chunk.Function.Args[0], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	reContent, ok := arg.Value.(string)
+	if !ok {
+		return &RawData{Type: typ, Error: errors.New("failed to capture, regex was null")}, 0, nil
+	}
+	re, err := regexp.Compile(reContent)
+	if err != nil {
+		return nil, 0, errors.New("Failed to compile regular expression: " + reContent)
+	}
+
+	res := map[string]interface{}{}
+	match := re.FindStringSubmatch(bind.Value.(string))
+	if match == nil {
+		return &RawData{Type: typ, Value: res}, 0, nil
+	}
+
+	for i, name := range re.SubexpNames() {
+		if name != "" {
+			res[name] = match[i]
+		}
+	}
+	return &RawData{Type: typ, Value: res}, 0, nil
+}
+
 // time methods
 
 // zeroTimeOffset to help convert unix times into base times that start at the year 0
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"go.mondoo.com/cnquery/types"
+	"google.golang.org/protobuf/proto"
 )
 
 // RawData is an internal track of raw data that can be cast to the appropriate types
@@ -152,6 +153,19 @@ func (r *RawData) String() string {
 	return rawDataString(r.Type, r.Value)
 }
 
+// Equal checks if two raw data objects have the same type, value and error.
+// Values are compared via their serialized form, so that e.g. maps are
+// compared independent of their order and resources by their IDs.
+func (r *RawData) Equal(other *RawData) bool {
+	if r == nil || other == nil {
+		return r == other
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	return proto.Equal(r.Result(), other.Result())
+}
+
 // IsTruthy indicates how the query is scored.
 // the first return value gives true/false based on if the data indicates success/failure
 // the second value indicates if we were able to come to a decision based on the data
@@ -1,6 +1,7 @@
 package llx
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -39,6 +40,28 @@ func TestRawData_String(t *testing.T) {
 	}
 }
 
+func TestRawData_Equal(t *testing.T) {
+	tests := []struct {
+		a   *RawData
+		b   *RawData
+		res bool
+	}{
+		{NilData, NilData, true},
+		{StringData("a"), StringData("a"), true},
+		{StringData("a"), StringData("b"), false},
+		{IntData(1), FloatData(1), false},
+		{MapData(map[string]interface{}{"a": "b", "c": "d"}, types.String), MapData(map[string]interface{}{"c": "d", "a": "b"}, types.String), true},
+		{ArrayData([]interface{}{"a", "b"}, types.String), ArrayData([]interface{}{"b", "a"}, types.String), false},
+		{&RawData{Type: types.String, Error: errors.New("e1")}, &RawData{Type: types.String, Error: errors.New("e1")}, true},
+		{&RawData{Type: types.String, Error: errors.New("e1")}, &RawData{Type: types.String, Error: errors.New("e2")}, false},
+		{StringData("a"), nil, false},
+	}
+
+	for i := range tests {
+		assert.Equal(t, tests[i].res, tests[i].a.Equal(tests[i].b), "test %d", i)
+	}
+}
+
 func TestTruthy(t *testing.T) {
 	tests := []struct {
 		data *RawData
@@ -22,6 +22,7 @@ var (
 	intType         = func(t types.Type) types.Type { return types.Int }
 	stringType      = func(t types.Type) types.Type { return types.String }
 	stringArrayType = func(t types.Type) types.Type { return types.Array(types.String) }
+	stringMapType   = func(t types.Type) types.Type { return types.Map(types.String, types.String) }
 	dictType        = func(t types.Type) types.Type { return types.Dict }
 	blockType       = func(t types.Type) types.Type { return types.Block }
 	dictArrayType   = func(t types.Type) types.Type { return types.Array(types.Dict) }
@@ -32,15 +33,21 @@ var builtinFunctions map[types.Type]map[string]compileHandler
 func init() {
 	builtinFunctions = map[types.Type]map[string]compileHandler{
 		types.String: {
-			"contains":  {compile: compileStringContains, typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
-			"find":      {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Regex}}},
-			"length":    {typ: intType, signature: FunctionSignature{}},
-			"camelcase": {typ: stringType, signature: FunctionSignature{}},
-			"downcase":  {typ: stringType, signature: FunctionSignature{}},
-			"upcase":    {typ: stringType, signature: FunctionSignature{}},
-			"lines":     {typ: stringArrayType, signature: FunctionSignature{}},
-			"split":     {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
-			"trim":      {typ: stringType, signature: FunctionSignature{Required: 0, Args: []types.Type{types.String}}},
+			"contains":   {compile: compileStringContains, typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"find":       {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Regex}}},
+			"length":     {typ: intType, signature: FunctionSignature{}},
+			"camelcase":  {typ: stringType, signature: FunctionSignature{}},
+			"downcase":   {typ: stringType, signature: FunctionSignature{}},
+			"upcase":     {typ: stringType, signature: FunctionSignature{}},
+			"lines":      {typ: stringArrayType, signature: FunctionSignature{}},
+			"split":      {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"trim":       {typ: stringType, signature: FunctionSignature{Required: 0, Args: []types.Type{types.String}}},
+			"replace":    {compile: compileStringReplace, signature: FunctionSignature{Required: 2, Args: []types.Type{types.String, types.String}}},
+			"substring":  {typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int, types.Int}}},
+			"startsWith": {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"endsWith":   {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"in":         {compile: compileStringIn, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Array(types.String)}}},
+			"capture":    {typ: stringMapType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Regex}}},
 		},
 		types.Time: {
 			"seconds": {typ: intType, signature: FunctionSignature{}},
@@ -53,14 +60,20 @@ func init() {
 			"[]": {typ: dictType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Any}}},
 			"{}": {typ: blockType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			// string-ish
-			"find":      {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Regex}}},
-			"length":    {typ: intType, signature: FunctionSignature{}},
-			"camelcase": {typ: stringType, signature: FunctionSignature{}},
-			"downcase":  {typ: stringType, signature: FunctionSignature{}},
-			"upcase":    {typ: stringType, signature: FunctionSignature{}},
-			"lines":     {typ: stringArrayType, signature: FunctionSignature{}},
-			"split":     {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
-			"trim":      {typ: stringType, signature: FunctionSignature{Required: 0, Args: []types.Type{types.String}}},
+			"find":       {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Regex}}},
+			"length":     {typ: intType, signature: FunctionSignature{}},
+			"camelcase":  {typ: stringType, signature: FunctionSignature{}},
+			"downcase":   {typ: stringType, signature: FunctionSignature{}},
+			"upcase":     {typ: stringType, signature: FunctionSignature{}},
+			"lines":      {typ: stringArrayType, signature: FunctionSignature{}},
+			"split":      {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"trim":       {typ: stringType, signature: FunctionSignature{Required: 0, Args: []types.Type{types.String}}},
+			"replace":    {compile: compileStringReplace, signature: FunctionSignature{Required: 2, Args: []types.Type{types.String, types.String}}},
+			"substring":  {typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int, types.Int}}},
+			"startsWith": {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"endsWith":   {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"in":         {compile: compileStringIn, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Array(types.String)}}},
+			"capture":    {typ: stringMapType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Regex}}},
 			// array- or map-ish
 			"first":        {typ: dictType, signature: FunctionSignature{}},
 			"last":         {typ: dictType, signature: FunctionSignature{}},
@@ -74,6 +87,12 @@ func init() {
 			"none":         {compile: compileDictNone, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			"map":          {compile: compileArrayMap, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			"flat":         {compile: compileDictFlat, signature: FunctionSignature{}},
+			"sort":         {compile: compileArraySort, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"groupBy":      {compile: compileArrayGroupBy, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
+			"min":          {compile: compileArrayAggregate, signature: FunctionSignature{}},
+			"max":          {compile: compileArrayAggregate, signature: FunctionSignature{}},
+			"sum":          {compile: compileArrayAggregate, signature: FunctionSignature{}},
+			"avg":          {compile: compileArrayAggregate, signature: FunctionSignature{}},
 			// map-ish
 			"keys":   {typ: stringArrayType, signature: FunctionSignature{}},
 			"values": {typ: dictArrayType, signature: FunctionSignature{}},
@@ -96,6 +115,12 @@ func init() {
 			"none":         {compile: compileArrayNone, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			"map":          {compile: compileArrayMap, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			"flat":         {compile: compileArrayFlat, signature: FunctionSignature{}},
+			"sort":         {compile: compileArraySort, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"groupBy":      {compile: compileArrayGroupBy, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
+			"min":          {compile: compileArrayAggregate, signature: FunctionSignature{}},
+			"max":          {compile: compileArrayAggregate, signature: FunctionSignature{}},
+			"sum":          {compile: compileArrayAggregate, signature: FunctionSignature{}},
+			"avg":          {compile: compileArrayAggregate, signature: FunctionSignature{}},
 		},
 		types.MapLike: {
 			"[]":     {typ: childType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
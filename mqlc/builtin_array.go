@@ -509,3 +509,160 @@ func compileArrayFlat(c *compiler, typ types.Type, ref uint64, id string, call *
 	})
 	return typ, nil
 }
+
+// compileFieldArg compiles the field argument of a list function like
+// sort(field) or groupBy(field) into a function block that is called
+// for every entry of the list. It returns the new binding and the args
+// for the function chunk.
+func compileFieldArg(c *compiler, typ types.Type, ref uint64, id string, arg *parser.Arg) (uint64, []*llx.Primitive, error) {
+	if arg.Name != "" {
+		return 0, nil, errors.New("called '" + id + "' with a named parameter, which is not supported")
+	}
+
+	refs, err := c.blockExpressions([]*parser.Expression{arg.Value}, typ, ref)
+	if err != nil {
+		return 0, nil, err
+	}
+	if refs.block == 0 {
+		return 0, nil, errors.New("called '" + id + "' without a function block")
+	}
+	if refs.isStandalone {
+		return 0, nil, errors.New("called '" + id + "' with a field name on an invalid type")
+	}
+
+	block := c.Result.CodeV2.Block(refs.block)
+	if len(block.Entrypoints) != 1 {
+		return 0, nil, errors.New("called '" + id + "' with a bad function block, you can only return 1 value")
+	}
+
+	args := []*llx.Primitive{
+		llx.RefPrimitiveV2(refs.binding),
+		llx.FunctionPrimitive(refs.block),
+	}
+	for _, v := range refs.deps {
+		if c.isInMyBlock(v) {
+			args = append(args, llx.RefPrimitiveV2(v))
+		}
+	}
+	c.blockDeps = append(c.blockDeps, refs.deps...)
+
+	return refs.binding, args, nil
+}
+
+// isOrdered returns true if values of the given type can be sorted.
+// Dicts are checked when the query is executed.
+func isOrdered(typ types.Type) bool {
+	if typ == types.Dict {
+		return true
+	}
+	_, ok := types.Compare[typ]
+	return ok
+}
+
+// isNumeric returns true if values of the given type can be summed up.
+// Dicts are checked when the query is executed.
+func isNumeric(typ types.Type) bool {
+	return typ == types.Int || typ == types.Float || typ == types.Dict
+}
+
+func compileArraySort(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	if call != nil && len(call.Function) > 1 {
+		return types.Nil, errors.New("too many arguments when calling '" + id + "'")
+	}
+
+	if call != nil && len(call.Function) == 1 {
+		binding, args, err := compileFieldArg(c, typ, ref, id, call.Function[0])
+		if err != nil {
+			return types.Nil, err
+		}
+
+		c.addChunk(&llx.Chunk{
+			Call: llx.Chunk_FUNCTION,
+			Id:   "fieldSort",
+			Function: &llx.Function{
+				Type:    string(typ),
+				Binding: binding,
+				Args:    args,
+			},
+		})
+		return typ, nil
+	}
+
+	// sort is being called with 0 arguments, which means it should be on an
+	// array of basic types
+	if !isOrdered(typ.Child()) {
+		return types.Nil, errors.New("cannot sort array of " + typ.Child().Label() + ", must be a basic type. Try using a field argument.")
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(typ),
+			Binding: ref,
+		},
+	})
+	return typ, nil
+}
+
+func compileArrayGroupBy(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	if call == nil || len(call.Function) == 0 {
+		return types.Nil, errors.New("missing field argument for calling '" + id + "'")
+	}
+	if len(call.Function) > 1 {
+		return types.Nil, errors.New("too many arguments when calling '" + id + "', only 1 is supported")
+	}
+
+	binding, args, err := compileFieldArg(c, typ, ref, id, call.Function[0])
+	if err != nil {
+		return types.Nil, err
+	}
+
+	resType := types.Map(types.String, types.Array(typ.Child()))
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(resType),
+			Binding: binding,
+			Args:    args,
+		},
+	})
+	return resType, nil
+}
+
+// compileArrayAggregate compiles min, max, sum and avg, which reduce a list
+// of values to a single value.
+func compileArrayAggregate(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	if call != nil && len(call.Function) > 0 {
+		return types.Nil, errors.New("no arguments supported for '" + id + "'")
+	}
+
+	ct := typ.Child()
+	resType := ct
+	switch id {
+	case "min", "max":
+		if !isOrdered(ct) {
+			return types.Nil, errors.New("cannot compute " + id + " of array of " + ct.Label() + ", must be a basic type. Try mapping it to a field first.")
+		}
+	case "sum", "avg":
+		if !isNumeric(ct) {
+			return types.Nil, errors.New("cannot compute " + id + " of array of " + ct.Label() + ", must be a number. Try mapping it to a field first.")
+		}
+		if id == "avg" {
+			resType = types.Float
+		}
+	default:
+		return types.Nil, errors.New("unknown aggregate function '" + id + "'")
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(resType),
+			Binding: ref,
+		},
+	})
+	return resType, nil
+}
@@ -99,3 +99,88 @@ func compileStringContains(c *compiler, typ types.Type, ref uint64, id string, c
 		return types.Nil, errors.New("cannot find #string.contains with this type " + types.Type(val.Type).Label())
 	}
 }
+
+func compileStringReplace(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	if call == nil || len(call.Function) != 2 {
+		return types.Nil, errors.New("function " + id + " needs two arguments")
+	}
+
+	args := make([]*llx.Primitive, 2)
+	for i := range call.Function {
+		f := call.Function[i]
+		if f.Value == nil {
+			return types.Nil, errors.New("function " + id + " needs two arguments")
+		}
+
+		val, err := c.compileExpression(f.Value)
+		if err != nil {
+			return types.Nil, err
+		}
+		args[i] = val
+	}
+
+	oldType, err := c.dereferenceType(args[0])
+	if err != nil {
+		return types.Nil, err
+	}
+	if oldType != types.String && oldType != types.Regex && oldType != types.Dict {
+		return types.Nil, errors.New("cannot replace " + oldType.Label() + " in a string, please provide a string or regex")
+	}
+
+	newType, err := c.dereferenceType(args[1])
+	if err != nil {
+		return types.Nil, err
+	}
+	if newType != types.String && newType != types.Dict {
+		return types.Nil, errors.New("cannot replace with " + newType.Label() + ", please provide a string")
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(types.String),
+			Binding: ref,
+			Args:    args,
+		},
+	})
+	return types.String, nil
+}
+
+func compileStringIn(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	if call == nil || len(call.Function) != 1 {
+		return types.Nil, errors.New("function " + id + " needs one argument")
+	}
+
+	f := call.Function[0]
+	if f.Value == nil {
+		return types.Nil, errors.New("function " + id + " needs one argument")
+	}
+
+	val, err := c.compileExpression(f.Value)
+	if err != nil {
+		return types.Nil, err
+	}
+
+	valType, err := c.dereferenceType(val)
+	if err != nil {
+		return types.Nil, err
+	}
+
+	switch valType {
+	case types.Array(types.String), types.Array(types.Dict), types.Dict:
+	default:
+		return types.Nil, errors.New("cannot call " + id + " with " + valType.Label() + ", please provide a list of strings")
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(types.Bool),
+			Binding: ref,
+			Args:    []*llx.Primitive{val},
+		},
+	})
+	return types.Bool, nil
+}
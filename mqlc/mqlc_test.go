@@ -1152,6 +1152,38 @@ func TestCompiler_ArrayResource(t *testing.T) {
 	// ^^
 }
 
+func TestCompiler_ArraySortAggregate(t *testing.T) {
+	compileT(t, "packages.list.sort(name)", func(res *llx.CodeBundle) {
+		chunk := res.CodeV2.Blocks[0].Chunks[2]
+		assert.Equal(t, "fieldSort", chunk.Id)
+		assert.Equal(t, string(types.Array(types.Resource("package"))), chunk.Function.Type)
+	})
+
+	compileT(t, "packages.list.groupBy(name)", func(res *llx.CodeBundle) {
+		chunk := res.CodeV2.Blocks[0].Chunks[2]
+		assert.Equal(t, "groupBy", chunk.Id)
+		assert.Equal(t, string(types.Map(types.String, types.Array(types.Resource("package")))), chunk.Function.Type)
+	})
+
+	compileT(t, "packages.list.map(name).max", func(res *llx.CodeBundle) {
+		assertFunction(t, "max", &llx.Function{
+			Binding: (1 << 32) | 3,
+			Type:    string(types.String),
+		}, res.CodeV2.Blocks[0].Chunks[3])
+	})
+
+	compileT(t, "[1,2,3].avg", func(res *llx.CodeBundle) {
+		assertFunction(t, "avg", &llx.Function{
+			Binding: (1 << 32) | 1,
+			Type:    string(types.Float),
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileErroneous(t, "packages.list.sort", errors.New("cannot sort array of package, must be a basic type. Try using a field argument."), nil)
+	compileErroneous(t, "packages.list.sum", errors.New("cannot compute sum of array of package, must be a number. Try mapping it to a field first."), nil)
+	compileErroneous(t, "['a'].avg", errors.New("cannot compute avg of array of string, must be a number. Try mapping it to a field first."), nil)
+}
+
 func TestCompiler_ResourceFieldGlob(t *testing.T) {
 	compileT(t, "mondoo{*}", func(res *llx.CodeBundle) {
 		assertFunction(t, "mondoo", nil, res.CodeV2.Blocks[0].Chunks[0])
@@ -1574,6 +1606,20 @@ func TestCompiler_StringContainsWithInt(t *testing.T) {
 	})
 }
 
+func TestCompiler_StringReplace(t *testing.T) {
+	compileT(t, "'hello'.replace(/l+/, 'L')", func(res *llx.CodeBundle) {
+		assertFunction(t, "replace", &llx.Function{
+			Type:    string(types.String),
+			Binding: (1 << 32) | 1,
+			Args:    []*llx.Primitive{llx.RegexPrimitive("l+"), llx.StringPrimitive("L")},
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileErroneous(t, "'hello'.replace(1, 'L')", errors.New("cannot replace int in a string, please provide a string or regex"), nil)
+	compileErroneous(t, "'hello'.in('hello')", errors.New("cannot call in with string, please provide a list of strings"), nil)
+	compileErroneous(t, "sprintf(1)", errors.New("the first parameter of 'sprintf' must be a format string, got int"), nil)
+}
+
 func TestCompiler_CallWithResource(t *testing.T) {
 	compileT(t, "users { file(home) }", func(res *llx.CodeBundle) {
 		assertFunction(t, "users", nil, res.CodeV2.Blocks[0].Chunks[0])
@@ -1779,8 +1825,10 @@ func TestSuggestions(t *testing.T) {
 		},
 		{
 			// resource suggestions
+			// windows.security.health fuzzy matches via window[s].[s]ecurity.[h]ealth,
+			// it keeps the name existing Windows queries use
 			"ssh",
-			[]string{"os.unix.sshd", "sshd", "sshd.config"},
+			[]string{"os.unix.sshd", "sshd", "sshd.config", "windows.security.health"},
 			errors.New("cannot find resource for identifier 'ssh'"),
 			nil,
 		},
@@ -15,30 +15,31 @@ var operatorsCompilers map[string]fieldCompiler
 
 func init() {
 	operatorsCompilers = map[string]fieldCompiler{
-		"==":     compileComparable,
-		"=~":     compileComparable,
-		"!=":     compileComparable,
-		"!~":     compileComparable,
-		">=":     compileComparable,
-		">":      compileComparable,
-		"<=":     compileComparable,
-		"<":      compileComparable,
-		"+":      compileTransformation,
-		"-":      compileTransformation,
-		"*":      compileTransformation,
-		"/":      compileTransformation,
-		"%":      nil,
-		"=":      compileAssignment,
-		"||":     compileComparable,
-		"&&":     compileComparable,
-		"{}":     compileBlock,
-		"if":     compileIf,
-		"else":   compileElse,
-		"expect": compileExpect,
-		"score":  compileScore,
-		"typeof": compileTypeof,
-		"switch": compileSwitch,
-		"Never":  compileNever,
+		"==":      compileComparable,
+		"=~":      compileComparable,
+		"!=":      compileComparable,
+		"!~":      compileComparable,
+		">=":      compileComparable,
+		">":       compileComparable,
+		"<=":      compileComparable,
+		"<":       compileComparable,
+		"+":       compileTransformation,
+		"-":       compileTransformation,
+		"*":       compileTransformation,
+		"/":       compileTransformation,
+		"%":       nil,
+		"=":       compileAssignment,
+		"||":      compileComparable,
+		"&&":      compileComparable,
+		"{}":      compileBlock,
+		"if":      compileIf,
+		"else":    compileElse,
+		"expect":  compileExpect,
+		"score":   compileScore,
+		"typeof":  compileTypeof,
+		"sprintf": compileSprintf,
+		"switch":  compileSwitch,
+		"Never":   compileNever,
 	}
 }
 
@@ -483,6 +484,48 @@ func compileTypeof(c *compiler, id string, call *parser.Call) (types.Type, error
 	return types.String, nil
 }
 
+func compileSprintf(c *compiler, id string, call *parser.Call) (types.Type, error) {
+	if call == nil || len(call.Function) < 1 {
+		return types.Nil, errors.New("missing format string for '" + id + "'")
+	}
+
+	args := make([]*llx.Primitive, len(call.Function))
+	for i := range call.Function {
+		arg := call.Function[i]
+		if arg == nil || arg.Value == nil {
+			return types.Nil, errors.New("failed to get parameter for '" + id + "'")
+		}
+		if arg.Name != "" {
+			return types.Nil, errors.New("called '" + id + "' with a named parameter, which is not supported")
+		}
+
+		argValue, err := c.compileExpression(arg.Value)
+		if err != nil {
+			return types.Nil, err
+		}
+		args[i] = argValue
+	}
+
+	formatType, err := c.dereferenceType(args[0])
+	if err != nil {
+		return types.Nil, err
+	}
+	if formatType != types.String && formatType != types.Dict {
+		return types.Nil, errors.New("the first parameter of '" + id + "' must be a format string, got " + formatType.Label())
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   "sprintf",
+		Function: &llx.Function{
+			Type: string(types.String),
+			Args: args,
+		},
+	})
+
+	return types.String, nil
+}
+
 func compileSwitch(c *compiler, id string, call *parser.Call) (types.Type, error) {
 	var ref *llx.Primitive
 
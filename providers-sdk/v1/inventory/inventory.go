@@ -329,3 +329,43 @@ func (c *Config) ToUrl() string {
 
 	return schema + "://" + host + path
 }
+
+// often used discovery targets
+const (
+	DiscoveryAuto = "auto"
+	DiscoveryAll  = "all"
+)
+
+// IncludesOneOfDiscoveryTarget returns true if any of the given targets
+// is requested for discovery by this connection
+func (c *Config) IncludesOneOfDiscoveryTarget(targets ...string) bool {
+	if c.Discover == nil {
+		return false
+	}
+
+	for i := range c.Discover.Targets {
+		for j := range targets {
+			if c.Discover.Targets[i] == targets[j] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IncludesDiscoveredAsset returns true if an asset that was discovered via
+// this connection matches its discovery targets. Assets are matched by
+// the type of their connection, unless all assets are requested via
+// "auto" or "all".
+func (c *Config) IncludesDiscoveredAsset(asset *Asset) bool {
+	if c.IncludesOneOfDiscoveryTarget(DiscoveryAuto, DiscoveryAll) {
+		return true
+	}
+
+	for i := range asset.Connections {
+		if c.IncludesOneOfDiscoveryTarget(asset.Connections[i].Type) {
+			return true
+		}
+	}
+	return false
+}
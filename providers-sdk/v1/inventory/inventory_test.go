@@ -259,3 +259,30 @@ func findAsset(assets []*Asset, id string) *Asset {
 	}
 	return nil
 }
+
+func TestDiscoveryTargets(t *testing.T) {
+	discovered := &Asset{
+		Name:        "container",
+		Connections: []*Config{{Type: "docker-container"}},
+	}
+
+	t.Run("no discovery", func(t *testing.T) {
+		conf := &Config{Type: "docker"}
+		assert.False(t, conf.IncludesOneOfDiscoveryTarget(DiscoveryAuto))
+		assert.False(t, conf.IncludesDiscoveredAsset(discovered))
+	})
+
+	t.Run("auto discovery", func(t *testing.T) {
+		conf := &Config{Type: "docker", Discover: &Discovery{Targets: []string{DiscoveryAuto}}}
+		assert.True(t, conf.IncludesOneOfDiscoveryTarget(DiscoveryAuto, DiscoveryAll))
+		assert.True(t, conf.IncludesDiscoveredAsset(discovered))
+	})
+
+	t.Run("discovery by connection type", func(t *testing.T) {
+		conf := &Config{Type: "docker", Discover: &Discovery{Targets: []string{"docker-container"}}}
+		assert.True(t, conf.IncludesDiscoveredAsset(discovered))
+
+		conf = &Config{Type: "docker", Discover: &Discovery{Targets: []string{"docker-image"}}}
+		assert.False(t, conf.IncludesDiscoveredAsset(discovered))
+	})
+}
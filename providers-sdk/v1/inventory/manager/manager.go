@@ -88,7 +88,9 @@ type inventoryManager struct {
 	// internal vault used to store embedded credentials
 	inmemoryVault vault.Vault
 	// wrapper vault to access the credentials
-	accessVault           vault.Vault
+	accessVault vault.Vault
+	// resolver for the credentials, it is kept to share its cache between connections
+	credsResolver         vault.Resolver
 	credentialQueryRunner *CredentialQueryRunner
 }
 
@@ -203,7 +205,7 @@ func (im *inventoryManager) Resolve(ctx context.Context) map[*inventory.Asset]er
 }
 
 func (im *inventoryManager) GetCredsResolver() vault.Resolver {
-	return credentials_resolver.New(im.accessVault, im.isCached)
+	return im.credsResolver
 }
 
 func (im *inventoryManager) GetCredential(cred *vault.Credential) (*vault.Credential, error) {
@@ -220,6 +222,7 @@ func (im *inventoryManager) resetVault() {
 	} else {
 		im.accessVault = nil
 	}
+	im.credsResolver = credentials_resolver.New(im.accessVault, im.isCached)
 }
 
 func (im *inventoryManager) GetVault() vault.Vault {
@@ -0,0 +1,332 @@
+package inventory
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// MaxExpandedHosts limits the number of hosts a single host range or CIDR
+// notation may expand into
+const MaxExpandedHosts = 65536
+
+var (
+	// matches ${VAR} and ${VAR:-default}
+	envPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+	// matches host ranges like [01:20]
+	hostRangePattern = regexp.MustCompile(`\[(\d+):(\d+)\]`)
+)
+
+// ExpandTemplates turns an inventory template into the list of assets it
+// describes:
+//   - environment variables like ${VAR} or ${VAR:-default} are interpolated
+//   - the defaults are merged into all assets and their connections
+//   - connection hosts with ranges, e.g. web[01:20].example.com, or in CIDR
+//     notation, e.g. 10.0.0.0/24, are expanded into one asset per host
+//
+// It has to be called before PreProcess, so that the credentials of the
+// defaults are extracted for every asset.
+func (p *Inventory) ExpandTemplates() error {
+	if err := interpolateEnv(p.ProtoReflect()); err != nil {
+		return err
+	}
+
+	if p.Spec == nil {
+		return nil
+	}
+
+	if p.Spec.Defaults != nil {
+		for i := range p.Spec.Assets {
+			p.Spec.Defaults.apply(p.Spec.Assets[i])
+		}
+		p.Spec.Defaults = nil
+	}
+
+	assets := make([]*Asset, 0, len(p.Spec.Assets))
+	for i := range p.Spec.Assets {
+		expanded, err := expandAsset(p.Spec.Assets[i])
+		if err != nil {
+			return err
+		}
+		assets = append(assets, expanded...)
+	}
+	p.Spec.Assets = assets
+
+	return nil
+}
+
+// apply merges the defaults into the asset. Values that are already set
+// on the asset and its connections are kept.
+func (d *AssetDefaults) apply(asset *Asset) {
+	asset.Labels = mergeDefaults(asset.Labels, d.Labels)
+	asset.Annotations = mergeDefaults(asset.Annotations, d.Annotations)
+	asset.Options = mergeDefaults(asset.Options, d.Options)
+
+	if d.Connection == nil {
+		return
+	}
+
+	if len(asset.Connections) == 0 {
+		asset.Connections = []*Config{proto.Clone(d.Connection).(*Config)}
+		return
+	}
+
+	for i := range asset.Connections {
+		conn := asset.Connections[i]
+		merged := proto.Clone(d.Connection).(*Config)
+
+		// lists and nested settings are replaced instead of merged, e.g. an
+		// asset with its own credentials does not use the default ones
+		if len(conn.Credentials) > 0 {
+			merged.Credentials = nil
+		}
+		if conn.Sudo != nil {
+			merged.Sudo = nil
+		}
+		if conn.Discover != nil {
+			merged.Discover = nil
+		}
+
+		proto.Merge(merged, conn)
+		asset.Connections[i] = merged
+	}
+}
+
+func mergeDefaults(values map[string]string, defaults map[string]string) map[string]string {
+	if len(defaults) == 0 {
+		return values
+	}
+	if values == nil {
+		values = make(map[string]string, len(defaults))
+	}
+	for k, v := range defaults {
+		if _, ok := values[k]; !ok {
+			values[k] = v
+		}
+	}
+	return values
+}
+
+// expandAsset returns one asset for every host of its connections. The host
+// template is replaced in the asset name and id, which therefore must
+// include it to stay unique.
+func expandAsset(asset *Asset) ([]*Asset, error) {
+	for i := range asset.Connections {
+		host := asset.Connections[i].Host
+		hosts, err := ExpandHosts(host)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot expand hosts for asset "+asset.Name)
+		}
+		if len(hosts) == 1 && hosts[0] == host {
+			continue
+		}
+
+		if asset.Id != "" && !strings.Contains(asset.Id, host) {
+			return nil, errors.New("asset id " + asset.Id + " must include the host template " + host)
+		}
+
+		res := []*Asset{}
+		for _, h := range hosts {
+			a := proto.Clone(asset).(*Asset)
+			a.Connections[i].Host = h
+			a.Name = strings.ReplaceAll(a.Name, host, h)
+			a.Id = strings.ReplaceAll(a.Id, host, h)
+
+			// other connections may be templates as well
+			expanded, err := expandAsset(a)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, expanded...)
+			if len(res) > MaxExpandedHosts {
+				return nil, errors.New("asset " + asset.Name + " expands into more than " + strconv.Itoa(MaxExpandedHosts) + " assets")
+			}
+		}
+		return res, nil
+	}
+
+	return []*Asset{asset}, nil
+}
+
+// ExpandHosts expands a host template into the hosts it describes. Ranges
+// like web[01:20].example.com are expanded into web01.example.com to
+// web20.example.com, keeping leading zeros. A host may include multiple
+// ranges. CIDR notations like 10.0.0.0/24 are expanded into all usable
+// addresses of the network. Other hosts are returned unchanged.
+func ExpandHosts(host string) ([]string, error) {
+	if strings.Contains(host, "/") {
+		if _, network, err := net.ParseCIDR(host); err == nil {
+			return expandCIDR(network)
+		}
+	}
+
+	loc := hostRangePattern.FindStringSubmatchIndex(host)
+	if loc == nil {
+		return []string{host}, nil
+	}
+
+	from, to := host[loc[2]:loc[3]], host[loc[4]:loc[5]]
+	start, err := strconv.Atoi(from)
+	if err != nil {
+		return nil, errors.New("invalid host range " + host[loc[0]:loc[1]])
+	}
+	end, err := strconv.Atoi(to)
+	if err != nil {
+		return nil, errors.New("invalid host range " + host[loc[0]:loc[1]])
+	}
+	if start > end {
+		return nil, errors.New("invalid host range " + host[loc[0]:loc[1]] + ", start is larger than end")
+	}
+	if end-start >= MaxExpandedHosts {
+		return nil, errors.New("host range " + host[loc[0]:loc[1]] + " is too large")
+	}
+
+	// ranges starting with a leading zero are padded, e.g. [01:20]
+	width := 0
+	if len(from) > 1 && from[0] == '0' {
+		width = len(from)
+	}
+
+	prefix, suffix := host[:loc[0]], host[loc[1]:]
+	res := []string{}
+	for i := start; i <= end; i++ {
+		// the remaining host may include more ranges
+		hosts, err := ExpandHosts(prefix + fmt.Sprintf("%0*d", width, i) + suffix)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, hosts...)
+		if len(res) > MaxExpandedHosts {
+			return nil, errors.New("host " + host + " expands into more than " + strconv.Itoa(MaxExpandedHosts) + " hosts")
+		}
+	}
+	return res, nil
+}
+
+// expandCIDR returns all addresses of a network. The network and broadcast
+// addresses of IPv4 networks are skipped, unless they are point-to-point
+// links (/31) or single hosts (/32).
+func expandCIDR(network *net.IPNet) ([]string, error) {
+	ones, bits := network.Mask.Size()
+	if bits-ones > 16 {
+		return nil, errors.New("network " + network.String() + " expands into more than " + strconv.Itoa(MaxExpandedHosts) + " hosts")
+	}
+
+	size := 1 << (bits - ones)
+	first, last := 0, size-1
+	if bits == 32 && size > 2 {
+		first, last = 1, size-2
+	}
+
+	base := new(big.Int).SetBytes(network.IP)
+	res := make([]string, 0, last-first+1)
+	for i := first; i <= last; i++ {
+		n := new(big.Int).Add(base, big.NewInt(int64(i)))
+		ip := make(net.IP, len(network.IP))
+		n.FillBytes(ip)
+		res = append(res, ip.String())
+	}
+	return res, nil
+}
+
+// interpolateEnv replaces environment variables in all string fields of a
+// message, incl. nested messages, lists and map values
+func interpolateEnv(m protoreflect.Message) error {
+	type field struct {
+		fd protoreflect.FieldDescriptor
+		v  protoreflect.Value
+	}
+
+	// collect the fields first, the message must not be modified while
+	// ranging over it
+	fields := []field{}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		fields = append(fields, field{fd: fd, v: v})
+		return true
+	})
+
+	for _, f := range fields {
+		switch {
+		case f.fd.IsList():
+			list := f.v.List()
+			for i := 0; i < list.Len(); i++ {
+				v, err := interpolateValue(f.fd.Kind(), list.Get(i))
+				if err != nil {
+					return err
+				}
+				list.Set(i, v)
+			}
+
+		case f.fd.IsMap():
+			values := f.v.Map()
+			keys := []protoreflect.MapKey{}
+			values.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+				keys = append(keys, k)
+				return true
+			})
+			for _, k := range keys {
+				v, err := interpolateValue(f.fd.MapValue().Kind(), values.Get(k))
+				if err != nil {
+					return err
+				}
+				values.Set(k, v)
+			}
+
+		default:
+			v, err := interpolateValue(f.fd.Kind(), f.v)
+			if err != nil {
+				return err
+			}
+			m.Set(f.fd, v)
+		}
+	}
+	return nil
+}
+
+func interpolateValue(kind protoreflect.Kind, v protoreflect.Value) (protoreflect.Value, error) {
+	switch kind {
+	case protoreflect.StringKind:
+		s, err := InterpolateEnv(v.String())
+		if err != nil {
+			return v, err
+		}
+		return protoreflect.ValueOfString(s), nil
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return v, interpolateEnv(v.Message())
+	default:
+		return v, nil
+	}
+}
+
+// InterpolateEnv replaces ${VAR} with the value of the environment variable
+// VAR. If the variable is not set, the default of ${VAR:-default} is used or
+// an error is returned.
+func InterpolateEnv(s string) (string, error) {
+	if !strings.Contains(s, "${") {
+		return s, nil
+	}
+
+	var err error
+	res := envPattern.ReplaceAllStringFunc(s, func(match string) string {
+		m := envPattern.FindStringSubmatch(match)
+		if v, ok := os.LookupEnv(m[1]); ok {
+			return v
+		}
+		if m[2] != "" {
+			return m[3]
+		}
+		if err == nil {
+			err = errors.New("environment variable " + m[1] + " is not set")
+		}
+		return match
+	})
+	return res, err
+}
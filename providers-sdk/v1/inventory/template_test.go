@@ -0,0 +1,132 @@
+package inventory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/providers-sdk/v1/vault"
+)
+
+func TestExpandHosts(t *testing.T) {
+	tests := []struct {
+		host string
+		res  []string
+	}{
+		{"example.com", []string{"example.com"}},
+		{"192.168.1.1", []string{"192.168.1.1"}},
+		{"web[1:3].example.com", []string{"web1.example.com", "web2.example.com", "web3.example.com"}},
+		{"web[08:10]", []string{"web08", "web09", "web10"}},
+		{"rack[1:2]-node[01:02]", []string{"rack1-node01", "rack1-node02", "rack2-node01", "rack2-node02"}},
+		{"10.0.0.0/30", []string{"10.0.0.1", "10.0.0.2"}},
+		{"10.0.0.8/31", []string{"10.0.0.8", "10.0.0.9"}},
+		{"10.0.0.5/32", []string{"10.0.0.5"}},
+		{"10.0.1.255/23", []string{}},
+		{"fd00::/127", []string{"fd00::", "fd00::1"}},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.host, func(t *testing.T) {
+			res, err := ExpandHosts(test.host)
+			require.NoError(t, err)
+			if test.host == "10.0.1.255/23" {
+				assert.Len(t, res, 510)
+				assert.Equal(t, "10.0.0.1", res[0])
+				assert.Equal(t, "10.0.1.254", res[509])
+				return
+			}
+			assert.Equal(t, test.res, res)
+		})
+	}
+
+	_, err := ExpandHosts("web[3:1]")
+	assert.Error(t, err)
+	_, err = ExpandHosts("10.0.0.0/8")
+	assert.Error(t, err)
+	_, err = ExpandHosts("a[0:1000]b[0:1000]")
+	assert.Error(t, err)
+}
+
+func TestInterpolateEnv(t *testing.T) {
+	t.Setenv("INVENTORY_TEST_HOST", "example.com")
+
+	s, err := InterpolateEnv("ssh://${INVENTORY_TEST_HOST}:${INVENTORY_TEST_PORT:-22}")
+	require.NoError(t, err)
+	assert.Equal(t, "ssh://example.com:22", s)
+
+	s, err = InterpolateEnv("no $VARIABLE")
+	require.NoError(t, err)
+	assert.Equal(t, "no $VARIABLE", s)
+
+	_, err = InterpolateEnv("${INVENTORY_TEST_MISSING}")
+	assert.EqualError(t, err, "environment variable INVENTORY_TEST_MISSING is not set")
+}
+
+func TestExpandTemplates(t *testing.T) {
+	t.Setenv("INVENTORY_TEST_USER", "chris")
+	t.Setenv("INVENTORY_TEST_PASSWORD", "s3cr3t")
+
+	inventory, err := InventoryFromFile("./testdata/template_inventory.yaml")
+	require.NoError(t, err)
+	require.NoError(t, inventory.ExpandTemplates())
+	assert.Nil(t, inventory.Spec.Defaults)
+
+	assets := inventory.Spec.Assets
+	require.Len(t, assets, 6)
+
+	names := []string{}
+	for i := range assets {
+		names = append(names, assets[i].Name)
+	}
+	assert.Equal(t, []string{
+		"web01.example.com", "web02.example.com", "web03.example.com",
+		"10.0.0.1", "10.0.0.2", "bastion",
+	}, names)
+
+	web := findAsset(assets, "web02.example.com")
+	require.NotNil(t, web)
+	assert.Equal(t, "staging", web.Labels["environment"])
+	require.Len(t, web.Connections, 1)
+	conn := web.Connections[0]
+	assert.Equal(t, "web02.example.com", conn.Host)
+	assert.Equal(t, "ssh", conn.Type)
+	assert.Equal(t, int32(22), conn.Port)
+	require.Len(t, conn.Credentials, 1)
+	assert.Equal(t, "chris", conn.Credentials[0].User)
+	assert.Equal(t, "s3cr3t", conn.Credentials[0].Password)
+
+	// every asset has its own copy of the default credentials
+	assert.NotSame(t, conn.Credentials[0], assets[0].Connections[0].Credentials[0])
+
+	network := assets[3]
+	assert.Equal(t, "10.0.0.1", network.Connections[0].Host)
+	assert.Equal(t, int32(2222), network.Connections[0].Port)
+
+	bastion := assets[5]
+	assert.Equal(t, "production", bastion.Labels["environment"])
+	require.Len(t, bastion.Connections[0].Credentials, 1)
+	assert.Equal(t, vault.CredentialType_ssh_agent, bastion.Connections[0].Credentials[0].Type)
+	assert.Equal(t, "admin", bastion.Connections[0].Credentials[0].User)
+
+	// the credentials are extracted for every expanded asset
+	require.NoError(t, inventory.PreProcess())
+	require.NoError(t, inventory.Validate())
+	assert.Len(t, inventory.Spec.Credentials, 6)
+}
+
+func TestExpandTemplatesErrors(t *testing.T) {
+	t.Run("missing environment variable", func(t *testing.T) {
+		inventory, err := InventoryFromFile("./testdata/template_inventory.yaml")
+		require.NoError(t, err)
+		assert.Error(t, inventory.ExpandTemplates())
+	})
+
+	t.Run("asset id without host template", func(t *testing.T) {
+		inventory := New(WithAssets(&Asset{
+			Id:          "web",
+			Connections: []*Config{{Host: "web[1:2]"}},
+		}))
+		assert.Error(t, inventory.ExpandTemplates())
+	})
+}
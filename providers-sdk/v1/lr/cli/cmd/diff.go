@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"go.mondoo.com/cnquery/providers-sdk/v1/lr"
+	"go.mondoo.com/cnquery/providers-sdk/v1/lr/docs"
+	"go.mondoo.com/cnquery/providers-sdk/v1/resources"
+	"sigs.k8s.io/yaml"
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff OLD NEW",
+	Short: "compare two versions of a provider's resources",
+	Long: `compare two LR files or generated resources JSON files and report all changes as additive or breaking.
+Changes to experimental or deprecated resources and to resources and fields that are newer than the
+released version are not considered breaking. Exits with a non-zero code on breaking changes.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		released, err := cmd.Flags().GetString("released-version")
+		if err != nil {
+			log.Fatal().Err(err).Msg("invalid argument for `released-version`")
+		}
+
+		old, oldManifest, err := loadDiffSchema(args[0])
+		if err != nil {
+			log.Fatal().Err(err).Str("path", args[0]).Msg("failed to load old resources")
+		}
+		cur, curManifest, err := loadDiffSchema(args[1])
+		if err != nil {
+			log.Fatal().Err(err).Str("path", args[1]).Msg("failed to load new resources")
+		}
+
+		changes, err := lr.DiffSchemas(old, cur, lr.SchemaDiffConfig{
+			OldManifest:     oldManifest,
+			NewManifest:     curManifest,
+			ReleasedVersion: released,
+		})
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to compare resources")
+		}
+
+		for i := range changes {
+			fmt.Println(changes[i].String())
+		}
+
+		if lr.HasBreakingChanges(changes) {
+			log.Error().Msg("found breaking changes")
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	diffCmd.Flags().String("released-version", "", "version of the last release, resources and fields with a newer min_mondoo_version are not released yet")
+	rootCmd.AddCommand(diffCmd)
+}
+
+// loadDiffSchema loads the schema of an LR file or a resources JSON file.
+// The manifest next to it is loaded as well, if it exists. Resources JSON
+// files already include the min_mondoo_version of the manifest.
+func loadDiffSchema(file string) (*resources.Schema, *docs.LrDocs, error) {
+	var schema *resources.Schema
+	var manifestPath string
+	isLR := !strings.HasSuffix(file, ".json")
+
+	if !isLR {
+		raw, err := os.ReadFile(file)
+		if err != nil {
+			return nil, nil, err
+		}
+		schema = &resources.Schema{}
+		if err := json.Unmarshal(raw, schema); err != nil {
+			return nil, nil, errors.Wrap(err, "failed to parse resources json")
+		}
+		manifestPath = strings.TrimSuffix(file, ".resources.json") + ".lr.manifest.yaml"
+	} else {
+		res, err := lr.Resolve(file, func(path string) ([]byte, error) {
+			return os.ReadFile(path)
+		})
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to resolve")
+		}
+		schema, err = lr.Schema(res)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to generate schema")
+		}
+		manifestPath = file + ".manifest.yaml"
+	}
+
+	raw, err := os.ReadFile(manifestPath)
+	if os.IsNotExist(err) {
+		return schema, nil, nil
+	} else if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to read manifest")
+	}
+
+	var manifest docs.LrDocs
+	if err := yaml.Unmarshal(raw, &manifest); err != nil {
+		return nil, nil, errors.Wrap(err, "could not load yaml data")
+	}
+	if isLR {
+		injectMetadata(schema, &manifest)
+	}
+
+	return schema, &manifest, nil
+}
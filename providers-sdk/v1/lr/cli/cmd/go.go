@@ -6,6 +6,7 @@ import (
 	"path"
 	"strings"
 
+	"github.com/cockroachdb/errors"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 	"go.mondoo.com/cnquery/providers-sdk/v1/lr"
@@ -25,76 +26,84 @@ var goCmd = &cobra.Command{
 			log.Fatal().Err(err).Msg("failed to get dist flag")
 		}
 
-		file := args[0]
-		packageName := path.Base(path.Dir(file))
-
-		res, err := lr.Resolve(file, func(path string) ([]byte, error) {
-			return os.ReadFile(path)
-		})
-		if err != nil {
-			log.Fatal().Err(err).Msg("failed to resolve")
-			return
+		if err := generateGo(args[0], dist); err != nil {
+			log.Fatal().Err(err).Msg("failed to generate go code")
 		}
+	},
+}
 
-		collector := lr.NewCollector(args[0])
-		godata, err := lr.Go(packageName, res, collector)
-		if err != nil {
-			log.Fatal().Err(err).Msg("failed to compile go code")
-		}
+// generateGo converts an LR file to go and writes the resources schema next
+// to it. If dist is set, the schema is also written to that folder.
+func generateGo(file string, dist string) error {
+	packageName := path.Base(path.Dir(file))
 
-		err = os.WriteFile(args[0]+".go", []byte(godata), 0o644)
-		if err != nil {
-			log.Fatal().Err(err).Msg("failed to write to go file")
-		}
+	res, err := lr.Resolve(file, func(path string) ([]byte, error) {
+		return os.ReadFile(path)
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve")
+	}
 
-		schema, err := lr.Schema(res)
-		if err != nil {
-			log.Fatal().Err(err).Msg("failed to generate schema")
-		}
+	collector := lr.NewCollector(file)
+	godata, err := lr.Go(packageName, res, collector)
+	if err != nil {
+		return errors.Wrap(err, "failed to compile go code")
+	}
 
-		// we will attempt to auto-detect the manifest to inject some metadata
-		// into the schema
-		manifestPath := file + ".manifest.yaml"
-		raw, err := os.ReadFile(manifestPath)
-		if err == nil {
-			var lrDocsData docs.LrDocs
-			err = yaml.Unmarshal(raw, &lrDocsData)
-			if err != nil {
-				log.Fatal().Err(err).Msg("could not load yaml data")
-			}
+	err = os.WriteFile(file+".go", []byte(godata), 0o644)
+	if err != nil {
+		return errors.Wrap(err, "failed to write to go file")
+	}
 
-			injectMetadata(schema, &lrDocsData)
-		} else if os.IsNotExist(err) {
-			log.Info().Str("path", manifestPath).Msg("no manifest found, ignoring")
-		} else {
-			log.Fatal().Err(err).Str("path", manifestPath).Msg("failed to read manifest")
-		}
+	schema, err := lr.Schema(res)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate schema")
+	}
 
-		schemaData, err := json.Marshal(schema)
+	// we will attempt to auto-detect the manifest to inject some metadata
+	// into the schema
+	manifestPath := file + ".manifest.yaml"
+	raw, err := os.ReadFile(manifestPath)
+	if err == nil {
+		var lrDocsData docs.LrDocs
+		err = yaml.Unmarshal(raw, &lrDocsData)
 		if err != nil {
-			log.Fatal().Err(err).Msg("failed to generate schema json")
+			return errors.Wrap(err, "could not load yaml data")
 		}
 
-		base := path.Base(args[0])
-		base = strings.TrimSuffix(base, ".lr")
+		injectMetadata(schema, &lrDocsData)
+	} else if os.IsNotExist(err) {
+		log.Info().Str("path", manifestPath).Msg("no manifest found, ignoring")
+	} else {
+		return errors.Wrap(err, "failed to read manifest "+manifestPath)
+	}
+
+	schemaData, err := json.Marshal(schema)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate schema json")
+	}
+
+	base := path.Base(file)
+	base = strings.TrimSuffix(base, ".lr")
+
+	dst := strings.TrimSuffix(file, ".lr") + ".resources.json"
+	err = os.WriteFile(dst, []byte(schemaData), 0o644)
+	if err != nil {
+		return errors.Wrap(err, "failed to write schema json "+dst)
+	}
 
-		dst := strings.TrimSuffix(file, ".lr") + ".resources.json"
-		err = os.WriteFile(dst, []byte(schemaData), 0o644)
+	if dist != "" {
+		if err = os.MkdirAll(dist, 0o755); err != nil {
+			return errors.Wrap(err, "failed to create dist folder")
+		}
+		infoFile := path.Join(dist, base+".resources.json")
+		err = os.WriteFile(infoFile, []byte(schemaData), 0o644)
 		if err != nil {
-			log.Fatal().Err(err).Str("dst", dst).Msg("failed to write schema json")
+			return errors.Wrap(err, "failed to write schema json "+infoFile)
 		}
+	}
 
-		if dist != "" {
-			if err = os.MkdirAll(dist, 0o755); err != nil {
-				log.Fatal().Err(err).Msg("failed to create dist folder")
-			}
-			infoFile := path.Join(dist, base+".resources.json")
-			err = os.WriteFile(infoFile, []byte(schemaData), 0o644)
-			if err != nil {
-				log.Fatal().Err(err).Str("dst", infoFile).Msg("failed to write schema json")
-			}
-		}
-	},
+	return nil
 }
 
 func init() {
@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"bytes"
+	"embed"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/cockroachdb/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+//go:embed templates/provider
+var providerTemplates embed.FS
+
+const providerTemplatesRoot = "templates/provider"
+
+// provider names are used as go package name, connection type and resource
+var reProviderName = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+
+type providerTemplateData struct {
+	// Name of the provider, e.g. example
+	Name string
+	// Title is the name used in go identifiers, e.g. Example
+	Title string
+	// GoPackage of the provider, which is also its ID
+	GoPackage string
+	// Version of the provider
+	Version string
+}
+
+var newProviderCmd = &cobra.Command{
+	Use:   "new-provider NAME",
+	Short: "generate the skeleton of a new provider",
+	Long: `generate a new provider with a config, connection, service and an example resource,
+including its generated go code, a manifest and a test using the mock connection`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+
+		dst, err := cmd.Flags().GetString("path")
+		if err != nil {
+			log.Fatal().Err(err).Msg("invalid argument for `path`")
+		}
+		if dst == "" {
+			dst = filepath.Join("providers", name)
+		}
+
+		goPackage, err := cmd.Flags().GetString("go-package")
+		if err != nil {
+			log.Fatal().Err(err).Msg("invalid argument for `go-package`")
+		}
+		if goPackage == "" {
+			goPackage = "go.mondoo.com/cnquery/providers/" + name
+		}
+
+		version, err := cmd.Flags().GetString("version")
+		if err != nil {
+			log.Fatal().Err(err).Msg("invalid argument for `version`")
+		}
+
+		err = newProvider(dst, providerTemplateData{
+			Name:      name,
+			GoPackage: goPackage,
+			Version:   version,
+		})
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to create provider")
+		}
+
+		log.Info().Str("path", dst).Msg("created provider " + name)
+		log.Info().Msg("add it to the Makefile with: @$(call genProvider, " + filepath.ToSlash(dst) + ")")
+	},
+}
+
+func init() {
+	newProviderCmd.Flags().String("path", "", "folder for the new provider, default is providers/NAME")
+	newProviderCmd.Flags().String("go-package", "", "go package of the new provider, default is go.mondoo.com/cnquery/providers/NAME")
+	newProviderCmd.Flags().String("version", "9.0.0", "initial version of the new provider")
+	rootCmd.AddCommand(newProviderCmd)
+}
+
+// newProvider writes the skeleton of a new provider to dst and generates
+// the go code for its resources
+func newProvider(dst string, data providerTemplateData) error {
+	if !reProviderName.MatchString(data.Name) {
+		return errors.New("invalid provider name '" + data.Name + "', it may only contain lowercase letters and digits and must start with a letter")
+	}
+	data.Title = strings.ToUpper(data.Name[:1]) + data.Name[1:]
+
+	entries, err := os.ReadDir(dst)
+	if err == nil && len(entries) != 0 {
+		return errors.New("folder " + dst + " already exists and is not empty")
+	} else if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	// all templates are rendered before anything is written, so that no
+	// half-finished provider is left behind
+	files := map[string][]byte{}
+	err = fs.WalkDir(providerTemplates, providerTemplatesRoot, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		raw, err := providerTemplates.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		tpl, err := template.New(path).Parse(string(raw))
+		if err != nil {
+			return errors.Wrap(err, "failed to parse template "+path)
+		}
+
+		var out bytes.Buffer
+		if err := tpl.Execute(&out, data); err != nil {
+			return errors.Wrap(err, "failed to render template "+path)
+		}
+
+		// resources/name.lr.tmpl turns into resources/NAME.lr
+		rel := strings.TrimSuffix(strings.TrimPrefix(path, providerTemplatesRoot+"/"), ".tmpl")
+		dir, file := filepath.Split(filepath.FromSlash(rel))
+		if strings.HasPrefix(file, "name.") {
+			file = data.Name + strings.TrimPrefix(file, "name")
+		}
+
+		files[filepath.Join(dst, dir, file)] = out.Bytes()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for target, content := range files {
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(target, content, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return generateGo(filepath.Join(dst, "resources", data.Name+".lr"), "")
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProvider(t *testing.T) {
+	dst := filepath.Join(t.TempDir(), "example")
+	data := providerTemplateData{
+		Name:      "example",
+		GoPackage: "go.mondoo.com/cnquery/providers/example",
+		Version:   "9.0.0",
+	}
+	require.NoError(t, newProvider(dst, data))
+
+	for _, file := range []string{
+		"main.go",
+		"config/config.go",
+		"connection/connection.go",
+		"gen/main.go",
+		"provider/provider.go",
+		"provider/provider_test.go",
+		"resources/example.go",
+		"resources/example.lr",
+		"resources/example.lr.manifest.yaml",
+		"resources/example.lr.go",
+		"resources/example.resources.json",
+	} {
+		assert.FileExists(t, filepath.Join(dst, file))
+	}
+
+	raw, err := os.ReadFile(filepath.Join(dst, "connection/connection.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(raw), "type ExampleConnection struct")
+
+	// the id implementation of the example resource is detected
+	raw, err = os.ReadFile(filepath.Join(dst, "resources/example.lr.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(raw), "res.__id, err = res.id()")
+
+	raw, err = os.ReadFile(filepath.Join(dst, "resources/example.resources.json"))
+	require.NoError(t, err)
+	assert.Contains(t, string(raw), `"min_mondoo_version":"9.0.0"`)
+
+	t.Run("existing provider", func(t *testing.T) {
+		assert.Error(t, newProvider(dst, data))
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		data := data
+		data.Name = "my-provider"
+		assert.Error(t, newProvider(filepath.Join(t.TempDir(), "invalid"), data))
+	})
+}
@@ -0,0 +1,293 @@
+package lr
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-version"
+	"go.mondoo.com/cnquery/providers-sdk/v1/lr/docs"
+	"go.mondoo.com/cnquery/providers-sdk/v1/resources"
+	"go.mondoo.com/cnquery/types"
+)
+
+// SchemaChangeKind classifies how a schema change affects existing queries
+type SchemaChangeKind int
+
+const (
+	// AdditiveChange keeps all existing queries working
+	AdditiveChange SchemaChangeKind = iota
+	// BreakingChange may break existing queries
+	BreakingChange
+	// UnstableChange would be breaking, but only affects resources that are
+	// experimental or deprecated, or that were never released
+	UnstableChange
+)
+
+func (k SchemaChangeKind) String() string {
+	switch k {
+	case AdditiveChange:
+		return "additive"
+	case BreakingChange:
+		return "breaking"
+	case UnstableChange:
+		return "unstable"
+	default:
+		return "unknown"
+	}
+}
+
+// SchemaChange is a change of a resource or one of its fields
+type SchemaChange struct {
+	Kind     SchemaChangeKind
+	Resource string
+	// Field is empty for changes of the resource itself
+	Field   string
+	Message string
+}
+
+func (c SchemaChange) String() string {
+	id := c.Resource
+	if c.Field != "" {
+		id += "." + c.Field
+	}
+	return c.Kind.String() + ": " + id + " " + c.Message
+}
+
+// SchemaDiffConfig adds the information that isn't part of the schema
+// to classify changes
+type SchemaDiffConfig struct {
+	// OldManifest and NewManifest provide the maturity of resources. Changes
+	// to experimental and deprecated resources are not breaking.
+	OldManifest *docs.LrDocs
+	NewManifest *docs.LrDocs
+	// ReleasedVersion is the version of the last release. Resources and fields
+	// with a newer min_mondoo_version were never released, so changing them
+	// is not breaking.
+	ReleasedVersion string
+}
+
+// DiffSchemas compares two versions of a provider's schema and returns all
+// changes, which affect queries against it
+func DiffSchemas(old *resources.Schema, cur *resources.Schema, conf SchemaDiffConfig) ([]SchemaChange, error) {
+	d := schemaDiff{conf: conf}
+	if conf.ReleasedVersion != "" {
+		v, err := version.NewVersion(conf.ReleasedVersion)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid released version")
+		}
+		d.released = v
+	}
+
+	for name, oldInfo := range old.Resources {
+		curInfo, ok := cur.Resources[name]
+		if !ok {
+			d.breaking(oldInfo, nil, name, "", "was removed")
+			continue
+		}
+		d.resource(name, oldInfo, curInfo)
+	}
+
+	for name := range cur.Resources {
+		if _, ok := old.Resources[name]; !ok {
+			d.add(AdditiveChange, name, "", "was added")
+		}
+	}
+
+	sort.Slice(d.changes, func(i, j int) bool {
+		a, b := d.changes[i], d.changes[j]
+		if a.Resource != b.Resource {
+			return a.Resource < b.Resource
+		}
+		if a.Field != b.Field {
+			return a.Field < b.Field
+		}
+		return a.Message < b.Message
+	})
+
+	return d.changes, d.err
+}
+
+// HasBreakingChanges is true if any of the changes breaks existing queries
+func HasBreakingChanges(changes []SchemaChange) bool {
+	for i := range changes {
+		if changes[i].Kind == BreakingChange {
+			return true
+		}
+	}
+	return false
+}
+
+type schemaDiff struct {
+	conf     SchemaDiffConfig
+	released *version.Version
+	changes  []SchemaChange
+	err      error
+}
+
+func (d *schemaDiff) add(kind SchemaChangeKind, resource string, field string, msg string) {
+	d.changes = append(d.changes, SchemaChange{
+		Kind:     kind,
+		Resource: resource,
+		Field:    field,
+		Message:  msg,
+	})
+}
+
+// breaking adds a change that breaks existing queries, unless the resource
+// or field of the old schema is not stable
+func (d *schemaDiff) breaking(info *resources.ResourceInfo, field *resources.Field, resource string, fieldName string, msg string) {
+	kind := BreakingChange
+	if !d.isStable(resource, info, field) {
+		kind = UnstableChange
+	}
+	d.add(kind, resource, fieldName, msg)
+}
+
+func (d *schemaDiff) isStable(resource string, info *resources.ResourceInfo, field *resources.Field) bool {
+	switch d.maturity(resource) {
+	case "experimental", "deprecated":
+		return false
+	}
+
+	if d.released == nil {
+		return true
+	}
+	if field != nil && field.MinMondooVersion != "" {
+		return d.isReleased(field.MinMondooVersion)
+	}
+	if info != nil && info.MinMondooVersion != "" {
+		return d.isReleased(info.MinMondooVersion)
+	}
+	return true
+}
+
+func (d *schemaDiff) isReleased(minVersion string) bool {
+	// used by the docs skeletons for resources of the upcoming release
+	if minVersion == "latest" {
+		return false
+	}
+
+	v, err := version.NewVersion(minVersion)
+	if err != nil {
+		if d.err == nil {
+			d.err = errors.Wrap(err, "invalid min_mondoo_version")
+		}
+		return true
+	}
+	return !v.GreaterThan(d.released)
+}
+
+// maturity of a resource, which is taken from the old manifest if it
+// is set there, since this is what users relied on
+func (d *schemaDiff) maturity(resource string) string {
+	for _, manifest := range []*docs.LrDocs{d.conf.OldManifest, d.conf.NewManifest} {
+		if manifest == nil {
+			continue
+		}
+		if entry, ok := manifest.Resources[resource]; ok && entry != nil && entry.Maturity != "" {
+			return entry.Maturity
+		}
+	}
+	return ""
+}
+
+func (d *schemaDiff) resource(name string, old *resources.ResourceInfo, cur *resources.ResourceInfo) {
+	if !old.Private && cur.Private {
+		d.breaking(old, nil, name, "", "became private")
+	} else if old.Private && !cur.Private {
+		d.add(AdditiveChange, name, "", "became public")
+	}
+
+	if old.ListType != cur.ListType {
+		d.breaking(old, nil, name, "", "changed its list type from "+typeLabel(old.ListType)+" to "+typeLabel(cur.ListType))
+	}
+
+	d.init(name, old, cur)
+
+	for fieldName, oldField := range old.Fields {
+		curField, ok := cur.Fields[fieldName]
+		if !ok {
+			d.breaking(old, oldField, name, fieldName, "was removed")
+			continue
+		}
+
+		if oldField.Type != curField.Type {
+			d.breaking(old, oldField, name, fieldName, "changed its type from "+typeLabel(oldField.Type)+" to "+typeLabel(curField.Type))
+		}
+		if !oldField.IsPrivate && curField.IsPrivate {
+			d.breaking(old, oldField, name, fieldName, "became private")
+		} else if oldField.IsPrivate && !curField.IsPrivate {
+			d.add(AdditiveChange, name, fieldName, "became public")
+		}
+	}
+
+	for fieldName := range cur.Fields {
+		if _, ok := old.Fields[fieldName]; !ok {
+			d.add(AdditiveChange, name, fieldName, "was added")
+		}
+	}
+}
+
+// init compares the init arguments of a resource. Renamed arguments are
+// reported as removed and added. Since init arguments can be passed by
+// position, moving an argument is a breaking change.
+func (d *schemaDiff) init(name string, old *resources.ResourceInfo, cur *resources.ResourceInfo) {
+	var oldArgs, curArgs []*resources.TypedArg
+	if old.Init != nil {
+		oldArgs = old.Init.Args
+	}
+	if cur.Init != nil {
+		curArgs = cur.Init.Args
+	}
+
+	curByName := make(map[string]*resources.TypedArg, len(curArgs))
+	curPos := make(map[string]int, len(curArgs))
+	for i := range curArgs {
+		curByName[curArgs[i].Name] = curArgs[i]
+		curPos[curArgs[i].Name] = i
+	}
+	oldByName := make(map[string]*resources.TypedArg, len(oldArgs))
+	for i := range oldArgs {
+		oldByName[oldArgs[i].Name] = oldArgs[i]
+	}
+
+	for i := range oldArgs {
+		oldArg := oldArgs[i]
+		curArg, ok := curByName[oldArg.Name]
+		if !ok {
+			d.breaking(old, nil, name, "", "removed init argument "+oldArg.Name)
+			continue
+		}
+		if pos := curPos[oldArg.Name]; pos != i {
+			d.breaking(old, nil, name, "", "moved init argument "+oldArg.Name+" from position "+strconv.Itoa(i+1)+" to "+strconv.Itoa(pos+1))
+		}
+		if oldArg.Type != curArg.Type {
+			d.breaking(old, nil, name, "", "changed the type of init argument "+oldArg.Name+" from "+typeLabel(oldArg.Type)+" to "+typeLabel(curArg.Type))
+		}
+		if oldArg.Optional && !curArg.Optional {
+			d.breaking(old, nil, name, "", "made init argument "+oldArg.Name+" required")
+		} else if !oldArg.Optional && curArg.Optional {
+			d.add(AdditiveChange, name, "", "made init argument "+oldArg.Name+" optional")
+		}
+	}
+
+	for i := range curArgs {
+		curArg := curArgs[i]
+		if _, ok := oldByName[curArg.Name]; ok {
+			continue
+		}
+		if curArg.Optional {
+			d.add(AdditiveChange, name, "", "added optional init argument "+curArg.Name)
+		} else {
+			d.breaking(old, nil, name, "", "added required init argument "+curArg.Name)
+		}
+	}
+}
+
+func typeLabel(typ string) string {
+	if typ == "" {
+		return "none"
+	}
+	return types.Type(typ).Label()
+}
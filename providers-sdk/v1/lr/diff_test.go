@@ -0,0 +1,161 @@
+package lr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/providers-sdk/v1/lr/docs"
+	"go.mondoo.com/cnquery/providers-sdk/v1/resources"
+)
+
+func diffSchema(t *testing.T, lr string) *resources.Schema {
+	res, err := Parse("option provider = \"test\"\n" + lr)
+	require.NoError(t, err)
+	schema, err := Schema(res)
+	require.NoError(t, err)
+	return schema
+}
+
+func diffStrings(changes []SchemaChange) []string {
+	res := make([]string, len(changes))
+	for i := range changes {
+		res[i] = changes[i].String()
+	}
+	return res
+}
+
+func TestDiffSchemas(t *testing.T) {
+	old := diffSchema(t, `
+user {
+  init(name string, uid int)
+  name string
+  uid int
+  shell string
+}
+group {
+  name string
+}
+process {
+  pid int
+}
+`)
+	cur := diffSchema(t, `
+user {
+  init(username string, uid string, gid? int)
+  name string
+  uid string
+  home string
+}
+group {
+  name string
+  members() []user
+}
+package {
+  name string
+}
+`)
+
+	changes, err := DiffSchemas(old, cur, SchemaDiffConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"additive: group.members was added",
+		"additive: package was added",
+		"breaking: process was removed",
+		"additive: user added optional init argument gid",
+		"breaking: user added required init argument username",
+		"breaking: user changed the type of init argument uid from int to string",
+		"breaking: user removed init argument name",
+		"additive: user.home was added",
+		"breaking: user.shell was removed",
+		"breaking: user.uid changed its type from int to string",
+	}, diffStrings(changes))
+	assert.True(t, HasBreakingChanges(changes))
+
+	t.Run("additive changes only", func(t *testing.T) {
+		changes, err := DiffSchemas(old, old, SchemaDiffConfig{})
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+
+		changes, err = DiffSchemas(diffSchema(t, "group {\n  name string\n}"), cur, SchemaDiffConfig{})
+		require.NoError(t, err)
+		assert.False(t, HasBreakingChanges(changes))
+	})
+}
+
+func TestDiffSchemas_InitOrder(t *testing.T) {
+	old := diffSchema(t, `
+registrykey.property {
+  init(path string, name string)
+  path string
+  name string
+}
+`)
+	cur := diffSchema(t, `
+registrykey.property {
+  init(name string, path string, default? string)
+  path string
+  name string
+}
+`)
+
+	changes, err := DiffSchemas(old, cur, SchemaDiffConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"additive: registrykey.property added optional init argument default",
+		"breaking: registrykey.property moved init argument name from position 2 to 1",
+		"breaking: registrykey.property moved init argument path from position 1 to 2",
+	}, diffStrings(changes))
+}
+
+func TestDiffSchemas_Unstable(t *testing.T) {
+	old := diffSchema(t, `
+user {
+  name string
+  uid int
+}
+process {
+  pid int
+}
+`)
+	cur := diffSchema(t, `
+user {
+  name string
+}
+`)
+
+	t.Run("maturity", func(t *testing.T) {
+		changes, err := DiffSchemas(old, cur, SchemaDiffConfig{
+			OldManifest: &docs.LrDocs{Resources: map[string]*docs.LrDocsEntry{
+				"process": {Maturity: "experimental"},
+				"user":    {Maturity: "deprecated"},
+			}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"unstable: process was removed",
+			"unstable: user.uid was removed",
+		}, diffStrings(changes))
+		assert.False(t, HasBreakingChanges(changes))
+	})
+
+	t.Run("min_mondoo_version", func(t *testing.T) {
+		old.Resources["process"].MinMondooVersion = "9.1.0"
+		old.Resources["user"].MinMondooVersion = "8.0.0"
+		old.Resources["user"].Fields["uid"].MinMondooVersion = "latest"
+
+		changes, err := DiffSchemas(old, cur, SchemaDiffConfig{ReleasedVersion: "9.0.0"})
+		require.NoError(t, err)
+		assert.False(t, HasBreakingChanges(changes))
+
+		changes, err = DiffSchemas(old, cur, SchemaDiffConfig{ReleasedVersion: "9.1.0"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"breaking: process was removed",
+			"unstable: user.uid was removed",
+		}, diffStrings(changes))
+
+		_, err = DiffSchemas(old, cur, SchemaDiffConfig{ReleasedVersion: "next"})
+		assert.Error(t, err)
+	})
+}
@@ -47,14 +47,27 @@ func (m *GRPCClient) Connect(req *ConnectReq, callback ProviderCallback) (*Conne
 	return res, err
 }
 
-func (m *GRPCClient) GetData(req *DataReq) (*DataRes, error) {
-	return m.client.GetData(context.Background(), req)
+func (m *GRPCClient) Disconnect(req *DisconnectReq) (*DisconnectRes, error) {
+	return m.client.Disconnect(context.Background(), req)
+}
+
+// GetData passes the context's deadline and cancellation on to the plugin
+func (m *GRPCClient) GetData(ctx context.Context, req *DataReq) (*DataRes, error) {
+	return m.client.GetData(ctx, req)
 }
 
 func (m *GRPCClient) StoreData(req *StoreReq) (*StoreRes, error) {
 	return m.client.StoreData(context.Background(), req)
 }
 
+func (m *GRPCClient) Shutdown(req *ShutdownReq) (*ShutdownRes, error) {
+	return m.client.Shutdown(context.Background(), req)
+}
+
+func (m *GRPCClient) Heartbeat(req *HeartbeatReq) (*HeartbeatRes, error) {
+	return m.client.Heartbeat(context.Background(), req)
+}
+
 // Here is the gRPC server that GRPCClient talks to.
 type GRPCServer struct {
 	// This is the real implementation
@@ -80,14 +93,26 @@ func (m *GRPCServer) Connect(ctx context.Context, req *ConnectReq) (*ConnectRes,
 	return m.Impl.Connect(req, a)
 }
 
+func (m *GRPCServer) Disconnect(ctx context.Context, req *DisconnectReq) (*DisconnectRes, error) {
+	return m.Impl.Disconnect(req)
+}
+
 func (m *GRPCServer) GetData(ctx context.Context, req *DataReq) (*DataRes, error) {
-	return m.Impl.GetData(req)
+	return m.Impl.GetData(ctx, req)
 }
 
 func (m *GRPCServer) StoreData(ctx context.Context, req *StoreReq) (*StoreRes, error) {
 	return m.Impl.StoreData(req)
 }
 
+func (m *GRPCServer) Shutdown(ctx context.Context, req *ShutdownReq) (*ShutdownRes, error) {
+	return m.Impl.Shutdown(req)
+}
+
+func (m *GRPCServer) Heartbeat(ctx context.Context, req *HeartbeatReq) (*HeartbeatRes, error) {
+	return m.Impl.Heartbeat(req)
+}
+
 // GRPCClient is an implementation of ProviderCallback that talks over RPC.
 type GRPCProviderCallbackClient struct{ client ProviderCallbackClient }
 
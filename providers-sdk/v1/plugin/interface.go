@@ -29,8 +29,11 @@ type ProviderCallback interface {
 type ProviderPlugin interface {
 	ParseCLI(req *ParseCLIReq) (*ParseCLIRes, error)
 	Connect(req *ConnectReq, callback ProviderCallback) (*ConnectRes, error)
-	GetData(req *DataReq) (*DataRes, error)
+	Disconnect(req *DisconnectReq) (*DisconnectRes, error)
+	GetData(ctx context.Context, req *DataReq) (*DataRes, error)
 	StoreData(req *StoreReq) (*StoreRes, error)
+	Shutdown(req *ShutdownReq) (*ShutdownRes, error)
+	Heartbeat(req *HeartbeatReq) (*HeartbeatRes, error)
 }
 
 // This is the implementation of plugin.Plugin so we can serve/consume this.
@@ -19,10 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ProviderPlugin_ParseCLI_FullMethodName  = "/cnquery.providers.v1.ProviderPlugin/ParseCLI"
-	ProviderPlugin_Connect_FullMethodName   = "/cnquery.providers.v1.ProviderPlugin/Connect"
-	ProviderPlugin_GetData_FullMethodName   = "/cnquery.providers.v1.ProviderPlugin/GetData"
-	ProviderPlugin_StoreData_FullMethodName = "/cnquery.providers.v1.ProviderPlugin/StoreData"
+	ProviderPlugin_ParseCLI_FullMethodName   = "/cnquery.providers.v1.ProviderPlugin/ParseCLI"
+	ProviderPlugin_Connect_FullMethodName    = "/cnquery.providers.v1.ProviderPlugin/Connect"
+	ProviderPlugin_Disconnect_FullMethodName = "/cnquery.providers.v1.ProviderPlugin/Disconnect"
+	ProviderPlugin_GetData_FullMethodName    = "/cnquery.providers.v1.ProviderPlugin/GetData"
+	ProviderPlugin_StoreData_FullMethodName  = "/cnquery.providers.v1.ProviderPlugin/StoreData"
+	ProviderPlugin_Shutdown_FullMethodName   = "/cnquery.providers.v1.ProviderPlugin/Shutdown"
+	ProviderPlugin_Heartbeat_FullMethodName  = "/cnquery.providers.v1.ProviderPlugin/Heartbeat"
 )
 
 // ProviderPluginClient is the client API for ProviderPlugin service.
@@ -31,8 +34,14 @@ const (
 type ProviderPluginClient interface {
 	ParseCLI(ctx context.Context, in *ParseCLIReq, opts ...grpc.CallOption) (*ParseCLIRes, error)
 	Connect(ctx context.Context, in *ConnectReq, opts ...grpc.CallOption) (*ConnectRes, error)
+	// Disconnect closes a connection and releases all its resources
+	Disconnect(ctx context.Context, in *DisconnectReq, opts ...grpc.CallOption) (*DisconnectRes, error)
 	GetData(ctx context.Context, in *DataReq, opts ...grpc.CallOption) (*DataRes, error)
 	StoreData(ctx context.Context, in *StoreReq, opts ...grpc.CallOption) (*StoreRes, error)
+	// Shutdown closes all connections before the plugin is stopped
+	Shutdown(ctx context.Context, in *ShutdownReq, opts ...grpc.CallOption) (*ShutdownRes, error)
+	// Heartbeat is used to detect plugins that stopped responding
+	Heartbeat(ctx context.Context, in *HeartbeatReq, opts ...grpc.CallOption) (*HeartbeatRes, error)
 }
 
 type providerPluginClient struct {
@@ -61,6 +70,15 @@ func (c *providerPluginClient) Connect(ctx context.Context, in *ConnectReq, opts
 	return out, nil
 }
 
+func (c *providerPluginClient) Disconnect(ctx context.Context, in *DisconnectReq, opts ...grpc.CallOption) (*DisconnectRes, error) {
+	out := new(DisconnectRes)
+	err := c.cc.Invoke(ctx, ProviderPlugin_Disconnect_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *providerPluginClient) GetData(ctx context.Context, in *DataReq, opts ...grpc.CallOption) (*DataRes, error) {
 	out := new(DataRes)
 	err := c.cc.Invoke(ctx, ProviderPlugin_GetData_FullMethodName, in, out, opts...)
@@ -79,14 +97,38 @@ func (c *providerPluginClient) StoreData(ctx context.Context, in *StoreReq, opts
 	return out, nil
 }
 
+func (c *providerPluginClient) Shutdown(ctx context.Context, in *ShutdownReq, opts ...grpc.CallOption) (*ShutdownRes, error) {
+	out := new(ShutdownRes)
+	err := c.cc.Invoke(ctx, ProviderPlugin_Shutdown_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) Heartbeat(ctx context.Context, in *HeartbeatReq, opts ...grpc.CallOption) (*HeartbeatRes, error) {
+	out := new(HeartbeatRes)
+	err := c.cc.Invoke(ctx, ProviderPlugin_Heartbeat_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProviderPluginServer is the server API for ProviderPlugin service.
 // All implementations must embed UnimplementedProviderPluginServer
 // for forward compatibility
 type ProviderPluginServer interface {
 	ParseCLI(context.Context, *ParseCLIReq) (*ParseCLIRes, error)
 	Connect(context.Context, *ConnectReq) (*ConnectRes, error)
+	// Disconnect closes a connection and releases all its resources
+	Disconnect(context.Context, *DisconnectReq) (*DisconnectRes, error)
 	GetData(context.Context, *DataReq) (*DataRes, error)
 	StoreData(context.Context, *StoreReq) (*StoreRes, error)
+	// Shutdown closes all connections before the plugin is stopped
+	Shutdown(context.Context, *ShutdownReq) (*ShutdownRes, error)
+	// Heartbeat is used to detect plugins that stopped responding
+	Heartbeat(context.Context, *HeartbeatReq) (*HeartbeatRes, error)
 	mustEmbedUnimplementedProviderPluginServer()
 }
 
@@ -100,12 +142,21 @@ func (UnimplementedProviderPluginServer) ParseCLI(context.Context, *ParseCLIReq)
 func (UnimplementedProviderPluginServer) Connect(context.Context, *ConnectReq) (*ConnectRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Connect not implemented")
 }
+func (UnimplementedProviderPluginServer) Disconnect(context.Context, *DisconnectReq) (*DisconnectRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Disconnect not implemented")
+}
 func (UnimplementedProviderPluginServer) GetData(context.Context, *DataReq) (*DataRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetData not implemented")
 }
 func (UnimplementedProviderPluginServer) StoreData(context.Context, *StoreReq) (*StoreRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StoreData not implemented")
 }
+func (UnimplementedProviderPluginServer) Shutdown(context.Context, *ShutdownReq) (*ShutdownRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Shutdown not implemented")
+}
+func (UnimplementedProviderPluginServer) Heartbeat(context.Context, *HeartbeatReq) (*HeartbeatRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
 func (UnimplementedProviderPluginServer) mustEmbedUnimplementedProviderPluginServer() {}
 
 // UnsafeProviderPluginServer may be embedded to opt out of forward compatibility for this service.
@@ -155,6 +206,24 @@ func _ProviderPlugin_Connect_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProviderPlugin_Disconnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderPluginServer).Disconnect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderPlugin_Disconnect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderPluginServer).Disconnect(ctx, req.(*DisconnectReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProviderPlugin_GetData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DataReq)
 	if err := dec(in); err != nil {
@@ -191,6 +260,42 @@ func _ProviderPlugin_StoreData_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProviderPlugin_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShutdownReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderPluginServer).Shutdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderPlugin_Shutdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderPluginServer).Shutdown(ctx, req.(*ShutdownReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderPlugin_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderPluginServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderPlugin_Heartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderPluginServer).Heartbeat(ctx, req.(*HeartbeatReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProviderPlugin_ServiceDesc is the grpc.ServiceDesc for ProviderPlugin service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -206,6 +311,10 @@ var ProviderPlugin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Connect",
 			Handler:    _ProviderPlugin_Connect_Handler,
 		},
+		{
+			MethodName: "Disconnect",
+			Handler:    _ProviderPlugin_Disconnect_Handler,
+		},
 		{
 			MethodName: "GetData",
 			Handler:    _ProviderPlugin_GetData_Handler,
@@ -214,6 +323,14 @@ var ProviderPlugin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StoreData",
 			Handler:    _ProviderPlugin_StoreData_Handler,
 		},
+		{
+			MethodName: "Shutdown",
+			Handler:    _ProviderPlugin_Shutdown_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _ProviderPlugin_Heartbeat_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "plugin.proto",
@@ -1,11 +1,13 @@
 package plugin
 
 import (
+	"context"
 	"errors"
 
 	"go.mondoo.com/cnquery/llx"
 	"go.mondoo.com/cnquery/providers-sdk/v1/upstream"
 	"go.mondoo.com/cnquery/types"
+	"google.golang.org/grpc/status"
 )
 
 type Runtime struct {
@@ -19,6 +21,51 @@ type Runtime struct {
 
 type Connection interface{}
 
+// ContextConnection is implemented by connections which stop running
+// commands and file reads once the requests that use them are done
+type ContextConnection interface {
+	// Use marks the connection as used by a request with the given context.
+	// The request uses the connection until its context is done or until the
+	// returned release function is called.
+	Use(ctx context.Context) (release func())
+}
+
+// Do processes a request for this runtime. Requests run concurrently. If
+// the request's context is done before it finishes, Do returns right away
+// with the context's error as gRPC status, e.g. DeadlineExceeded.
+func (r *Runtime) Do(ctx context.Context, f func() (*DataRes, error)) (*DataRes, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+
+	if conn, ok := r.Connection.(ContextConnection); ok {
+		release := conn.Use(ctx)
+		defer release()
+	}
+
+	// requests that cannot be cancelled don't need to be watched
+	if ctx.Done() == nil {
+		return f()
+	}
+
+	type result struct {
+		res *DataRes
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		res, err := f()
+		done <- result{res: res, err: err}
+	}()
+
+	select {
+	case x := <-done:
+		return x.res, x.err
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+}
+
 type CreateNamedResource func(runtime *Runtime, name string, args map[string]*llx.RawData) (Resource, error)
 
 type Resource interface {
@@ -155,6 +202,30 @@ func (r *Runtime) GetSharedData(resource string, resourceID string, field string
 	return res.Data.RawData(), nil
 }
 
+// FieldUID identifies a field of a resource. It is used as the ID of
+// data that is pushed via Collect.
+func FieldUID(resource string, id string, field string) string {
+	return resource + "\x00" + id + "\x00" + field
+}
+
+// Collect pushes data of a resource field to the caller asynchronously.
+// Fields that take long to compute can return NotReady and push their data
+// once it is available. If data is nil, the caller is told that the field
+// has changed and will request it again.
+func (r *Runtime) Collect(resource string, id string, field string, data *llx.RawData) error {
+	if r.Callback == nil {
+		return errors.New("cannot collect data for '" + resource + "." + field + "', no callback available")
+	}
+
+	req := &DataRes{Id: FieldUID(resource, id, field)}
+	if data != nil {
+		res := data.Result()
+		req.Data = res.Data
+		req.Error = res.Error
+	}
+	return r.Callback.Collect(req)
+}
+
 type TValue[T any] struct {
 	Data  T
 	State State
@@ -163,7 +234,12 @@ type TValue[T any] struct {
 
 func (x *TValue[T]) ToDataRes(typ types.Type) *DataRes {
 	if x.State&StateIsSet == 0 {
-		return &DataRes{}
+		// An empty response tells the caller that the data is not ready yet
+		// and will be pushed via Collect. Fields that were never set are null.
+		if x.Error == NotReady {
+			return &DataRes{}
+		}
+		return &DataRes{Data: &llx.Primitive{Type: string(typ)}}
 	}
 	if x.State&StateIsNull != 0 {
 		res := &DataRes{
@@ -0,0 +1,133 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTValue_ToDataRes(t *testing.T) {
+	t.Run("unset fields are null", func(t *testing.T) {
+		x := TValue[string]{}
+		assert.Equal(t, &DataRes{Data: &llx.Primitive{Type: string(types.String)}}, x.ToDataRes(types.String))
+	})
+
+	t.Run("not ready fields are empty", func(t *testing.T) {
+		var cached TValue[string]
+		x := GetOrCompute(&cached, func() (string, error) { return "", NotReady })
+		assert.Equal(t, &DataRes{}, x.ToDataRes(types.String))
+	})
+
+	t.Run("errors are set", func(t *testing.T) {
+		var cached TValue[string]
+		x := GetOrCompute(&cached, func() (string, error) { return "", errors.New("fail") })
+		res := x.ToDataRes(types.String)
+		assert.Equal(t, "fail", res.Error)
+	})
+}
+
+type contextConnection struct {
+	lock  sync.Mutex
+	users int
+}
+
+func (c *contextConnection) Use(ctx context.Context) func() {
+	c.lock.Lock()
+	c.users++
+	c.lock.Unlock()
+	return func() {
+		c.lock.Lock()
+		c.users--
+		c.lock.Unlock()
+	}
+}
+
+func (c *contextConnection) activeUsers() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.users
+}
+
+func TestRuntime_Do(t *testing.T) {
+	conn := &contextConnection{}
+	r := &Runtime{Connection: conn}
+
+	t.Run("requests use the connection", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		users := 0
+		res, err := r.Do(ctx, func() (*DataRes, error) {
+			users = conn.activeUsers()
+			return &DataRes{Data: llx.StringPrimitive("ok")}, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, llx.StringPrimitive("ok"), res.Data)
+		assert.Equal(t, 1, users)
+		assert.Equal(t, 0, conn.activeUsers())
+	})
+
+	t.Run("a hung request doesn't block others", func(t *testing.T) {
+		hung := make(chan struct{})
+		defer close(hung)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err := r.Do(ctx, func() (*DataRes, error) {
+			<-hung
+			return &DataRes{}, nil
+		})
+		assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+		assert.Equal(t, 0, conn.activeUsers())
+
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		res, err := r.Do(ctx, func() (*DataRes, error) {
+			return &DataRes{Data: llx.StringPrimitive("next")}, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, llx.StringPrimitive("next"), res.Data)
+	})
+
+	t.Run("requests run concurrently", func(t *testing.T) {
+		second := make(chan struct{})
+		first := make(chan error, 1)
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			_, err := r.Do(ctx, func() (*DataRes, error) {
+				<-second
+				return &DataRes{}, nil
+			})
+			first <- err
+		}()
+
+		_, err := r.Do(context.Background(), func() (*DataRes, error) {
+			close(second)
+			return &DataRes{}, nil
+		})
+		require.NoError(t, err)
+		assert.NoError(t, <-first)
+	})
+
+	t.Run("cancelled requests are not processed", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		called := false
+		_, err := r.Do(ctx, func() (*DataRes, error) {
+			called = true
+			return &DataRes{}, nil
+		})
+		assert.Equal(t, codes.Canceled, status.Code(err))
+		assert.False(t, called)
+	})
+}
@@ -0,0 +1,107 @@
+package plugin
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+)
+
+// Service keeps track of the runtimes of all connections of a provider. It
+// provides the default implementations for Disconnect, Shutdown and
+// Heartbeat. Providers embed it into their own service.
+type Service struct {
+	runtimes         map[uint32]*Runtime
+	lastConnectionID uint32
+	lock             sync.Mutex
+}
+
+func NewService() *Service {
+	return &Service{
+		runtimes: map[uint32]*Runtime{},
+	}
+}
+
+// NewConnectionID returns a new id for a connection
+func (s *Service) NewConnectionID() uint32 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.lastConnectionID++
+	return s.lastConnectionID
+}
+
+// AddRuntime registers the runtime of a connection
+func (s *Service) AddRuntime(id uint32, runtime *Runtime) {
+	s.lock.Lock()
+	s.runtimes[id] = runtime
+	s.lock.Unlock()
+}
+
+// GetRuntime returns the runtime of a connection
+func (s *Service) GetRuntime(id uint32) (*Runtime, error) {
+	s.lock.Lock()
+	runtime, ok := s.runtimes[id]
+	s.lock.Unlock()
+	if !ok {
+		return nil, errors.New("connection " + strconv.FormatUint(uint64(id), 10) + " not found")
+	}
+	return runtime, nil
+}
+
+// Disconnect removes the runtime of a connection and closes the connection.
+// Connections that are unknown, e.g. because they are already disconnected,
+// are ignored.
+func (s *Service) Disconnect(req *DisconnectReq) (*DisconnectRes, error) {
+	s.lock.Lock()
+	runtime, ok := s.runtimes[req.Connection]
+	delete(s.runtimes, req.Connection)
+	s.lock.Unlock()
+
+	if ok {
+		if err := closeConnection(runtime.Connection); err != nil {
+			return nil, err
+		}
+	}
+	return &DisconnectRes{}, nil
+}
+
+// Shutdown closes all connections
+func (s *Service) Shutdown(req *ShutdownReq) (*ShutdownRes, error) {
+	s.lock.Lock()
+	runtimes := s.runtimes
+	s.runtimes = map[uint32]*Runtime{}
+	s.lock.Unlock()
+
+	var errs []string
+	for id, runtime := range runtimes {
+		if err := closeConnection(runtime.Connection); err != nil {
+			errs = append(errs, "failed to close connection "+strconv.FormatUint(uint64(id), 10)+": "+err.Error())
+		}
+	}
+	if len(errs) != 0 {
+		return nil, errors.New("failed to shut down: " + joinErrs(errs))
+	}
+	return &ShutdownRes{}, nil
+}
+
+// Heartbeat answers as long as the provider is able to process requests
+func (s *Service) Heartbeat(req *HeartbeatReq) (*HeartbeatRes, error) {
+	return &HeartbeatRes{}, nil
+}
+
+func closeConnection(conn Connection) error {
+	switch x := conn.(type) {
+	case interface{ Close() }:
+		x.Close()
+	case interface{ Close() error }:
+		return x.Close()
+	}
+	return nil
+}
+
+func joinErrs(errs []string) string {
+	res := errs[0]
+	for i := 1; i < len(errs); i++ {
+		res += ", " + errs[i]
+	}
+	return res
+}
@@ -0,0 +1,70 @@
+package plugin
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testConnection struct {
+	closed bool
+	err    error
+}
+
+func (c *testConnection) Close() error {
+	c.closed = true
+	return c.err
+}
+
+func TestService(t *testing.T) {
+	s := NewService()
+
+	id1, id2 := s.NewConnectionID(), s.NewConnectionID()
+	assert.Equal(t, uint32(1), id1)
+	assert.Equal(t, uint32(2), id2)
+
+	conn1, conn2 := &testConnection{}, &testConnection{}
+	s.AddRuntime(id1, &Runtime{Connection: conn1})
+	s.AddRuntime(id2, &Runtime{Connection: conn2})
+
+	runtime, err := s.GetRuntime(id1)
+	require.NoError(t, err)
+	assert.Same(t, conn1, runtime.Connection)
+
+	_, err = s.GetRuntime(3)
+	assert.EqualError(t, err, "connection 3 not found")
+
+	t.Run("disconnect", func(t *testing.T) {
+		_, err := s.Disconnect(&DisconnectReq{Connection: id1})
+		require.NoError(t, err)
+		assert.True(t, conn1.closed)
+		assert.False(t, conn2.closed)
+
+		_, err = s.GetRuntime(id1)
+		assert.Error(t, err)
+
+		// disconnecting twice is fine
+		_, err = s.Disconnect(&DisconnectReq{Connection: id1})
+		require.NoError(t, err)
+	})
+
+	t.Run("heartbeat", func(t *testing.T) {
+		_, err := s.Heartbeat(&HeartbeatReq{})
+		require.NoError(t, err)
+	})
+
+	t.Run("shutdown", func(t *testing.T) {
+		conn3 := &testConnection{err: errors.New("broken pipe")}
+		s.AddRuntime(s.NewConnectionID(), &Runtime{Connection: conn3})
+
+		_, err := s.Shutdown(&ShutdownReq{})
+		assert.EqualError(t, err, "failed to shut down: failed to close connection 3: broken pipe")
+		assert.True(t, conn2.closed)
+		assert.True(t, conn3.closed)
+
+		_, err = s.GetRuntime(id2)
+		assert.Error(t, err)
+	})
+}
@@ -2,40 +2,107 @@ package cache
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"go.mondoo.com/cnquery/providers-sdk/v1/vault"
 )
 
-type cachedVault struct {
-	secrets map[string]*vault.Secret
-	vault   vault.Vault
+// DefaultTTL is the time secrets are cached if they do not define a ttl. It
+// ensures rotated secrets are picked up by long-running processes.
+const DefaultTTL = 15 * time.Minute
+
+type Option func(*Vault)
+
+// WithDefaultTTL sets the time secrets without a ttl are cached. A ttl of 0
+// caches these secrets until they are invalidated.
+func WithDefaultTTL(ttl time.Duration) Option {
+	return func(c *Vault) {
+		c.defaultTTL = ttl
+	}
 }
 
-func New(v vault.Vault) vault.Vault {
-	return &cachedVault{
-		secrets: map[string]*vault.Secret{},
-		vault:   v,
+type entry struct {
+	secret  *vault.Secret
+	expires time.Time
+}
+
+// Vault caches the secrets of another vault in memory. Secrets expire after
+// their ttl, e.g. the lease duration of a HashiCorp Vault secret, or after the
+// default ttl.
+type Vault struct {
+	vault      vault.Vault
+	defaultTTL time.Duration
+	secrets    map[string]entry
+	lock       sync.Mutex
+	now        func() time.Time
+}
+
+func New(v vault.Vault, opts ...Option) *Vault {
+	c := &Vault{
+		secrets:    map[string]entry{},
+		vault:      v,
+		defaultTTL: DefaultTTL,
+		now:        time.Now,
+	}
+
+	for _, option := range opts {
+		option(c)
 	}
+
+	return c
 }
 
-func (c *cachedVault) About(ctx context.Context, e *vault.Empty) (*vault.VaultInfo, error) {
+func (c *Vault) About(ctx context.Context, e *vault.Empty) (*vault.VaultInfo, error) {
 	// return the info about the underlying vault. The cached vault is only an abstraction
 	return c.vault.About(ctx, e)
 }
 
-func (c *cachedVault) Get(ctx context.Context, id *vault.SecretID) (*vault.Secret, error) {
-	if s, ok := c.secrets[id.Key]; ok {
-		return s, nil
+func (c *Vault) Get(ctx context.Context, id *vault.SecretID) (*vault.Secret, error) {
+	c.lock.Lock()
+	e, ok := c.secrets[id.Key]
+	if ok && (e.expires.IsZero() || c.now().Before(e.expires)) {
+		c.lock.Unlock()
+		return e.secret, nil
 	}
+	c.lock.Unlock()
+
 	s, err := c.vault.Get(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	c.secrets[id.Key] = s
+	ttl := c.defaultTTL
+	if s.Ttl > 0 {
+		ttl = time.Duration(s.Ttl) * time.Second
+	}
+
+	e = entry{secret: s}
+	if ttl > 0 {
+		e.expires = c.now().Add(ttl)
+	}
+
+	c.lock.Lock()
+	c.secrets[id.Key] = e
+	c.lock.Unlock()
 	return s, nil
 }
 
-func (c *cachedVault) Set(ctx context.Context, s *vault.Secret) (*vault.SecretID, error) {
-	return c.vault.Set(ctx, s)
+func (c *Vault) Set(ctx context.Context, s *vault.Secret) (*vault.SecretID, error) {
+	id, err := c.vault.Set(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+
+	// the next read has to return the new secret
+	c.Invalidate(&vault.SecretID{Key: s.Key})
+	return id, nil
+}
+
+// Invalidate removes a secret from the cache, so that it is retrieved from the
+// underlying vault on the next read, e.g. after it was rejected
+func (c *Vault) Invalidate(id *vault.SecretID) {
+	c.lock.Lock()
+	delete(c.secrets, id.Key)
+	c.lock.Unlock()
 }
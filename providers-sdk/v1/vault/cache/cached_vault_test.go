@@ -0,0 +1,128 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/providers-sdk/v1/vault"
+	"go.mondoo.com/cnquery/providers-sdk/v1/vault/inmemory"
+)
+
+// countingVault counts the reads of the underlying vault
+type countingVault struct {
+	vault.Vault
+	reads int
+}
+
+func (v *countingVault) Get(ctx context.Context, id *vault.SecretID) (*vault.Secret, error) {
+	v.reads++
+	return v.Vault.Get(ctx, id)
+}
+
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time { return c.now }
+
+func newTestVault(opts ...Option) (*Vault, *countingVault, *clock) {
+	underlying := &countingVault{Vault: inmemory.New()}
+	clk := &clock{now: time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)}
+	c := New(underlying, opts...)
+	c.now = clk.Now
+	return c, underlying, clk
+}
+
+func TestCachedVault_DefaultTTL(t *testing.T) {
+	ctx := context.Background()
+	c, underlying, clk := newTestVault()
+	_, err := underlying.Set(ctx, &vault.Secret{Key: "a", Data: []byte("v1")})
+	require.NoError(t, err)
+
+	s, err := c.Get(ctx, &vault.SecretID{Key: "a"})
+	require.NoError(t, err)
+	assert.Equal(t, "v1", string(s.Data))
+
+	// the secret is rotated in the underlying vault
+	_, err = underlying.Set(ctx, &vault.Secret{Key: "a", Data: []byte("v2")})
+	require.NoError(t, err)
+
+	clk.now = clk.now.Add(DefaultTTL - time.Second)
+	s, err = c.Get(ctx, &vault.SecretID{Key: "a"})
+	require.NoError(t, err)
+	assert.Equal(t, "v1", string(s.Data))
+	assert.Equal(t, 1, underlying.reads)
+
+	clk.now = clk.now.Add(time.Second)
+	s, err = c.Get(ctx, &vault.SecretID{Key: "a"})
+	require.NoError(t, err)
+	assert.Equal(t, "v2", string(s.Data))
+	assert.Equal(t, 2, underlying.reads)
+}
+
+func TestCachedVault_SecretTTL(t *testing.T) {
+	ctx := context.Background()
+	c, underlying, clk := newTestVault()
+	_, err := underlying.Set(ctx, &vault.Secret{Key: "a", Data: []byte("v1"), Ttl: 60})
+	require.NoError(t, err)
+
+	_, err = c.Get(ctx, &vault.SecretID{Key: "a"})
+	require.NoError(t, err)
+
+	clk.now = clk.now.Add(59 * time.Second)
+	_, err = c.Get(ctx, &vault.SecretID{Key: "a"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, underlying.reads)
+
+	clk.now = clk.now.Add(time.Second)
+	_, err = c.Get(ctx, &vault.SecretID{Key: "a"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, underlying.reads)
+}
+
+func TestCachedVault_NoExpiry(t *testing.T) {
+	ctx := context.Background()
+	c, underlying, clk := newTestVault(WithDefaultTTL(0))
+	_, err := underlying.Set(ctx, &vault.Secret{Key: "a", Data: []byte("v1")})
+	require.NoError(t, err)
+
+	_, err = c.Get(ctx, &vault.SecretID{Key: "a"})
+	require.NoError(t, err)
+
+	clk.now = clk.now.Add(365 * 24 * time.Hour)
+	_, err = c.Get(ctx, &vault.SecretID{Key: "a"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, underlying.reads)
+}
+
+func TestCachedVault_Invalidate(t *testing.T) {
+	ctx := context.Background()
+	c, underlying, _ := newTestVault()
+	_, err := c.Set(ctx, &vault.Secret{Key: "a", Data: []byte("v1")})
+	require.NoError(t, err)
+
+	s, err := c.Get(ctx, &vault.SecretID{Key: "a"})
+	require.NoError(t, err)
+	assert.Equal(t, "v1", string(s.Data))
+
+	// writes through the cache are visible immediately
+	_, err = c.Set(ctx, &vault.Secret{Key: "a", Data: []byte("v2")})
+	require.NoError(t, err)
+	s, err = c.Get(ctx, &vault.SecretID{Key: "a"})
+	require.NoError(t, err)
+	assert.Equal(t, "v2", string(s.Data))
+
+	_, err = underlying.Set(ctx, &vault.Secret{Key: "a", Data: []byte("v3")})
+	require.NoError(t, err)
+	c.Invalidate(&vault.SecretID{Key: "a"})
+	s, err = c.Get(ctx, &vault.SecretID{Key: "a"})
+	require.NoError(t, err)
+	assert.Equal(t, "v3", string(s.Data))
+	assert.Equal(t, 3, underlying.reads)
+
+	_, err = c.Get(ctx, &vault.SecretID{Key: "missing"})
+	assert.Equal(t, vault.NotFoundError, err)
+}
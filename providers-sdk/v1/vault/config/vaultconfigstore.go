@@ -11,6 +11,7 @@ import (
 	"go.mondoo.com/cnquery/providers-sdk/v1/vault"
 	"go.mondoo.com/cnquery/providers-sdk/v1/vault/awsparameterstore"
 	"go.mondoo.com/cnquery/providers-sdk/v1/vault/awssecretsmanager"
+	"go.mondoo.com/cnquery/providers-sdk/v1/vault/encryptedfile"
 	"go.mondoo.com/cnquery/providers-sdk/v1/vault/gcpberglas"
 	"go.mondoo.com/cnquery/providers-sdk/v1/vault/gcpsecretmanager"
 	"go.mondoo.com/cnquery/providers-sdk/v1/vault/hashivault"
@@ -37,9 +38,15 @@ func New(vCfg *vault.VaultConfiguration) (vault.Vault, error) {
 		token := vCfg.Options["token"]
 		v = hashivault.New(serverUrl, token)
 	case vault.VaultType_EncryptedFile:
+		password := vCfg.Options["password"]
+		// the file option selects the portable OpenPGP encrypted file, the path
+		// option the directory of the keyring file backend
+		if file := vCfg.Options["file"]; file != "" {
+			v = encryptedfile.New(file, password)
+			break
+		}
 		path := vCfg.Options["path"]
 		keyRingName := vCfg.Options["name"]
-		password := vCfg.Options["password"]
 		v = keyring.NewEncryptedFile(path, keyRingName, password)
 	case vault.VaultType_KeyRing:
 		keyRingName := vCfg.Options["name"]
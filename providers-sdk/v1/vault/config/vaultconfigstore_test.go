@@ -1,11 +1,14 @@
 package config
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.mondoo.com/cnquery/providers-sdk/v1/vault"
+	"go.mondoo.com/cnquery/providers-sdk/v1/vault/encryptedfile"
+	"go.mondoo.com/cnquery/providers-sdk/v1/vault/keyring"
 )
 
 func TestVaultConfiguration(t *testing.T) {
@@ -39,3 +42,28 @@ func TestVaultConfiguration(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "vault1cfg-name2", cfg.Name)
 }
+
+func TestNewEncryptedFileVault(t *testing.T) {
+	v, err := New(&vault.VaultConfiguration{
+		Name: "file",
+		Type: vault.VaultType_EncryptedFile,
+		Options: map[string]string{
+			"file":     filepath.Join(t.TempDir(), "vault.asc"),
+			"password": "superpassword",
+		},
+	})
+	require.NoError(t, err)
+	assert.IsType(t, &encryptedfile.Vault{}, v)
+
+	v, err = New(&vault.VaultConfiguration{
+		Name: "keyring-file",
+		Type: vault.VaultType_EncryptedFile,
+		Options: map[string]string{
+			"path":     t.TempDir(),
+			"name":     "mondoo",
+			"password": "superpassword",
+		},
+	})
+	require.NoError(t, err)
+	assert.IsType(t, &keyring.Vault{}, v)
+}
@@ -113,7 +113,9 @@ func (s *SecretEncoding) UnmarshalJSON(data []byte) error {
 	if err != nil {
 		var name string
 		err = json.Unmarshal(data, &name)
-		code, ok := SecretEncoding_value["encoding_"+strings.ToLower(strings.TrimSpace(name))]
+		// accept the short form as well as the name written by MarshalJSON
+		name = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(name)), "encoding_")
+		code, ok := SecretEncoding_value["encoding_"+name]
 		if !ok {
 			return errors.New("unknown type value: " + string(data))
 		}
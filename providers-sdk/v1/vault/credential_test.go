@@ -54,3 +54,16 @@ func TestSecretEncoding(t *testing.T) {
 	yaml.Unmarshal([]byte(content), &v)
 	assert.Equal(t, 2, len(v))
 }
+
+func TestSecretEncodingMarshal(t *testing.T) {
+	data, err := json.Marshal(SecretEncoding_encoding_json)
+	require.NoError(t, err)
+	assert.Equal(t, "\"encoding_json\"", string(data))
+
+	var encoding SecretEncoding
+	require.NoError(t, json.Unmarshal(data, &encoding))
+	assert.Equal(t, SecretEncoding_encoding_json, encoding)
+
+	require.NoError(t, json.Unmarshal([]byte("\"proto\""), &encoding))
+	assert.Equal(t, SecretEncoding_encoding_proto, encoding)
+}
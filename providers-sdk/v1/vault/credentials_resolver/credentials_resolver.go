@@ -7,17 +7,27 @@ import (
 	"github.com/rs/zerolog/log"
 	"go.mondoo.com/cnquery/providers-sdk/v1/vault"
 	"go.mondoo.com/cnquery/providers-sdk/v1/vault/cache"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// Refresher is implemented by resolvers which can retrieve a credential from
+// the vault again, bypassing any cache, e.g. after it was rotated
+type Refresher interface {
+	RefreshCredential(cred *vault.Credential) (*vault.Credential, error)
+}
+
 type resolver struct {
 	vault vault.Vault
+	cache *cache.Vault
 }
 
 // New creates a new credentials resolver. The resolver allows for caching already resolved credentials
 // in memory such that they are not retrieved from vault again.
 func New(v vault.Vault, enableCaching bool) vault.Resolver {
 	if enableCaching {
-		return &resolver{vault: cache.New(v)}
+		c := cache.New(v)
+		return &resolver{vault: c, cache: c}
 	}
 	return &resolver{vault: v}
 }
@@ -58,3 +68,51 @@ func (c *resolver) GetCredential(cred *vault.Credential) (*vault.Credential, err
 
 	return retrievedCred, nil
 }
+
+// RefreshCredential removes the secret from the cache and retrieves the
+// credential from vault again
+func (c *resolver) RefreshCredential(cred *vault.Credential) (*vault.Credential, error) {
+	if cred == nil {
+		return nil, errors.New("cannot find credential with empty input")
+	}
+
+	if c.cache != nil {
+		c.cache.Invalidate(&vault.SecretID{Key: cred.SecretId})
+	}
+	return c.GetCredential(cred)
+}
+
+// NewAuthError marks an error of a provider as a rejection of the credentials
+// of a connection. Only gRPC status errors keep their code when they are
+// returned through the provider plugin protocol.
+func NewAuthError(err error) error {
+	return status.Error(codes.Unauthenticated, err.Error())
+}
+
+// IsAuthError returns true if a provider rejected the credentials of a connection
+func IsAuthError(err error) bool {
+	return status.Code(err) == codes.Unauthenticated
+}
+
+// RetryOnAuthError calls resolve to resolve the credentials and connects. If
+// the connection is rejected because of the credentials, resolve is called
+// again with refresh set to retrieve them from the vault again, and the
+// connection is retried once. This picks up credentials which were rotated
+// since they were cached.
+func RetryOnAuthError(resolve func(refresh bool) error, connect func() error) error {
+	if err := resolve(false); err != nil {
+		return err
+	}
+
+	err := connect()
+	if err == nil || !IsAuthError(err) {
+		return err
+	}
+
+	log.Debug().Err(err).Msg("connection was rejected, retry with refreshed credentials")
+	if rerr := resolve(true); rerr != nil {
+		log.Debug().Err(rerr).Msg("could not refresh credentials")
+		return err
+	}
+	return connect()
+}
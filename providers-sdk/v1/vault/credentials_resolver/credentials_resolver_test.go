@@ -0,0 +1,138 @@
+package credentials_resolver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	goplugin "github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/providers-sdk/v1/vault"
+	"go.mondoo.com/cnquery/providers-sdk/v1/vault/inmemory"
+	"go.mondoo.com/cnquery/utils/multierr"
+)
+
+func setPassword(t *testing.T, v vault.Vault, key string, password string) {
+	secret, err := vault.NewSecret(vault.NewPasswordCredential("admin", password), vault.SecretEncoding_encoding_proto)
+	require.NoError(t, err)
+	secret.Key = key
+	_, err = v.Set(context.Background(), secret)
+	require.NoError(t, err)
+}
+
+func TestResolver_RefreshCredential(t *testing.T) {
+	v := inmemory.New()
+	setPassword(t, v, "ssh-key", "old")
+
+	r := New(v, true)
+	cred := &vault.Credential{SecretId: "ssh-key"}
+
+	resolved, err := r.GetCredential(cred)
+	require.NoError(t, err)
+	assert.Equal(t, "old", string(resolved.Secret))
+
+	// the password was rotated, the cache still holds the old one
+	setPassword(t, v, "ssh-key", "new")
+	resolved, err = r.GetCredential(cred)
+	require.NoError(t, err)
+	assert.Equal(t, "old", string(resolved.Secret))
+
+	resolved, err = r.(Refresher).RefreshCredential(cred)
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(resolved.Secret))
+	assert.Equal(t, "admin", resolved.User)
+}
+
+func TestIsAuthError(t *testing.T) {
+	authErr := NewAuthError(errors.New("ssh: unable to authenticate"))
+	assert.True(t, IsAuthError(authErr))
+	assert.True(t, IsAuthError(multierr.Wrap(authErr, "could not connect")))
+	assert.False(t, IsAuthError(errors.New("connection refused")))
+	assert.False(t, IsAuthError(nil))
+}
+
+func TestRetryOnAuthError(t *testing.T) {
+	authErr := NewAuthError(errors.New("ssh: unable to authenticate"))
+
+	t.Run("retries once with refreshed credentials", func(t *testing.T) {
+		var refreshed []bool
+		connects := 0
+		err := RetryOnAuthError(func(refresh bool) error {
+			refreshed = append(refreshed, refresh)
+			return nil
+		}, func() error {
+			connects++
+			if connects == 1 {
+				return authErr
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []bool{false, true}, refreshed)
+		assert.Equal(t, 2, connects)
+	})
+
+	t.Run("gives up after the second attempt", func(t *testing.T) {
+		connects := 0
+		err := RetryOnAuthError(func(refresh bool) error { return nil }, func() error {
+			connects++
+			return authErr
+		})
+		assert.Equal(t, authErr, err)
+		assert.Equal(t, 2, connects)
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		connects := 0
+		connErr := errors.New("connection refused")
+		err := RetryOnAuthError(func(refresh bool) error { return nil }, func() error {
+			connects++
+			return connErr
+		})
+		assert.Equal(t, connErr, err)
+		assert.Equal(t, 1, connects)
+	})
+
+	t.Run("keeps the connection error if refreshing fails", func(t *testing.T) {
+		connects := 0
+		err := RetryOnAuthError(func(refresh bool) error {
+			if refresh {
+				return errors.New("vault unavailable")
+			}
+			return nil
+		}, func() error {
+			connects++
+			return authErr
+		})
+		assert.Equal(t, authErr, err)
+		assert.Equal(t, 1, connects)
+	})
+}
+
+// authRejectingProvider rejects the credentials of all connections
+type authRejectingProvider struct {
+	plugin.ProviderPlugin
+}
+
+func (p *authRejectingProvider) Connect(req *plugin.ConnectReq, callback plugin.ProviderCallback) (*plugin.ConnectRes, error) {
+	return nil, multierr.Wrap(NewAuthError(errors.New("ssh: unable to authenticate")), "could not connect")
+}
+
+func TestIsAuthError_ThroughPlugin(t *testing.T) {
+	client, server := goplugin.TestPluginGRPCConn(t, map[string]goplugin.Plugin{
+		"provider": &plugin.ProviderPluginImpl{Impl: &authRejectingProvider{}},
+	})
+	defer client.Close()
+	defer server.Stop()
+
+	raw, err := client.Dispense("provider")
+	require.NoError(t, err)
+	provider := raw.(plugin.ProviderPlugin)
+
+	_, err = provider.Connect(&plugin.ConnectReq{}, nil)
+	require.Error(t, err)
+	assert.True(t, IsAuthError(err))
+	assert.Contains(t, err.Error(), "unable to authenticate")
+}
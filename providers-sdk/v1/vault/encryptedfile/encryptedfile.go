@@ -0,0 +1,258 @@
+package encryptedfile
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/providers-sdk/v1/vault"
+)
+
+const armorType = "PGP MESSAGE"
+
+var errWrongPassword = errors.New("could not decrypt vault file, the password may be incorrect")
+
+// New creates a vault which stores all secrets in a single file. The file is
+// symmetrically encrypted with OpenPGP and can be decrypted with any OpenPGP
+// implementation, e.g. via `gpg --decrypt`.
+func New(path string, password string) *Vault {
+	return &Vault{
+		path:     path,
+		password: password,
+	}
+}
+
+type Vault struct {
+	path     string
+	password string
+	lock     sync.Mutex
+}
+
+// fileSecret is the representation of a secret within the decrypted file
+type fileSecret struct {
+	Label    string               `json:"label,omitempty"`
+	Data     []byte               `json:"data"`
+	Encoding vault.SecretEncoding `json:"encoding,omitempty"`
+}
+
+type fileContent struct {
+	Secrets map[string]fileSecret `json:"secrets"`
+}
+
+func (v *Vault) About(context.Context, *vault.Empty) (*vault.VaultInfo, error) {
+	return &vault.VaultInfo{Name: "Encrypted File Vault: " + v.path}, nil
+}
+
+func (v *Vault) Get(ctx context.Context, id *vault.SecretID) (*vault.Secret, error) {
+	if id == nil {
+		return nil, errors.New("id cannot be nil")
+	}
+	log.Debug().Str("id", id.Key).Msg("get secret from encrypted file")
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	content, err := v.load()
+	if err != nil {
+		return nil, err
+	}
+
+	s, ok := content.Secrets[id.Key]
+	if !ok {
+		return nil, vault.NotFoundError
+	}
+
+	return &vault.Secret{
+		Key:      id.Key,
+		Label:    s.Label,
+		Data:     s.Data,
+		Encoding: s.Encoding,
+	}, nil
+}
+
+func (v *Vault) Set(ctx context.Context, secret *vault.Secret) (*vault.SecretID, error) {
+	if secret == nil {
+		return nil, errors.New("secret is empty")
+	}
+	if secret.Key == "" {
+		return nil, errors.New("secret key cannot be empty")
+	}
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	content, err := v.load()
+	if err != nil {
+		return nil, err
+	}
+
+	content.Secrets[secret.Key] = fileSecret{
+		Label:    secret.Label,
+		Data:     secret.Data,
+		Encoding: secret.Encoding,
+	}
+
+	if err := v.save(content); err != nil {
+		return nil, err
+	}
+
+	return &vault.SecretID{
+		Key: secret.Key,
+	}, nil
+}
+
+func (v *Vault) List(ctx context.Context) ([]*vault.SecretID, error) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	content, err := v.load()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(content.Secrets))
+	for k := range content.Secrets {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	res := make([]*vault.SecretID, len(keys))
+	for i := range keys {
+		res[i] = &vault.SecretID{Key: keys[i]}
+	}
+	return res, nil
+}
+
+func (v *Vault) Delete(ctx context.Context, id *vault.SecretID) error {
+	if id == nil {
+		return errors.New("id cannot be nil")
+	}
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	content, err := v.load()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := content.Secrets[id.Key]; !ok {
+		return vault.NotFoundError
+	}
+	delete(content.Secrets, id.Key)
+
+	return v.save(content)
+}
+
+// load decrypts the vault file. A missing file is treated as an empty vault.
+func (v *Vault) load() (*fileContent, error) {
+	if v.password == "" {
+		return nil, errors.New("encrypted file vault requires a password")
+	}
+
+	content := &fileContent{Secrets: map[string]fileSecret{}}
+
+	data, err := os.ReadFile(v.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return content, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// support armored and binary files, e.g. files created via `gpg --symmetric`
+	var r io.Reader = bytes.NewReader(data)
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("-----BEGIN")) {
+		block, err := armor.Decode(r)
+		if err != nil {
+			return nil, errors.New("could not decode vault file: " + err.Error())
+		}
+		r = block.Body
+	}
+
+	// the prompt is called again after a wrong password, so we only answer once
+	prompted := false
+	md, err := openpgp.ReadMessage(r, nil, func(keys []openpgp.Key, symmetric bool) ([]byte, error) {
+		if prompted || !symmetric {
+			return nil, errWrongPassword
+		}
+		prompted = true
+		return []byte(v.password), nil
+	}, nil)
+	if err != nil {
+		if errors.Is(err, errWrongPassword) {
+			return nil, errWrongPassword
+		}
+		return nil, errors.New("could not decrypt vault file: " + err.Error())
+	}
+
+	plaintext, err := io.ReadAll(md.UnverifiedBody)
+	if err != nil {
+		return nil, errors.New("could not decrypt vault file: " + err.Error())
+	}
+
+	if err := json.Unmarshal(plaintext, content); err != nil {
+		return nil, errors.New("corrupt vault file: " + err.Error())
+	}
+	if content.Secrets == nil {
+		content.Secrets = map[string]fileSecret{}
+	}
+	return content, nil
+}
+
+// save encrypts the content and replaces the vault file atomically
+func (v *Vault) save(content *fileContent) error {
+	plaintext, err := json.Marshal(content)
+	if err != nil {
+		return err
+	}
+
+	buf := &bytes.Buffer{}
+	armored, err := armor.Encode(buf, armorType, nil)
+	if err != nil {
+		return err
+	}
+	w, err := openpgp.SymmetricallyEncrypt(armored, []byte(v.password), nil, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(plaintext); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	if err := armored.Close(); err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(v.path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(v.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(buf.Bytes()); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), v.path)
+}
@@ -0,0 +1,98 @@
+package encryptedfile
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/providers-sdk/v1/vault"
+)
+
+func TestEncryptedFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "vault.asc")
+	v := New(path, "superpassword")
+	ctx := context.Background()
+
+	// an empty vault has no secrets
+	ids, err := v.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+	_, err = v.Get(ctx, &vault.SecretID{Key: "missing"})
+	assert.Equal(t, vault.NotFoundError, err)
+
+	credBytes, err := json.Marshal(map[string]string{"key": "value"})
+	require.NoError(t, err)
+
+	key := "mondoo-test-secret-key"
+	cred := &vault.Secret{
+		Key:      key,
+		Label:    "mondoo: " + key,
+		Data:     credBytes,
+		Encoding: vault.SecretEncoding_encoding_json,
+	}
+	id, err := v.Set(ctx, cred)
+	require.NoError(t, err)
+	_, err = v.Set(ctx, &vault.Secret{Key: "another-key", Data: []byte("secret")})
+	require.NoError(t, err)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	// create a new instance to test file reading
+	v2 := New(path, "superpassword")
+	newCred, err := v2.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, key, newCred.Key)
+	assert.Equal(t, cred.Label, newCred.Label)
+	assert.Equal(t, cred.Data, newCred.Data)
+	assert.Equal(t, vault.SecretEncoding_encoding_json, newCred.Encoding)
+
+	ids, err = v2.List(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []*vault.SecretID{{Key: "another-key"}, {Key: key}}, ids)
+
+	require.NoError(t, v2.Delete(ctx, id))
+	assert.Equal(t, vault.NotFoundError, v2.Delete(ctx, id))
+	_, err = v.Get(ctx, id)
+	assert.Equal(t, vault.NotFoundError, err)
+
+	// the file cannot be read with a wrong password
+	_, err = New(path, "wrongpassword").Get(ctx, id)
+	assert.Equal(t, errWrongPassword, err)
+}
+
+func TestEncryptedFileIsPortable(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "vault.asc")
+	v := New(path, "superpassword")
+	_, err := v.Set(context.Background(), &vault.Secret{Key: "key", Data: []byte("secret")})
+	require.NoError(t, err)
+
+	// decrypt the file with plain OpenPGP
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	block, err := armor.Decode(f)
+	require.NoError(t, err)
+	md, err := openpgp.ReadMessage(block.Body, nil, func(keys []openpgp.Key, symmetric bool) ([]byte, error) {
+		return []byte("superpassword"), nil
+	}, nil)
+	require.NoError(t, err)
+	data, err := io.ReadAll(md.UnverifiedBody)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"secrets":{"key":{"data":"c2VjcmV0"}}}`, string(data))
+}
+
+func TestEncryptedFileRequiresPassword(t *testing.T) {
+	v := New(filepath.Join(t.TempDir(), "vault.asc"), "")
+	_, err := v.Get(context.Background(), &vault.SecretID{Key: "key"})
+	assert.Error(t, err)
+}
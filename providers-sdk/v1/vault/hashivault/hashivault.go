@@ -73,7 +73,7 @@ func (v *Vault) Get(ctx context.Context, id *vault.SecretID) (*vault.Secret, err
 	}
 
 	secret, err := c.Logical().Read(vaultSecretId(id.Key))
-	if err != nil {
+	if err != nil || secret == nil {
 		return nil, vault.NotFoundError
 	}
 
@@ -85,6 +85,8 @@ func (v *Vault) Get(ctx context.Context, id *vault.SecretID) (*vault.Secret, err
 	return &vault.Secret{
 		Key:  id.Key,
 		Data: secretBytes,
+		// secrets with a lease must be retrieved again once it expires
+		Ttl: int64(secret.LeaseDuration),
 	}, nil
 }
 
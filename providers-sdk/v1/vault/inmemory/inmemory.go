@@ -3,6 +3,7 @@ package inmemory
 import (
 	"context"
 	"errors"
+	"sort"
 
 	"go.mondoo.com/cnquery/providers-sdk/v1/vault"
 )
@@ -57,3 +58,29 @@ func (v *inmemoryVault) Get(ctx context.Context, id *vault.SecretID) (*vault.Sec
 	}
 	return s, nil
 }
+
+func (v *inmemoryVault) List(ctx context.Context) ([]*vault.SecretID, error) {
+	keys := make([]string, 0, len(v.secrets))
+	for k := range v.secrets {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	res := make([]*vault.SecretID, len(keys))
+	for i := range keys {
+		res[i] = &vault.SecretID{Key: keys[i]}
+	}
+	return res, nil
+}
+
+func (v *inmemoryVault) Delete(ctx context.Context, id *vault.SecretID) error {
+	if id == nil {
+		return errors.New("secret id is empty")
+	}
+
+	if _, ok := v.secrets[id.Key]; !ok {
+		return vault.NotFoundError
+	}
+	delete(v.secrets, id.Key)
+	return nil
+}
@@ -38,3 +38,24 @@ func TestVault(t *testing.T) {
 	assert.Equal(t, cred.Label, newCred.Label)
 	assert.DeepEqual(t, cred.Data, newCred.Data)
 }
+
+func TestVaultListAndDelete(t *testing.T) {
+	v := New()
+	ctx := context.Background()
+
+	for _, key := range []string{"b", "a"} {
+		_, err := v.Set(ctx, &vault.Secret{Key: key})
+		require.NoError(t, err)
+	}
+
+	ids, err := vault.ListSecrets(ctx, v)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{"a", "b"}, []string{ids[0].Key, ids[1].Key})
+
+	require.NoError(t, vault.DeleteSecret(ctx, v, &vault.SecretID{Key: "a"}))
+	assert.Equal(t, vault.NotFoundError, vault.DeleteSecret(ctx, v, &vault.SecretID{Key: "a"}))
+
+	ids, err = vault.ListSecrets(ctx, v)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(ids))
+}
@@ -124,3 +124,36 @@ func (v *Vault) Get(ctx context.Context, id *vault.SecretID) (*vault.Secret, err
 		Encoding: vault.SecretEncoding_encoding_json,
 	}, nil
 }
+
+func (v *Vault) List(ctx context.Context) ([]*vault.SecretID, error) {
+	ring, err := v.open()
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := ring.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*vault.SecretID, len(keys))
+	for i := range keys {
+		res[i] = &vault.SecretID{Key: keys[i]}
+	}
+	return res, nil
+}
+
+func (v *Vault) Delete(ctx context.Context, id *vault.SecretID) error {
+	if id == nil {
+		return errors.New("id cannot be nil")
+	}
+	ring, err := v.open()
+	if err != nil {
+		return err
+	}
+
+	if _, err := ring.Get(id.Key); err != nil {
+		return vault.NotFoundError
+	}
+	return ring.Remove(id.Key)
+}
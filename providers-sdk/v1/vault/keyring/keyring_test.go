@@ -40,3 +40,22 @@ func TestEncryptedFile(t *testing.T) {
 	assert.Equal(t, cred.Label, newCred.Label)
 	assert.Equal(t, cred.Data, newCred.Data)
 }
+
+func TestEncryptedFileListAndDelete(t *testing.T) {
+	v := NewEncryptedFile(t.TempDir(), "mondoo", "superpassword")
+	ctx := context.Background()
+
+	_, err := v.Set(ctx, &vault.Secret{Key: "mondoo-test-list", Data: []byte("{}")})
+	require.NoError(t, err)
+
+	ids, err := vault.ListSecrets(ctx, v)
+	require.NoError(t, err)
+	assert.Equal(t, []*vault.SecretID{{Key: "mondoo-test-list"}}, ids)
+
+	require.NoError(t, vault.DeleteSecret(ctx, v, &vault.SecretID{Key: "mondoo-test-list"}))
+	assert.Equal(t, vault.NotFoundError, vault.DeleteSecret(ctx, v, &vault.SecretID{Key: "mondoo-test-list"}))
+
+	ids, err = vault.ListSecrets(ctx, v)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
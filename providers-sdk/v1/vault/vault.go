@@ -1,6 +1,7 @@
 package vault
 
 import (
+	"context"
 	"encoding/json"
 	"strings"
 
@@ -14,6 +15,17 @@ type Resolver interface {
 	GetCredential(cred *Credential) (*Credential, error)
 }
 
+// SecretLister is implemented by vaults which can list the ids of all
+// secrets they store
+type SecretLister interface {
+	List(ctx context.Context) ([]*SecretID, error)
+}
+
+// SecretDeleter is implemented by vaults which can delete secrets
+type SecretDeleter interface {
+	Delete(ctx context.Context, id *SecretID) error
+}
+
 //go:generate protoc --proto_path=../../../:. --go_out=. --go_opt=paths=source_relative --rangerrpc_out=. vault.proto
 
 func EscapeSecretID(key string) string {
@@ -22,6 +34,28 @@ func EscapeSecretID(key string) string {
 
 var NotFoundError = status.Error(codes.NotFound, "secret not found")
 
+var NotSupportedError = status.Error(codes.Unimplemented, "operation is not supported by this vault")
+
+// ListSecrets returns the ids of all secrets in the vault, if the vault
+// supports listing secrets
+func ListSecrets(ctx context.Context, v Vault) ([]*SecretID, error) {
+	lister, ok := v.(SecretLister)
+	if !ok {
+		return nil, NotSupportedError
+	}
+	return lister.List(ctx)
+}
+
+// DeleteSecret removes a secret from the vault, if the vault supports
+// deleting secrets
+func DeleteSecret(ctx context.Context, v Vault, id *SecretID) error {
+	deleter, ok := v.(SecretDeleter)
+	if !ok {
+		return NotSupportedError
+	}
+	return deleter.Delete(ctx, id)
+}
+
 // Credential parses the secret data and creates a credential
 func (x *Secret) Credential() (*Credential, error) {
 	var cred Credential
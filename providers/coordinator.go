@@ -3,6 +3,7 @@ package providers
 import (
 	"os"
 	"os/exec"
+	"strconv"
 	"sync"
 	"time"
 
@@ -13,6 +14,8 @@ import (
 	pp "go.mondoo.com/cnquery/providers-sdk/v1/plugin"
 	"go.mondoo.com/cnquery/providers-sdk/v1/resources"
 	"go.mondoo.com/cnquery/providers/core/resources/versions/semver"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var Coordinator = coordinator{
@@ -25,6 +28,18 @@ type coordinator struct {
 	mutex     sync.Mutex
 }
 
+const (
+	// interval in which running plugins are checked for responsiveness
+	heartbeatInterval = 30 * time.Second
+	// time after which a plugin that doesn't answer a heartbeat is considered hung
+	heartbeatTimeout = 10 * time.Second
+	// time plugins get to close their connections before they are stopped
+	shutdownTimeout = 5 * time.Second
+	// number of times a plugin is restarted after it crashed or hung,
+	// before the provider is given up
+	maxRestarts = 3
+)
+
 type RunningProvider struct {
 	Name   string
 	ID     string
@@ -33,6 +48,78 @@ type RunningProvider struct {
 	Schema *resources.Schema
 
 	isClosed bool
+	// number of runtimes that use this provider
+	refs int
+	// lock guards the plugin and client, which are replaced on restarts
+	lock sync.Mutex
+	// launch starts a new plugin process, it is only set for providers
+	// that are not built in
+	launch        func() (*plugin.Client, pp.ProviderPlugin, error)
+	stopHeartbeat chan struct{}
+	// exited reports if the plugin process is gone, e.g. because it crashed
+	exited func() bool
+	// generation is increased every time the plugin is restarted. Connections
+	// use it to detect that they have to be re-established.
+	generation int
+	// restartLock makes sure the plugin is only restarted once, when
+	// multiple callers detect that it crashed
+	restartLock sync.Mutex
+}
+
+// impl returns the current plugin implementation of this provider
+func (p *RunningProvider) impl() pp.ProviderPlugin {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.Plugin
+}
+
+// currentGeneration returns the generation of the running plugin
+func (p *RunningProvider) currentGeneration() int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.generation
+}
+
+// hasExited is true if the plugin process is no longer running, even
+// though the provider wasn't closed
+func (p *RunningProvider) hasExited() bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return !p.isClosed && p.exited != nil && p.exited()
+}
+
+// ping checks that the plugin responds. Plugins that were built before
+// heartbeats were added don't implement them, they are pinged via the
+// plugin client instead.
+func (p *RunningProvider) ping() error {
+	p.lock.Lock()
+	impl, client := p.Plugin, p.Client
+	p.lock.Unlock()
+
+	_, err := impl.Heartbeat(&pp.HeartbeatReq{})
+	if !isUnimplemented(err) {
+		return err
+	}
+	if client == nil {
+		return nil
+	}
+	rpcClient, err := client.Client()
+	if err != nil {
+		return err
+	}
+	return rpcClient.Ping()
+}
+
+// isUnimplemented is true for errors of requests that the plugin doesn't
+// support, e.g. because it was built for an older version of the protocol
+func isUnimplemented(err error) bool {
+	return status.Code(err) == codes.Unimplemented
+}
+
+// crashed is true if the plugin of the given generation is gone, either
+// because its process exited or because it was already restarted
+func (p *RunningProvider) crashed(generation int) bool {
+	return p.currentGeneration() != generation || p.hasExited()
 }
 
 type UpdateProvidersConfig struct {
@@ -86,6 +173,35 @@ func (c *coordinator) Start(id string, update UpdateProvidersConfig) (*RunningPr
 		}
 	}
 
+	launch := func() (*plugin.Client, pp.ProviderPlugin, error) {
+		return startPlugin(provider)
+	}
+	client, impl, err := launch()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &RunningProvider{
+		Name:          provider.Name,
+		ID:            provider.ID,
+		Plugin:        impl,
+		Client:        client,
+		Schema:        provider.Schema,
+		launch:        launch,
+		stopHeartbeat: make(chan struct{}),
+		exited:        client.Exited,
+	}
+
+	c.mutex.Lock()
+	c.Running = append(c.Running, res)
+	c.mutex.Unlock()
+
+	go c.heartbeat(res, heartbeatInterval, heartbeatTimeout)
+
+	return res, nil
+}
+
+func startPlugin(provider *Provider) (*plugin.Client, pp.ProviderPlugin, error) {
 	pluginCmd := exec.Command(provider.binPath(), "run_as_plugin")
 	log.Debug().Str("path", pluginCmd.Path).Msg("running provider plugin")
 
@@ -106,7 +222,7 @@ func (c *coordinator) Start(id string, update UpdateProvidersConfig) (*RunningPr
 	rpcClient, err := client.Client()
 	if err != nil {
 		client.Kill()
-		return nil, errors.Wrap(err, "failed to initialize plugin client")
+		return nil, nil, errors.Wrap(err, "failed to initialize plugin client")
 	}
 
 	// Request the plugin
@@ -114,22 +230,106 @@ func (c *coordinator) Start(id string, update UpdateProvidersConfig) (*RunningPr
 	raw, err := rpcClient.Dispense(pluginName)
 	if err != nil {
 		client.Kill()
-		return nil, errors.Wrap(err, "failed to call "+pluginName+" plugin")
+		return nil, nil, errors.Wrap(err, "failed to call "+pluginName+" plugin")
 	}
 
-	res := &RunningProvider{
-		Name:   provider.Name,
-		ID:     provider.ID,
-		Plugin: raw.(pp.ProviderPlugin),
-		Client: client,
-		Schema: provider.Schema,
+	return client, raw.(pp.ProviderPlugin), nil
+}
+
+// heartbeat checks in regular intervals if the provider still runs and
+// responds and restarts it if it doesn't. It stops once the provider is closed.
+func (c *coordinator) heartbeat(p *RunningProvider, interval time.Duration, timeout time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stopHeartbeat:
+			return
+		case <-ticker.C:
+		}
+
+		generation := p.currentGeneration()
+		if p.hasExited() {
+			log.Warn().Str("provider", p.Name).Msg("provider crashed, restarting it")
+		} else {
+			err := callWithTimeout(timeout, p.ping)
+			if err == nil {
+				continue
+			}
+			log.Warn().Err(err).Str("provider", p.Name).Msg("provider stopped responding, restarting it")
+		}
+
+		if err := c.restart(p, generation); err != nil {
+			log.Error().Err(err).Str("provider", p.Name).Msg("failed to restart provider")
+			return
+		}
 	}
+}
 
-	c.mutex.Lock()
-	c.Running = append(c.Running, res)
-	c.mutex.Unlock()
+// restart stops the provider's plugin of the given generation and replaces
+// it with a new one. If the plugin was already restarted in the meantime,
+// nothing happens. Connections of the old plugin are lost and have to be
+// re-established by their runtimes.
+func (c *coordinator) restart(p *RunningProvider, generation int) error {
+	if p.launch == nil {
+		return errors.New("cannot restart builtin provider " + p.Name)
+	}
 
-	return res, nil
+	p.restartLock.Lock()
+	defer p.restartLock.Unlock()
+
+	if p.currentGeneration() != generation {
+		return nil
+	}
+	if generation >= maxRestarts {
+		return errors.New("provider " + p.Name + " was restarted " + strconv.Itoa(maxRestarts) + " times, giving up")
+	}
+
+	client, impl, err := p.launch()
+	if err != nil {
+		return err
+	}
+
+	p.lock.Lock()
+	if p.isClosed {
+		// the provider was closed while the new plugin started
+		p.lock.Unlock()
+		if client != nil {
+			client.Kill()
+		}
+		return nil
+	}
+	old := p.Client
+	p.Client = client
+	p.Plugin = impl
+	p.exited = nil
+	if client != nil {
+		p.exited = client.Exited
+	}
+	p.generation++
+	p.lock.Unlock()
+
+	if old != nil {
+		old.Kill()
+	}
+	return nil
+}
+
+// callWithTimeout calls f and returns an error if it does not return in time.
+// The call itself is not interrupted.
+func callWithTimeout(timeout time.Duration, f func() error) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- f()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(timeout):
+		return errors.New("no response after " + timeout.String())
+	}
 }
 
 type ProviderVersions struct {
@@ -198,13 +398,25 @@ func (c *coordinator) tryProviderUpdate(provider *Provider, update UpdateProvide
 	return provider, nil
 }
 
+// Retain marks a provider as used by one more runtime. Providers are only
+// closed once the last runtime that uses them is closed.
+func (c *coordinator) Retain(p *RunningProvider) {
+	c.mutex.Lock()
+	p.refs++
+	c.mutex.Unlock()
+}
+
 func (c *coordinator) Close(p *RunningProvider) {
-	if !p.isClosed {
-		p.isClosed = true
-		if p.Client != nil {
-			p.Client.Kill()
-		}
+	c.mutex.Lock()
+	if p.refs > 1 {
+		p.refs--
+		c.mutex.Unlock()
+		return
 	}
+	p.refs = 0
+	c.mutex.Unlock()
+
+	p.stop()
 
 	c.mutex.Lock()
 	for i := range c.Running {
@@ -219,13 +431,40 @@ func (c *coordinator) Close(p *RunningProvider) {
 func (c *coordinator) Shutdown() {
 	c.mutex.Lock()
 	for i := range c.Running {
-		cur := c.Running[i]
-		cur.isClosed = true
-		cur.Client.Kill()
+		c.Running[i].stop()
 	}
 	c.mutex.Unlock()
 }
 
+// stop shuts down the provider's plugin. It gets the chance to close all
+// its connections before it is killed. Builtin providers keep running.
+func (p *RunningProvider) stop() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.isClosed {
+		return
+	}
+	p.isClosed = true
+
+	if p.stopHeartbeat != nil {
+		close(p.stopHeartbeat)
+	}
+	if p.Client == nil {
+		return
+	}
+
+	impl := p.Plugin
+	err := callWithTimeout(shutdownTimeout, func() error {
+		_, err := impl.Shutdown(&pp.ShutdownReq{})
+		return err
+	})
+	if err != nil {
+		log.Debug().Err(err).Str("provider", p.Name).Msg("failed to shut down provider")
+	}
+	p.Client.Kill()
+}
+
 func (c *coordinator) LoadSchema(name string) (*resources.Schema, error) {
 	if x, ok := builtinProviders[name]; ok {
 		return x.Runtime.Schema, nil
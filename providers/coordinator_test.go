@@ -0,0 +1,248 @@
+package providers
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+	pp "go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCoordinator_HeartbeatRestartsHungProvider(t *testing.T) {
+	hung := &testPlugin{hung: make(chan struct{})}
+	defer close(hung.hung)
+
+	restarted := &testPlugin{}
+	launches := 0
+	p := &RunningProvider{
+		Name:   "test",
+		ID:     "test",
+		Plugin: hung,
+		launch: func() (*plugin.Client, pp.ProviderPlugin, error) {
+			launches++
+			return nil, restarted, nil
+		},
+		stopHeartbeat: make(chan struct{}),
+	}
+
+	c := &coordinator{}
+	done := make(chan struct{})
+	go func() {
+		c.heartbeat(p, 10*time.Millisecond, 10*time.Millisecond)
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool {
+		return p.impl() == restarted
+	}, time.Second, 5*time.Millisecond)
+
+	// responsive providers are not restarted again
+	time.Sleep(50 * time.Millisecond)
+	p.stop()
+	<-done
+	assert.Equal(t, 1, launches)
+}
+
+// legacyPlugin was built before heartbeats and disconnects were added
+type legacyPlugin struct {
+	testPlugin
+}
+
+func (l *legacyPlugin) Heartbeat(req *pp.HeartbeatReq) (*pp.HeartbeatRes, error) {
+	return nil, status.Error(codes.Unimplemented, "unknown method Heartbeat")
+}
+
+func (l *legacyPlugin) Disconnect(req *pp.DisconnectReq) (*pp.DisconnectRes, error) {
+	return nil, status.Error(codes.Unimplemented, "unknown method Disconnect")
+}
+
+func TestCoordinator_HeartbeatKeepsLegacyProvider(t *testing.T) {
+	client, server := plugin.TestPluginGRPCConn(t, map[string]plugin.Plugin{
+		"provider": &pp.ProviderPluginImpl{Impl: &legacyPlugin{}},
+	})
+	defer client.Close()
+	defer server.Stop()
+	raw, err := client.Dispense("provider")
+	require.NoError(t, err)
+
+	launches := 0
+	p := &RunningProvider{
+		Name:   "test",
+		ID:     "test",
+		Plugin: raw.(pp.ProviderPlugin),
+		launch: func() (*plugin.Client, pp.ProviderPlugin, error) {
+			launches++
+			return nil, &testPlugin{}, nil
+		},
+		stopHeartbeat: make(chan struct{}),
+	}
+	assert.NoError(t, p.ping())
+
+	c := &coordinator{}
+	done := make(chan struct{})
+	go func() {
+		c.heartbeat(p, 5*time.Millisecond, 100*time.Millisecond)
+		close(done)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	p.stop()
+	<-done
+	assert.Equal(t, 0, launches)
+}
+
+func TestRuntime_CloseLegacyProvider(t *testing.T) {
+	var logs bytes.Buffer
+	logger := log.Logger
+	log.Logger = zerolog.New(&logs)
+	defer func() { log.Logger = logger }()
+
+	r := newTestRuntime(&legacyPlugin{})
+	r.Close()
+	assert.NotContains(t, logs.String(), "failed to disconnect")
+}
+
+func TestCoordinator_RestartBuiltinProvider(t *testing.T) {
+	c := &coordinator{}
+	p := &RunningProvider{Name: "test", Plugin: &testPlugin{}}
+	assert.Error(t, c.restart(p, 0))
+}
+
+func TestRuntime_CloseDisconnects(t *testing.T) {
+	p := &testPlugin{}
+	r := newTestRuntime(p)
+	r.Close()
+	assert.Equal(t, []uint32{1}, p.disconnected)
+}
+
+func TestCoordinator_RestartOnlyOnce(t *testing.T) {
+	launches := 0
+	p := &RunningProvider{
+		Name:   "test",
+		Plugin: &testPlugin{},
+		launch: func() (*plugin.Client, pp.ProviderPlugin, error) {
+			launches++
+			return nil, &testPlugin{}, nil
+		},
+	}
+
+	c := &coordinator{}
+	require.NoError(t, c.restart(p, 0))
+	// the plugin of generation 0 was already replaced
+	require.NoError(t, c.restart(p, 0))
+	assert.Equal(t, 1, launches)
+	assert.Equal(t, 1, p.currentGeneration())
+
+	require.NoError(t, c.restart(p, 1))
+	require.NoError(t, c.restart(p, 2))
+	assert.Error(t, c.restart(p, maxRestarts))
+	assert.Equal(t, maxRestarts, launches)
+}
+
+func TestRuntime_RecoverCrashedProvider(t *testing.T) {
+	crashing := &testPlugin{value: "one"}
+	restarted := &testPlugin{value: "two"}
+	r := newTestRuntime(crashing)
+
+	exited := false
+	instance := r.Provider.Instance
+	instance.exited = func() bool { return exited }
+	instance.launch = func() (*plugin.Client, pp.ProviderPlugin, error) {
+		return nil, restarted, nil
+	}
+
+	req := &pp.ConnectReq{Asset: &inventory.Asset{Name: "test"}}
+	require.NoError(t, r.Provider.connect(req, &providerCallbacks{runtime: r}))
+	_, err := r.CreateResourceWithID("test", "1", map[string]*llx.Primitive{})
+	require.NoError(t, err)
+
+	raw, err := r.fetchField("test", "1", "value", false)
+	require.NoError(t, err)
+	assert.Equal(t, "one", raw.Value)
+
+	t.Run("errors are returned as long as the plugin runs", func(t *testing.T) {
+		crashing.err = errors.New("failed to compute")
+		_, err := r.fetchField("test", "1", "value", false)
+		assert.EqualError(t, err, "failed to compute")
+		assert.Equal(t, crashing, instance.impl())
+	})
+
+	t.Run("in-flight fields fail when the plugin crashes", func(t *testing.T) {
+		crashing.err = errors.New("connection is shut down")
+		exited = true
+		raw, err := r.fetchField("test", "1", "value", false)
+		require.NoError(t, err)
+		assert.EqualError(t, raw.Error, "provider test crashed while computing test.value")
+		assert.Equal(t, restarted, instance.impl())
+	})
+
+	t.Run("the connection is re-established", func(t *testing.T) {
+		require.Len(t, restarted.connects, 1)
+		assert.Same(t, req, restarted.connects[0])
+		require.Len(t, restarted.stored, 1)
+		assert.Equal(t, "test", restarted.stored[0].Name)
+		assert.Equal(t, "1", restarted.stored[0].Id)
+
+		raw, err := r.fetchField("test", "1", "value", false)
+		require.NoError(t, err)
+		assert.Equal(t, "two", raw.Value)
+	})
+}
+
+func TestRuntime_RecreateResourcesAfterRestart(t *testing.T) {
+	crashing := &testPlugin{value: "one", resources: map[string]struct{}{}}
+	restarted := &testPlugin{value: "two", resources: map[string]struct{}{}}
+	r := newTestRuntime(crashing)
+
+	instance := r.Provider.Instance
+	instance.launch = func() (*plugin.Client, pp.ProviderPlugin, error) {
+		return nil, restarted, nil
+	}
+	require.NoError(t, r.Provider.connect(&pp.ConnectReq{}, &providerCallbacks{runtime: r}))
+
+	resource, err := r.CreateResource("test", map[string]*llx.Primitive{
+		"id": llx.StringPrimitive("1"),
+	})
+	require.NoError(t, err)
+	raw, err := r.fetchField("test", resource.MqlID(), "value", false)
+	require.NoError(t, err)
+	assert.Equal(t, "one", raw.Value)
+
+	require.NoError(t, r.coordinator.restart(instance, 0))
+
+	// the resource was created before the crash and is created again
+	raw, err = r.fetchField("test", resource.MqlID(), "value", false)
+	require.NoError(t, err)
+	assert.Equal(t, "two", raw.Value)
+	require.Len(t, restarted.stored, 1)
+	assert.Equal(t, "test", restarted.stored[0].Name)
+	assert.Equal(t, "1", restarted.stored[0].Id)
+	assert.Equal(t, "1", string(restarted.stored[0].Fields["id"].Data.Value))
+}
+
+func TestRuntime_ReconnectAfterHeartbeatRestart(t *testing.T) {
+	restarted := &testPlugin{value: "two"}
+	r := newTestRuntime(&testPlugin{value: "one"})
+	instance := r.Provider.Instance
+	instance.launch = func() (*plugin.Client, pp.ProviderPlugin, error) {
+		return nil, restarted, nil
+	}
+	require.NoError(t, r.Provider.connect(&pp.ConnectReq{}, &providerCallbacks{runtime: r}))
+
+	// e.g. the heartbeat restarted a hung plugin
+	require.NoError(t, r.coordinator.restart(instance, 0))
+
+	raw, err := r.fetchField("test", "1", "value", false)
+	require.NoError(t, err)
+	assert.Equal(t, "two", raw.Value)
+	assert.Len(t, restarted.connects, 1)
+}
@@ -1,8 +1,8 @@
 package provider
 
 import (
+	"context"
 	"errors"
-	"strconv"
 	"strings"
 
 	"go.mondoo.com/cnquery/llx"
@@ -11,16 +11,13 @@ import (
 	"go.mondoo.com/cnquery/types"
 )
 
-const defaultConnection uint32 = 1
-
 type Service struct {
-	runtimes         map[uint32]*plugin.Runtime
-	lastConnectionID uint32
+	*plugin.Service
 }
 
 func Init() *Service {
 	return &Service{
-		runtimes: map[uint32]*plugin.Runtime{},
+		Service: plugin.NewService(),
 	}
 }
 
@@ -33,14 +30,13 @@ func (s *Service) Connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 		return nil, errors.New("no connection data provided")
 	}
 
-	s.lastConnectionID++
-	connID := s.lastConnectionID
+	connID := s.NewConnectionID()
 	runtime := &plugin.Runtime{
 		Resources:    map[string]plugin.Resource{},
 		Callback:     callback,
 		HasRecording: req.HasRecording,
 	}
-	s.runtimes[connID] = runtime
+	s.AddRuntime(connID, runtime)
 
 	asset := req.Asset
 	_, err := resources.CreateResource(runtime, "asset", map[string]*llx.RawData{
@@ -61,17 +57,23 @@ func (s *Service) Connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 	}
 
 	return &plugin.ConnectRes{
-		Id:   defaultConnection,
+		Id:   connID,
 		Name: "core",
 	}, nil
 }
 
-func (s *Service) GetData(req *plugin.DataReq) (*plugin.DataRes, error) {
-	runtime, ok := s.runtimes[req.Connection]
-	if !ok {
-		return nil, errors.New("connection " + strconv.FormatUint(uint64(req.Connection), 10) + " not found")
+func (s *Service) GetData(ctx context.Context, req *plugin.DataReq) (*plugin.DataRes, error) {
+	runtime, err := s.GetRuntime(req.Connection)
+	if err != nil {
+		return nil, err
 	}
 
+	return runtime.Do(ctx, func() (*plugin.DataRes, error) {
+		return s.getData(runtime, req)
+	})
+}
+
+func (s *Service) getData(runtime *plugin.Runtime, req *plugin.DataReq) (*plugin.DataRes, error) {
 	args := plugin.PrimitiveArgsToRawDataArgs(req.Args, runtime)
 
 	if req.ResourceId == "" && req.Field == "" {
@@ -95,9 +97,9 @@ func (s *Service) GetData(req *plugin.DataReq) (*plugin.DataRes, error) {
 }
 
 func (s *Service) StoreData(req *plugin.StoreReq) (*plugin.StoreRes, error) {
-	runtime, ok := s.runtimes[req.Connection]
-	if !ok {
-		return nil, errors.New("connection " + strconv.FormatUint(uint64(req.Connection), 10) + " not found")
+	runtime, err := s.GetRuntime(req.Connection)
+	if err != nil {
+		return nil, err
 	}
 
 	var errs []string
@@ -411,6 +411,68 @@ func TestString_Methods(t *testing.T) {
 			Code:        "'hello ' + 'world'",
 			Expectation: "hello world",
 		},
+		{
+			Code:        "'hello world'.replace('o', '0')",
+			Expectation: "hell0 w0rld",
+		},
+		{
+			Code:        "'PermitRootLogin   yes'.replace(/\\s+/, ' ')",
+			Expectation: "PermitRootLogin yes",
+		},
+		{
+			Code:        "'hello'.substring(1, 3)",
+			Expectation: "el",
+		},
+		{
+			Code:        "'hello'.substring(2)",
+			Expectation: "llo",
+		},
+		{
+			Code:        "'hello'.substring(3, 99)",
+			Expectation: "lo",
+		},
+		{
+			Code:        "'hello'.startsWith('he')",
+			Expectation: true,
+		},
+		{
+			Code:        "'hello'.endsWith('he')",
+			Expectation: false,
+		},
+		{
+			Code:        "'b'.in(['a', 'b'])",
+			Expectation: true,
+		},
+		{
+			Code:        "'c'.in(['a', 'b'])",
+			Expectation: false,
+		},
+		{
+			Code:        "'v1.22.3'.capture(/v(?P<major>\\d+)\\.(?P<minor>\\d+)/)",
+			Expectation: map[string]interface{}{"major": "1", "minor": "22"},
+		},
+		{
+			Code:        "'nope'.capture(/(?P<major>\\d+)/)",
+			Expectation: map[string]interface{}{},
+		},
+	})
+}
+
+func TestSprintf(t *testing.T) {
+	x := testutils.InitTester(testutils.LinuxMock("../../../providers-sdk/v1/testutils"))
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			Code:        "sprintf('%s has %d items', 'list', 3)",
+			Expectation: "list has 3 items",
+		},
+		{
+			Code:        "a = 1.5; sprintf('%.2f', a)",
+			Expectation: "1.50",
+		},
+		{
+			Code:        "sprintf('no args')",
+			Expectation: "no args",
+		},
 	})
 }
 
@@ -628,6 +690,57 @@ func TestArray(t *testing.T) {
 			Code:        "[3,1,3,4,2] - [3,4,5]",
 			Expectation: []interface{}{int64(1), int64(2)},
 		},
+		{
+			Code:        "[3,1,2].sort",
+			Expectation: []interface{}{int64(1), int64(2), int64(3)},
+		},
+		{
+			Code:        "['b','c','a'].sort()",
+			Expectation: []interface{}{"a", "b", "c"},
+		},
+		{
+			Code:        "[[2,1],[1,3],[3,2]].sort(_[0]).map(_[1])",
+			Expectation: []interface{}{int64(3), int64(1), int64(2)},
+		},
+		{
+			Code:        "[3,1,2].min",
+			Expectation: int64(1),
+		},
+		{
+			Code:        "[3,1,2].max",
+			Expectation: int64(3),
+		},
+		{
+			Code:        "['b','c','a'].max",
+			Expectation: "c",
+		},
+		{
+			Code:        "[3,1,2].sum",
+			Expectation: int64(6),
+		},
+		{
+			Code:        "[1.5,2.5].sum",
+			Expectation: float64(4),
+		},
+		{
+			Code:        "[1,2].avg",
+			Expectation: float64(1.5),
+		},
+		{
+			Code:        "[0].where(_ > 0).max",
+			Expectation: nil,
+		},
+		{
+			Code:        "[0].where(_ > 0).sum",
+			Expectation: int64(0),
+		},
+		{
+			Code: "['ab','cd','b'].groupBy(_.length)",
+			Expectation: map[string]interface{}{
+				"1": []interface{}{"b"},
+				"2": []interface{}{"ab", "cd"},
+			},
+		},
 	})
 }
 
@@ -36,3 +36,12 @@ func (p *HostConnection) FQDN() string {
 	}
 	return p.Conf.Host
 }
+
+// DnsServer returns the dns server configured via the dns_server option,
+// e.g. "10.0.0.2" or "10.0.0.2:53". It is empty if the system resolver is used.
+func (p *HostConnection) DnsServer() string {
+	if p.Conf == nil || p.Conf.Options == nil {
+		return ""
+	}
+	return p.Conf.Options["dns_server"]
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"errors"
 	"net/url"
 	"strconv"
@@ -33,13 +34,12 @@ var commonPorts = map[string]int{
 }
 
 type Service struct {
-	runtimes         map[uint32]*plugin.Runtime
-	lastConnectionID uint32
+	*plugin.Service
 }
 
 func Init() *Service {
 	return &Service{
-		runtimes: map[uint32]*plugin.Runtime{},
+		Service: plugin.NewService(),
 	}
 }
 
@@ -116,13 +116,11 @@ func (s *Service) connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 
 	switch conf.Type {
 	case "host":
-		s.lastConnectionID++
-		conn = connection.NewHostConnection(s.lastConnectionID, asset, conf)
+		conn = connection.NewHostConnection(s.NewConnectionID(), asset, conf)
 
 	default:
 		// generic host connection, without anything else
-		s.lastConnectionID++
-		conn = connection.NewHostConnection(s.lastConnectionID, asset, conf)
+		conn = connection.NewHostConnection(s.NewConnectionID(), asset, conf)
 	}
 
 	if err != nil {
@@ -138,14 +136,14 @@ func (s *Service) connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 	}
 
 	asset.Connections[0].Id = conn.ID()
-	s.runtimes[conn.ID()] = &plugin.Runtime{
+	s.AddRuntime(conn.ID(), &plugin.Runtime{
 		Connection:     conn,
 		Resources:      map[string]plugin.Resource{},
 		Callback:       callback,
 		HasRecording:   req.HasRecording,
 		CreateResource: resources.CreateResource,
 		Upstream:       upstream,
-	}
+	})
 
 	return conn, err
 }
@@ -167,12 +165,18 @@ func (s *Service) detect(asset *inventory.Asset, conn *connection.HostConnection
 	return nil
 }
 
-func (s *Service) GetData(req *plugin.DataReq) (*plugin.DataRes, error) {
-	runtime, ok := s.runtimes[req.Connection]
-	if !ok {
-		return nil, errors.New("connection " + strconv.FormatUint(uint64(req.Connection), 10) + " not found")
+func (s *Service) GetData(ctx context.Context, req *plugin.DataReq) (*plugin.DataRes, error) {
+	runtime, err := s.GetRuntime(req.Connection)
+	if err != nil {
+		return nil, err
 	}
 
+	return runtime.Do(ctx, func() (*plugin.DataRes, error) {
+		return s.getData(runtime, req)
+	})
+}
+
+func (s *Service) getData(runtime *plugin.Runtime, req *plugin.DataReq) (*plugin.DataRes, error) {
 	args := plugin.PrimitiveArgsToRawDataArgs(req.Args, runtime)
 
 	if req.ResourceId == "" && req.Field == "" {
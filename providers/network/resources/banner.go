@@ -0,0 +1,95 @@
+package resources
+
+import (
+	"errors"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/providers/network/connection"
+)
+
+const (
+	// bannerTimeout is the time we wait for a service to send its banner
+	bannerTimeout = 5 * time.Second
+	// bannerLineTimeout is the time we wait for additional lines of a banner
+	bannerLineTimeout = 500 * time.Millisecond
+	bannerMaxLength   = 4096
+)
+
+func initBanner(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	// if the socket is set already, we have nothing else to do
+	if _, ok := args["socket"]; ok {
+		return args, nil, nil
+	}
+
+	rawPort, ok := args["port"]
+	if !ok {
+		return nil, nil, errors.New("banner requires a port")
+	}
+	port, ok := rawPort.Value.(int64)
+	if !ok || port <= 0 || port > 65535 {
+		return nil, nil, errors.New("banner requires a valid port")
+	}
+
+	conn := runtime.Connection.(*connection.HostConnection)
+	socket, err := CreateResource(runtime, "socket", map[string]*llx.RawData{
+		"protocol": llx.StringData("tcp"),
+		"port":     llx.IntData(port),
+		"address":  llx.StringData(conn.FQDN()),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	delete(args, "port")
+	args["socket"] = llx.ResourceData(socket, "socket")
+	return args, nil, nil
+}
+
+func (b *mqlBanner) id() (string, error) {
+	return "banner+" + b.Socket.Data.__id, nil
+}
+
+func (b *mqlBanner) text() (string, error) {
+	socket := b.Socket.Data
+	target := net.JoinHostPort(socket.Address.Data, strconv.Itoa(int(socket.Port.Data)))
+	return grabBanner(socket.Protocol.Data, target)
+}
+
+// grabBanner returns the text a service sends after the connection is
+// established. Multi-line banners are collected until the service pauses.
+func grabBanner(proto string, target string) (string, error) {
+	conn, err := net.DialTimeout(proto, target, bannerTimeout)
+	if err != nil {
+		return "", errors.New("failed to connect to target: " + err.Error())
+	}
+	defer conn.Close()
+
+	res := []byte{}
+	buf := make([]byte, bannerMaxLength)
+	timeout := bannerTimeout
+	for len(res) < bannerMaxLength {
+		if err := conn.SetReadDeadline(time.Now().Add(timeout)); err != nil {
+			return "", err
+		}
+
+		n, err := conn.Read(buf[:bannerMaxLength-len(res)])
+		res = append(res, buf[:n]...)
+		if err != nil {
+			// the service may not send a banner or is done sending it
+			if errors.Is(err, os.ErrDeadlineExceeded) || errors.Is(err, io.EOF) {
+				break
+			}
+			return "", err
+		}
+		timeout = bannerLineTimeout
+	}
+
+	return strings.TrimSpace(string(res)), nil
+}
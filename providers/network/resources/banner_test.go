@@ -0,0 +1,65 @@
+package resources
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+)
+
+// startBannerServer accepts a single connection and sends the given lines with
+// a short pause in between
+func startBannerServer(t *testing.T, lines ...string) int64 {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for i := range lines {
+			conn.Write([]byte(lines[i]))
+			time.Sleep(50 * time.Millisecond)
+		}
+		// keep the connection open like most services do
+		time.Sleep(2 * bannerLineTimeout)
+	}()
+
+	return int64(l.Addr().(*net.TCPAddr).Port)
+}
+
+func TestResource_Banner(t *testing.T) {
+	runtime := newTestRuntime(&inventory.Config{Host: "127.0.0.1"})
+
+	t.Run("single line", func(t *testing.T) {
+		port := startBannerServer(t, "220 mail.example.com ESMTP Postfix\r\n")
+		res := newTestResource[*mqlBanner](t, runtime, "banner", map[string]*llx.RawData{"port": llx.IntData(port)})
+		assert.Equal(t, port, res.Socket.Data.Port.Data)
+
+		text := res.GetText()
+		require.NoError(t, text.Error)
+		assert.Equal(t, "220 mail.example.com ESMTP Postfix", text.Data)
+	})
+
+	t.Run("multiple lines", func(t *testing.T) {
+		port := startBannerServer(t, "220-ftp.example.com\r\n", "220 Welcome\r\n")
+		res := newTestResource[*mqlBanner](t, runtime, "banner", map[string]*llx.RawData{"port": llx.IntData(port)})
+		text := res.GetText()
+		require.NoError(t, text.Error)
+		assert.Equal(t, "220-ftp.example.com\r\n220 Welcome", text.Data)
+	})
+
+	t.Run("invalid port", func(t *testing.T) {
+		_, err := NewResource(runtime, "banner", map[string]*llx.RawData{
+			"port": llx.IntData(70000),
+		})
+		assert.Error(t, err)
+	})
+}
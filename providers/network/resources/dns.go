@@ -61,8 +61,18 @@ func initDns(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]
 	return args, nil, nil
 }
 
+// newDnsClient returns a client which uses the dns server configured for the
+// connection or the system resolver
+func newDnsClient(runtime *plugin.Runtime, fqdn string) (*dnsshake.DnsClient, error) {
+	conn := runtime.Connection.(*connection.HostConnection)
+	if server := conn.DnsServer(); server != "" {
+		return dnsshake.NewWithServer(fqdn, server)
+	}
+	return dnsshake.New(fqdn)
+}
+
 func (d *mqlDns) params(fqdn string) (interface{}, error) {
-	dnsShaker, err := dnsshake.New(fqdn)
+	dnsShaker, err := newDnsClient(d.MqlRuntime, fqdn)
 	if err != nil {
 		return nil, err
 	}
@@ -274,3 +284,255 @@ func (d *mqlDnsDkimRecord) valid() (bool, error) {
 	ok, _, _ := d.dkim.Valid()
 	return ok, nil
 }
+
+func (d *mqlDns) spf(params interface{}) (*mqlDnsSpfRecord, error) {
+	var spfEntries []string
+	if paramsM, ok := params.(map[string]interface{}); ok {
+		if r, ok := paramsM["TXT"].(map[string]interface{}); ok {
+			rdata, _ := r["rData"].([]interface{})
+			for j := range rdata {
+				entry, _ := rdata[j].(string)
+				if dnsshake.IsSpf(entry) {
+					spfEntries = append(spfEntries, strings.TrimSpace(entry))
+				}
+			}
+		}
+	}
+
+	switch len(spfEntries) {
+	case 0:
+		d.Spf.State = plugin.StateIsSet | plugin.StateIsNull
+		return nil, nil
+	case 1:
+	default:
+		// see https://datatracker.ietf.org/doc/html/rfc7208#section-4.5
+		return nil, errors.New("found multiple spf records for " + d.Fqdn.Data)
+	}
+
+	entry := spfEntries[0]
+	record, err := dnsshake.NewSpf().Parse(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	mechanisms := make([]interface{}, len(record.Directives))
+	for i := range record.Directives {
+		directive := record.Directives[i]
+		qualifier := directive.Qualifier
+		if qualifier == "" {
+			qualifier = "+"
+		}
+		o, err := CreateResource(d.MqlRuntime, "dns.spfMechanism", map[string]*llx.RawData{
+			"qualifier": llx.StringData(qualifier),
+			"mechanism": llx.StringData(directive.Mechanism),
+			"value":     llx.StringData(directive.Value),
+			"cidr":      llx.StringData(directive.CIDR),
+		})
+		if err != nil {
+			return nil, err
+		}
+		mechanisms[i] = o
+	}
+
+	modifiers := make(map[string]interface{}, len(record.Modifiers))
+	for i := range record.Modifiers {
+		modifiers[record.Modifiers[i].Modifier] = record.Modifiers[i].Value
+	}
+
+	o, err := CreateResource(d.MqlRuntime, "dns.spfRecord", map[string]*llx.RawData{
+		"dnsTxt":     llx.StringData(entry),
+		"domain":     llx.StringData(d.Fqdn.Data),
+		"version":    llx.StringData(record.Version),
+		"mechanisms": llx.ArrayData(mechanisms, types.Resource("dns.spfMechanism")),
+		"modifiers":  llx.MapData(modifiers, types.String),
+		"includes":   llx.ArrayData(llx.TArr2Raw(record.Includes()), types.String),
+	})
+	if err != nil {
+		return nil, err
+	}
+	spfRecord := o.(*mqlDnsSpfRecord)
+	spfRecord.spf = record
+	return spfRecord, nil
+}
+
+type mqlDnsSpfRecordInternal struct {
+	spf *dnsshake.SpfRecord
+}
+
+func (d *mqlDnsSpfRecord) id() (string, error) {
+	hasher := sha256.New()
+	hasher.Write([]byte(d.DnsTxt.Data))
+	sha256 := hex.EncodeToString(hasher.Sum(nil))
+	return "dns.spf/" + d.Domain.Data + "/" + sha256, nil
+}
+
+func (d *mqlDnsSpfRecord) dnsLookups() (int64, error) {
+	if d.spf == nil {
+		return 0, errors.New("could not load spf data")
+	}
+
+	client, err := newDnsClient(d.MqlRuntime, d.Domain.Data)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := client.SpfDnsLookups(d.spf)
+	if err != nil {
+		return 0, err
+	}
+	return int64(count), nil
+}
+
+func (d *mqlDnsSpfRecord) valid() (bool, error) {
+	lookups := d.GetDnsLookups()
+	if lookups.Error != nil {
+		return false, lookups.Error
+	}
+	return lookups.Data <= dnsshake.SpfLookupLimit, nil
+}
+
+func (d *mqlDnsSpfMechanism) id() (string, error) {
+	return "dns.spfMechanism/" + d.Qualifier.Data + d.Mechanism.Data + ":" + d.Value.Data + "/" + d.Cidr.Data, nil
+}
+
+func (d *mqlDns) dmarc(fqdn string) (*mqlDnsDmarcRecord, error) {
+	client, err := newDnsClient(d.MqlRuntime, fqdn)
+	if err != nil {
+		return nil, err
+	}
+
+	domain := dnsshake.DmarcDomain(fqdn)
+	txts, err := client.TxtRecords(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	var dmarcEntries []string
+	for i := range txts {
+		entry := strings.TrimSpace(txts[i])
+		if strings.HasPrefix(entry, "v=DMARC1") {
+			dmarcEntries = append(dmarcEntries, entry)
+		}
+	}
+
+	switch len(dmarcEntries) {
+	case 0:
+		d.Dmarc.State = plugin.StateIsSet | plugin.StateIsNull
+		return nil, nil
+	case 1:
+	default:
+		// see https://datatracker.ietf.org/doc/html/rfc7489#section-6.6.3
+		return nil, errors.New("found multiple dmarc records for " + domain)
+	}
+
+	entry := dmarcEntries[0]
+	record, err := dnsshake.NewDmarcRecord(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	o, err := CreateResource(d.MqlRuntime, "dns.dmarcRecord", map[string]*llx.RawData{
+		"dnsTxt":          llx.StringData(entry),
+		"domain":          llx.StringData(domain),
+		"version":         llx.StringData(record.Version),
+		"policy":          llx.StringData(record.Policy),
+		"subdomainPolicy": llx.StringData(record.SubdomainPolicy),
+		"pct":             llx.IntData(record.Percentage),
+		"rua":             llx.ArrayData(llx.TArr2Raw(record.ReportAggregate), types.String),
+		"ruf":             llx.ArrayData(llx.TArr2Raw(record.ReportFailure), types.String),
+		"adkim":           llx.StringData(record.AlignmentDkim),
+		"aspf":            llx.StringData(record.AlignmentSpf),
+		"fo":              llx.StringData(record.FailureOptions),
+		"ri":              llx.IntData(record.ReportInterval),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return o.(*mqlDnsDmarcRecord), nil
+}
+
+func (d *mqlDnsDmarcRecord) id() (string, error) {
+	hasher := sha256.New()
+	hasher.Write([]byte(d.DnsTxt.Data))
+	sha256 := hex.EncodeToString(hasher.Sum(nil))
+	return "dns.dmarc/" + d.Domain.Data + "/" + sha256, nil
+}
+
+func (d *mqlDns) caa(params interface{}) ([]interface{}, error) {
+	paramsM, ok := params.(map[string]interface{})
+	if !ok {
+		return []interface{}{}, nil
+	}
+
+	caaEntries := []interface{}{}
+	r, ok := paramsM["CAA"].(map[string]interface{})
+	if !ok {
+		return caaEntries, nil
+	}
+
+	name, _ := r["name"].(string)
+	class, _ := r["class"].(string)
+	rdata, _ := r["rData"].([]interface{})
+
+	for j := range rdata {
+		entry, _ := rdata[j].(string)
+
+		// use dns package to parse caa entry
+		got, err := dns.NewRR(name + "\t" + class + "\tCAA\t" + entry)
+		if err != nil {
+			return nil, err
+		}
+
+		v, ok := got.(*dns.CAA)
+		if !ok {
+			continue
+		}
+
+		o, err := CreateResource(d.MqlRuntime, "dns.caaRecord", map[string]*llx.RawData{
+			"name":     llx.StringData(name),
+			"flag":     llx.IntData(int64(v.Flag)),
+			"critical": llx.BoolData(v.Flag&128 != 0),
+			"tag":      llx.StringData(v.Tag),
+			"value":    llx.StringData(v.Value),
+		})
+		if err != nil {
+			return nil, err
+		}
+		caaEntries = append(caaEntries, o)
+	}
+
+	return caaEntries, nil
+}
+
+func (d *mqlDnsCaaRecord) id() (string, error) {
+	return "dns.caa/" + d.Name.Data + "/" + strconv.FormatInt(d.Flag.Data, 10) + "/" + d.Tag.Data + "/" + d.Value.Data, nil
+}
+
+func (d *mqlDns) dnssec(fqdn string) (*mqlDnsDnssec, error) {
+	client, err := newDnsClient(d.MqlRuntime, fqdn)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := client.Dnssec()
+	if err != nil {
+		return nil, err
+	}
+
+	o, err := CreateResource(d.MqlRuntime, "dns.dnssec", map[string]*llx.RawData{
+		"fqdn":          llx.StringData(fqdn),
+		"enabled":       llx.BoolData(status.Enabled()),
+		"dsPresent":     llx.BoolData(status.DS),
+		"dnskeyPresent": llx.BoolData(status.DNSKEY),
+		"rrsigPresent":  llx.BoolData(status.RRSIG),
+		"validated":     llx.BoolData(status.Validated),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return o.(*mqlDnsDnssec), nil
+}
+
+func (d *mqlDnsDnssec) id() (string, error) {
+	return "dns.dnssec/" + d.Fqdn.Data, nil
+}
@@ -0,0 +1,201 @@
+package resources
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+)
+
+// testZone answers queries from a fixed set of records. RRSIG records are only
+// returned if the DNSSEC OK bit is set, like real resolvers do.
+type testZone struct {
+	records       []dns.RR
+	authenticated bool
+}
+
+func (z *testZone) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	m := &dns.Msg{}
+	m.SetReply(req)
+	m.AuthenticatedData = z.authenticated
+
+	q := req.Question[0]
+	do := req.IsEdns0() != nil && req.IsEdns0().Do()
+
+	found := false
+	for _, rr := range z.records {
+		if !strings.EqualFold(rr.Header().Name, q.Name) {
+			continue
+		}
+		found = true
+
+		switch v := rr.(type) {
+		case *dns.RRSIG:
+			if do && v.TypeCovered == q.Qtype {
+				m.Answer = append(m.Answer, rr)
+			}
+		default:
+			if rr.Header().Rrtype == q.Qtype {
+				m.Answer = append(m.Answer, rr)
+			}
+		}
+	}
+	if !found {
+		m.Rcode = dns.RcodeNameError
+	}
+
+	w.WriteMsg(m)
+}
+
+func startDnsServer(t *testing.T, zone *testZone) string {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	started := make(chan struct{})
+	srv := &dns.Server{PacketConn: pc, Handler: zone, NotifyStartedFunc: func() { close(started) }}
+	go srv.ActivateAndServe()
+	<-started
+	t.Cleanup(func() { srv.Shutdown() })
+
+	return pc.LocalAddr().String()
+}
+
+func testRRs(t *testing.T, records ...string) []dns.RR {
+	res := make([]dns.RR, len(records))
+	for i := range records {
+		rr, err := dns.NewRR(records[i])
+		require.NoError(t, err)
+		res[i] = rr
+	}
+	return res
+}
+
+const testRRSIG = "20231001000000 20230901000000 12345 example.com. c2lnbmF0dXJl"
+
+func TestResource_DnsMailPolicies(t *testing.T) {
+	server := startDnsServer(t, &testZone{records: testRRs(t,
+		`example.com. 300 IN TXT "v=spf1 mx ip4:192.0.2.0/24 include:_spf.example.net ~all"`,
+		`example.com. 300 IN TXT "google-site-verification=abc"`,
+		`example.com. 300 IN CAA 0 issue "letsencrypt.org"`,
+		`example.com. 300 IN CAA 128 iodef "mailto:security@example.com"`,
+		`_spf.example.net. 300 IN TXT "v=spf1 a include:_spf2.example.net -all"`,
+		`_spf2.example.net. 300 IN TXT "v=spf1 ip4:198.51.100.0/24 -all"`,
+		`_dmarc.example.com. 300 IN TXT "v=DMARC1; p=quarantine; pct=25; rua=mailto:dmarc@example.com"`,
+		`toomany.com. 300 IN TXT "v=spf1 include:loop.toomany.com -all"`,
+		`loop.toomany.com. 300 IN TXT "v=spf1 include:toomany.com -all"`,
+		`nospf.com. 300 IN A 192.0.2.1`,
+	)})
+	runtime := newTestRuntime(&inventory.Config{Options: map[string]string{"dns_server": server}})
+
+	t.Run("spf", func(t *testing.T) {
+		spf := newTestResource[*mqlDns](t, runtime, "dns", map[string]*llx.RawData{"fqdn": llx.StringData("example.com")}).GetSpf()
+		require.NoError(t, spf.Error)
+		require.NotNil(t, spf.Data)
+
+		assert.Equal(t, "spf1", spf.Data.Version.Data)
+		assert.Equal(t, []interface{}{"_spf.example.net"}, spf.Data.Includes.Data)
+
+		mechanisms := spf.Data.Mechanisms.Data
+		require.Len(t, mechanisms, 4)
+		last := mechanisms[3].(*mqlDnsSpfMechanism)
+		assert.Equal(t, "~", last.Qualifier.Data)
+		assert.Equal(t, "all", last.Mechanism.Data)
+		ip4 := mechanisms[1].(*mqlDnsSpfMechanism)
+		assert.Equal(t, "+", ip4.Qualifier.Data)
+		assert.Equal(t, "192.0.2.0", ip4.Value.Data)
+		assert.Equal(t, "24", ip4.Cidr.Data)
+
+		// mx, include, a and the nested include
+		lookups := spf.Data.GetDnsLookups()
+		require.NoError(t, lookups.Error)
+		assert.Equal(t, int64(4), lookups.Data)
+		assert.True(t, spf.Data.GetValid().Data)
+	})
+
+	t.Run("spf include loop", func(t *testing.T) {
+		spf := newTestResource[*mqlDns](t, runtime, "dns", map[string]*llx.RawData{"fqdn": llx.StringData("toomany.com")}).GetSpf()
+		require.NoError(t, spf.Error)
+		assert.Greater(t, spf.Data.GetDnsLookups().Data, int64(10))
+		assert.False(t, spf.Data.GetValid().Data)
+	})
+
+	t.Run("no spf record", func(t *testing.T) {
+		spf := newTestResource[*mqlDns](t, runtime, "dns", map[string]*llx.RawData{"fqdn": llx.StringData("nospf.com")}).GetSpf()
+		require.NoError(t, spf.Error)
+		assert.NotZero(t, spf.State&plugin.StateIsNull)
+	})
+
+	t.Run("dmarc", func(t *testing.T) {
+		dmarc := newTestResource[*mqlDns](t, runtime, "dns", map[string]*llx.RawData{"fqdn": llx.StringData("example.com")}).GetDmarc()
+		require.NoError(t, dmarc.Error)
+		require.NotNil(t, dmarc.Data)
+		assert.Equal(t, "_dmarc.example.com", dmarc.Data.Domain.Data)
+		assert.Equal(t, "quarantine", dmarc.Data.Policy.Data)
+		assert.Equal(t, "quarantine", dmarc.Data.SubdomainPolicy.Data)
+		assert.Equal(t, int64(25), dmarc.Data.Pct.Data)
+		assert.Equal(t, []interface{}{"mailto:dmarc@example.com"}, dmarc.Data.Rua.Data)
+		assert.Empty(t, dmarc.Data.Ruf.Data)
+
+		dmarc = newTestResource[*mqlDns](t, runtime, "dns", map[string]*llx.RawData{"fqdn": llx.StringData("nospf.com")}).GetDmarc()
+		require.NoError(t, dmarc.Error)
+		assert.NotZero(t, dmarc.State&plugin.StateIsNull)
+	})
+
+	t.Run("caa", func(t *testing.T) {
+		caa := newTestResource[*mqlDns](t, runtime, "dns", map[string]*llx.RawData{"fqdn": llx.StringData("example.com")}).GetCaa()
+		require.NoError(t, caa.Error)
+		require.Len(t, caa.Data, 2)
+
+		records := map[string]*mqlDnsCaaRecord{}
+		for i := range caa.Data {
+			r := caa.Data[i].(*mqlDnsCaaRecord)
+			records[r.Tag.Data] = r
+		}
+		assert.Equal(t, "letsencrypt.org", records["issue"].Value.Data)
+		assert.False(t, records["issue"].Critical.Data)
+		assert.Equal(t, "mailto:security@example.com", records["iodef"].Value.Data)
+		assert.Equal(t, int64(128), records["iodef"].Flag.Data)
+		assert.True(t, records["iodef"].Critical.Data)
+	})
+}
+
+func TestResource_DnsDnssec(t *testing.T) {
+	t.Run("signed zone", func(t *testing.T) {
+		server := startDnsServer(t, &testZone{authenticated: true, records: testRRs(t,
+			"example.com. 300 IN A 192.0.2.1",
+			"example.com. 300 IN RRSIG A 13 2 300 "+testRRSIG,
+			"example.com. 300 IN DS 12345 13 2 3A6C7E1B6C4E2F9D0A1B2C3D4E5F60718293A4B5C6D7E8F90A1B2C3D4E5F6071",
+			"example.com. 300 IN RRSIG DS 13 2 300 "+testRRSIG,
+			"example.com. 300 IN DNSKEY 257 3 13 mdsswUyr3DPW132mOi8V9xESWE8jTo0dxCjjnopKl+GqJxpVXckHAeF+KkxLbxILfDLUT0rAK9iUzy1L53eKGQ==",
+			"example.com. 300 IN RRSIG DNSKEY 13 2 300 "+testRRSIG,
+		)})
+
+		dnssec := newTestResource[*mqlDns](t, newTestRuntime(&inventory.Config{Options: map[string]string{"dns_server": server}}), "dns", map[string]*llx.RawData{"fqdn": llx.StringData("example.com")}).GetDnssec()
+		require.NoError(t, dnssec.Error)
+		assert.True(t, dnssec.Data.Enabled.Data)
+		assert.True(t, dnssec.Data.DsPresent.Data)
+		assert.True(t, dnssec.Data.DnskeyPresent.Data)
+		assert.True(t, dnssec.Data.RrsigPresent.Data)
+		assert.True(t, dnssec.Data.Validated.Data)
+	})
+
+	t.Run("unsigned zone", func(t *testing.T) {
+		server := startDnsServer(t, &testZone{records: testRRs(t,
+			"example.com. 300 IN A 192.0.2.1",
+		)})
+
+		dnssec := newTestResource[*mqlDns](t, newTestRuntime(&inventory.Config{Options: map[string]string{"dns_server": server}}), "dns", map[string]*llx.RawData{"fqdn": llx.StringData("example.com")}).GetDnssec()
+		require.NoError(t, dnssec.Error)
+		assert.False(t, dnssec.Data.Enabled.Data)
+		assert.False(t, dnssec.Data.DsPresent.Data)
+		assert.False(t, dnssec.Data.DnskeyPresent.Data)
+		assert.False(t, dnssec.Data.RrsigPresent.Data)
+		assert.False(t, dnssec.Data.Validated.Data)
+	})
+}
@@ -4,8 +4,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"go.mondoo.com/cnquery/providers-sdk/v1/testutils"
 )
 
+var x = testutils.InitTester(testutils.LinuxMock("../../../providers-sdk/v1/testutils"))
+
 func TestResource_DNS(t *testing.T) {
 	res := x.TestQuery(t, "dns(\"mondoo.com\").mx")
 	assert.NotEmpty(t, res)
@@ -0,0 +1,132 @@
+package dnsshake
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Domain-based Message Authentication, Reporting, and Conformance (DMARC)
+// https://datatracker.ietf.org/doc/html/rfc7489#section-6.3
+type DmarcRecord struct {
+	Version string
+	// Requested mail receiver policy: none, quarantine or reject
+	Policy string
+	// Requested mail receiver policy for all subdomains
+	SubdomainPolicy string
+	// Percentage of messages to which the policy is applied
+	Percentage int64
+	// Addresses for aggregate feedback
+	ReportAggregate []string
+	// Addresses for failure reports
+	ReportFailure []string
+	// DKIM identifier alignment mode: r (relaxed) or s (strict)
+	AlignmentDkim string
+	// SPF identifier alignment mode: r (relaxed) or s (strict)
+	AlignmentSpf string
+	// Failure reporting options
+	FailureOptions string
+	// Interval between aggregate reports in seconds
+	ReportInterval int64
+}
+
+// DmarcDomain returns the name at which the DMARC policy for the domain is
+// published
+func DmarcDomain(fqdn string) string {
+	return "_dmarc." + strings.TrimSuffix(fqdn, ".")
+}
+
+// NewDmarcRecord parses the TXT representation of a DMARC record and
+// applies the defaults defined in RFC 7489
+func NewDmarcRecord(txt string) (*DmarcRecord, error) {
+	res := &DmarcRecord{
+		Percentage:      100,
+		ReportAggregate: []string{},
+		ReportFailure:   []string{},
+		AlignmentDkim:   "r",
+		AlignmentSpf:    "r",
+		FailureOptions:  "0",
+		ReportInterval:  86400,
+	}
+
+	tags := strings.Split(txt, ";")
+	for i := range tags {
+		tag := strings.TrimSpace(tags[i])
+		if tag == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(tag, "=")
+		if !ok {
+			return nil, errors.New("invalid dmarc tag: " + tag)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+
+		// the version must be the first tag
+		if i == 0 && key != "v" {
+			return nil, errors.New("dmarc record must start with the version tag")
+		}
+
+		switch key {
+		case "v":
+			res.Version = value
+		case "p":
+			res.Policy = strings.ToLower(value)
+		case "sp":
+			res.SubdomainPolicy = strings.ToLower(value)
+		case "pct":
+			pct, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || pct < 0 || pct > 100 {
+				return nil, errors.New("invalid dmarc pct value: " + value)
+			}
+			res.Percentage = pct
+		case "rua":
+			res.ReportAggregate = dmarcURIs(value)
+		case "ruf":
+			res.ReportFailure = dmarcURIs(value)
+		case "adkim":
+			res.AlignmentDkim = strings.ToLower(value)
+		case "aspf":
+			res.AlignmentSpf = strings.ToLower(value)
+		case "fo":
+			res.FailureOptions = value
+		case "ri":
+			ri, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, errors.New("invalid dmarc ri value: " + value)
+			}
+			res.ReportInterval = ri
+		}
+	}
+
+	if res.Version != "DMARC1" {
+		return nil, errors.New("unsupported dmarc version: " + res.Version)
+	}
+
+	switch res.Policy {
+	case "none", "quarantine", "reject":
+	default:
+		return nil, errors.New("invalid dmarc policy: " + res.Policy)
+	}
+
+	// the subdomain policy defaults to the domain policy
+	if res.SubdomainPolicy == "" {
+		res.SubdomainPolicy = res.Policy
+	}
+
+	return res, nil
+}
+
+func dmarcURIs(value string) []string {
+	res := []string{}
+	uris := strings.Split(value, ",")
+	for i := range uris {
+		uri := strings.TrimSpace(uris[i])
+		if uri != "" {
+			res = append(res, uri)
+		}
+	}
+	return res
+}
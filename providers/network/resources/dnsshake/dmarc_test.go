@@ -0,0 +1,61 @@
+package dnsshake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDmarcRecord(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		record, err := NewDmarcRecord("v=DMARC1; p=none")
+		require.NoError(t, err)
+		assert.Equal(t, &DmarcRecord{
+			Version:         "DMARC1",
+			Policy:          "none",
+			SubdomainPolicy: "none",
+			Percentage:      100,
+			ReportAggregate: []string{},
+			ReportFailure:   []string{},
+			AlignmentDkim:   "r",
+			AlignmentSpf:    "r",
+			FailureOptions:  "0",
+			ReportInterval:  86400,
+		}, record)
+	})
+
+	t.Run("all tags", func(t *testing.T) {
+		record, err := NewDmarcRecord("v=DMARC1; p=Reject; sp=quarantine; pct=50; rua=mailto:dmarc@example.com, mailto:reports@example.net; ruf=mailto:forensic@example.com; adkim=s; aspf=s; fo=1; ri=3600;")
+		require.NoError(t, err)
+		assert.Equal(t, &DmarcRecord{
+			Version:         "DMARC1",
+			Policy:          "reject",
+			SubdomainPolicy: "quarantine",
+			Percentage:      50,
+			ReportAggregate: []string{"mailto:dmarc@example.com", "mailto:reports@example.net"},
+			ReportFailure:   []string{"mailto:forensic@example.com"},
+			AlignmentDkim:   "s",
+			AlignmentSpf:    "s",
+			FailureOptions:  "1",
+			ReportInterval:  3600,
+		}, record)
+	})
+
+	t.Run("invalid records", func(t *testing.T) {
+		for _, txt := range []string{
+			"",
+			"p=none; v=DMARC1",
+			"v=DMARC2; p=none",
+			"v=DMARC1",
+			"v=DMARC1; p=block",
+			"v=DMARC1; p=none; pct=150",
+			"v=DMARC1; p=none; rua",
+		} {
+			_, err := NewDmarcRecord(txt)
+			assert.Error(t, err, txt)
+		}
+	})
+
+	assert.Equal(t, "_dmarc.example.com", DmarcDomain("example.com."))
+}
@@ -0,0 +1,67 @@
+package dnsshake
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/miekg/dns"
+)
+
+// DnssecStatus describes which DNSSEC records are published for a name
+type DnssecStatus struct {
+	// DS records are published in the parent zone
+	DS bool
+	// DNSKEY records are published in the zone
+	DNSKEY bool
+	// answers are signed with RRSIG records
+	RRSIG bool
+	// the resolver validated the answers and set the Authenticated Data flag
+	Validated bool
+}
+
+// Enabled returns true if the zone is signed and the chain of trust is
+// established by a DS record in the parent zone
+func (s *DnssecStatus) Enabled() bool {
+	return s.DS && s.RRSIG
+}
+
+// Dnssec queries the DS, DNSKEY and A records with the DNSSEC OK bit set to
+// determine if the name is signed
+func (d *DnsClient) Dnssec() (*DnssecStatus, error) {
+	res := &DnssecStatus{}
+	validated := true
+	answered := false
+
+	for _, dnsType := range []uint16{dns.TypeDS, dns.TypeDNSKEY, dns.TypeA} {
+		r, err := d.exchange(d.fqdn, dnsType, true)
+		if err != nil {
+			return nil, err
+		}
+
+		switch r.Rcode {
+		case dns.RcodeSuccess:
+		case dns.RcodeNameError:
+			continue
+		default:
+			return nil, errors.New("dns query for " + d.fqdn + " failed: " + dns.RcodeToString[r.Rcode])
+		}
+
+		if len(r.Answer) == 0 {
+			continue
+		}
+		answered = true
+		validated = validated && r.AuthenticatedData
+
+		for i := range r.Answer {
+			switch r.Answer[i].(type) {
+			case *dns.DS:
+				res.DS = true
+			case *dns.DNSKEY:
+				res.DNSKEY = true
+			case *dns.RRSIG:
+				res.RRSIG = true
+			}
+		}
+	}
+
+	res.Validated = answered && validated
+	return res, nil
+}
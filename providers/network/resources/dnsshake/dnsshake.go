@@ -62,6 +62,31 @@ func New(fqdn string) (*DnsClient, error) {
 	}, nil
 }
 
+// NewWithServer creates a client that sends all queries to the given
+// server, e.g. "10.0.0.2" or "127.0.0.1:8053"
+func NewWithServer(fqdn string, server string) (*DnsClient, error) {
+	host, port, err := net.SplitHostPort(server)
+	if err != nil {
+		// no port was provided
+		host, port = server, "53"
+	}
+	if host == "" {
+		return nil, errors.New("invalid dns server: " + server)
+	}
+
+	return &DnsClient{
+		fqdn: fqdn,
+		config: &dns.ClientConfig{
+			Servers:  []string{host},
+			Search:   []string{},
+			Port:     port,
+			Ndots:    1,
+			Timeout:  5,
+			Attempts: 2,
+		},
+	}, nil
+}
+
 // stringToType is a map of strings to each RR type.
 var stringToType = map[string]uint16{
 	"A":          dns.TypeA,
@@ -197,13 +222,7 @@ func (d *DnsClient) queryDnsType(fqdn string, t string) (map[string]DnsRecord, e
 
 	res := map[string]DnsRecord{}
 
-	c := &dns.Client{}
-	m := &dns.Msg{}
-	m.SetEdns0(4096, false)
-	m.SetQuestion(dns.Fqdn(fqdn), dnsType)
-	m.RecursionDesired = true
-
-	r, _, err := c.Exchange(m, net.JoinHostPort(d.config.Servers[0], d.config.Port))
+	r, err := d.exchange(fqdn, dnsType, false)
 	if err != nil {
 		res[dnsTypText] = DnsRecord{
 			Type:  dnsTypText,
@@ -289,3 +308,39 @@ func (d *DnsClient) queryDnsType(fqdn string, t string) (map[string]DnsRecord, e
 	}
 	return res, nil
 }
+
+// exchange sends a single query to the configured dns server. If dnssec is set,
+// the DNSSEC OK bit is set to receive the RRSIG records for the answers.
+func (d *DnsClient) exchange(fqdn string, dnsType uint16, dnssec bool) (*dns.Msg, error) {
+	c := &dns.Client{}
+	m := &dns.Msg{}
+	m.SetEdns0(4096, dnssec)
+	m.SetQuestion(dns.Fqdn(fqdn), dnsType)
+	m.RecursionDesired = true
+
+	r, _, err := c.Exchange(m, net.JoinHostPort(d.config.Servers[0], d.config.Port))
+	return r, err
+}
+
+// TxtRecords returns all TXT records for the given name. Strings of a single
+// record are joined. A non-existing name returns no records.
+func (d *DnsClient) TxtRecords(fqdn string) ([]string, error) {
+	r, err := d.exchange(fqdn, dns.TypeTXT, false)
+	if err != nil {
+		return nil, err
+	}
+
+	switch r.Rcode {
+	case dns.RcodeSuccess, dns.RcodeNameError:
+	default:
+		return nil, errors.New("dns query for " + fqdn + " failed: " + dns.RcodeToString[r.Rcode])
+	}
+
+	res := []string{}
+	for i := range r.Answer {
+		if txt, ok := r.Answer[i].(*dns.TXT); ok {
+			res = append(res, strings.Join(txt.Txt, ""))
+		}
+	}
+	return res, nil
+}
@@ -3,6 +3,8 @@ package dnsshake
 import (
 	"strings"
 
+	"github.com/cockroachdb/errors"
+
 	"github.com/alecthomas/participle/v2"
 	"github.com/alecthomas/participle/v2/lexer"
 )
@@ -24,7 +26,7 @@ var spfLexer = lexer.MustSimple([]lexer.SimpleRule{
 	{`Equal`, `[=]`},
 	{`Mechanism`, `\b(all|include|a|mx|ptr|ip4|ip6|exists)\b`},
 	{`Modifier`, `\b(redirect|exp)\b`},
-	{`String`, `[^+\-:\s=\/][\w.%\-+{}]+`},
+	{`String`, `[^+\-~?:\s=\/][\w.%\-+{}]+`},
 	{`Qualifier`, `[\+\-~?]`},
 	{`Number`, `\d+`},
 })
@@ -65,3 +67,104 @@ func (s *spf) Parse(txt string) (*SpfRecord, error) {
 
 	return spfParser.Parse("", strings.NewReader(strings.Join(lines, "\n")))
 }
+
+// SpfLookupLimit is the maximum number of DNS lookups during the evaluation of
+// an SPF record, see https://datatracker.ietf.org/doc/html/rfc7208#section-4.6.4
+const SpfLookupLimit = 10
+
+// IsSpf returns true if the TXT record is an SPF record
+func IsSpf(txt string) bool {
+	txt = strings.TrimSpace(txt)
+	return txt == "v=spf1" || strings.HasPrefix(txt, "v=spf1 ")
+}
+
+// DnsLookups returns the number of terms which require a DNS lookup. Lookups
+// required for included records are not part of the count.
+func (r *SpfRecord) DnsLookups() int {
+	count := 0
+	for i := range r.Directives {
+		switch r.Directives[i].Mechanism {
+		case "include", "a", "mx", "ptr", "exists":
+			count++
+		}
+	}
+	for i := range r.Modifiers {
+		if r.Modifiers[i].Modifier == "redirect" {
+			count++
+		}
+	}
+	return count
+}
+
+// Includes returns the domains referenced by include mechanisms and the
+// redirect modifier
+func (r *SpfRecord) Includes() []string {
+	res := []string{}
+	for i := range r.Directives {
+		if r.Directives[i].Mechanism == "include" {
+			res = append(res, r.Directives[i].Value)
+		}
+	}
+	for i := range r.Modifiers {
+		if r.Modifiers[i].Modifier == "redirect" {
+			res = append(res, r.Modifiers[i].Value)
+		}
+	}
+	return res
+}
+
+// SpfDnsLookups returns the number of DNS lookups required to evaluate the
+// record, including the lookups of all included records. The count stops once
+// it exceeds the SpfLookupLimit.
+func (d *DnsClient) SpfDnsLookups(record *SpfRecord) (int, error) {
+	return d.spfDnsLookups(record, map[string]struct{}{})
+}
+
+func (d *DnsClient) spfDnsLookups(record *SpfRecord, path map[string]struct{}) (int, error) {
+	count := record.DnsLookups()
+	includes := record.Includes()
+	for i := range includes {
+		if count > SpfLookupLimit {
+			break
+		}
+
+		include := strings.ToLower(strings.TrimSuffix(includes[i], "."))
+		// macros are only expanded during the evaluation for a sender
+		if strings.Contains(include, "%{") {
+			continue
+		}
+		// an include loop never terminates and exceeds any limit
+		if _, ok := path[include]; ok {
+			return SpfLookupLimit + 1, nil
+		}
+
+		txts, err := d.TxtRecords(include)
+		if err != nil {
+			return 0, err
+		}
+
+		var nested *SpfRecord
+		for j := range txts {
+			if !IsSpf(txts[j]) {
+				continue
+			}
+			nested, err = NewSpf().Parse(strings.TrimSpace(txts[j]))
+			if err != nil {
+				return 0, errors.Wrap(err, "could not parse spf record of "+include)
+			}
+			break
+		}
+		if nested == nil {
+			continue
+		}
+
+		path[include] = struct{}{}
+		n, err := d.spfDnsLookups(nested, path)
+		delete(path, include)
+		if err != nil {
+			return 0, err
+		}
+		count += n
+	}
+	return count, nil
+}
@@ -207,6 +207,23 @@ func TestRfcRecords(t *testing.T) {
 				},
 			},
 		},
+		{
+			Title:        "softfail and neutral qualifiers",
+			DnsTxtRecord: "v=spf1 ?mx ~all",
+			Expected: &SpfRecord{
+				Version: "spf1",
+				Directives: []Directive{
+					{
+						Qualifier: "?",
+						Mechanism: "mx",
+					},
+					{
+						Qualifier: "~",
+						Mechanism: "all",
+					},
+				},
+			},
+		},
 		{
 			Title:        "Exists",
 			DnsTxtRecord: "v=spf1 exists:%{ir}.%{l1r+-}._spf.%{d} -all",
@@ -260,3 +277,24 @@ func TestRfcRecords(t *testing.T) {
 		})
 	}
 }
+
+func TestSpfDnsLookups(t *testing.T) {
+	spf := NewSpf()
+	record, err := spf.Parse("v=spf1 a mx include:_spf.example.com ip4:192.0.2.0/24 exists:%{i}._spf.%{d} redirect=_spf.example.net")
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, record.DnsLookups())
+	assert.Equal(t, []string{"_spf.example.com", "_spf.example.net"}, record.Includes())
+
+	record, err = spf.Parse("v=spf1 ip4:192.0.2.0/24 -all")
+	require.NoError(t, err)
+	assert.Equal(t, 0, record.DnsLookups())
+	assert.Empty(t, record.Includes())
+}
+
+func TestIsSpf(t *testing.T) {
+	assert.True(t, IsSpf("v=spf1"))
+	assert.True(t, IsSpf("v=spf1 -all"))
+	assert.False(t, IsSpf("v=spf10 -all"))
+	assert.False(t, IsSpf("v=DKIM1; p="))
+}
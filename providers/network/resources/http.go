@@ -0,0 +1,370 @@
+package resources
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/providers-sdk/v1/vault"
+	"go.mondoo.com/cnquery/providers/network/connection"
+	"go.mondoo.com/cnquery/types"
+)
+
+const (
+	httpDefaultTimeout = 30 * time.Second
+	httpMaxRedirects   = 10
+)
+
+type mqlHttpGetInternal struct {
+	lock          sync.Mutex
+	executed      bool
+	response      *http.Response
+	respBody      []byte
+	redirectChain []string
+	err           error
+}
+
+func initHttpGet(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	raw, ok := args["url"]
+	if !ok {
+		return nil, nil, errors.New("http.get requires a url")
+	}
+	rawURL, ok := raw.Value.(string)
+	if !ok {
+		return nil, nil, errors.New("http.get url must be a string")
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, nil, errors.New("invalid url for http.get: " + err.Error())
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, nil, errors.New("http.get only supports http and https urls")
+	}
+
+	if _, ok := args["method"]; !ok {
+		args["method"] = llx.StringData(http.MethodGet)
+	}
+	if _, ok := args["requestHeaders"]; !ok {
+		args["requestHeaders"] = llx.MapData(map[string]interface{}{}, "string")
+	}
+	if _, ok := args["requestBody"]; !ok {
+		args["requestBody"] = llx.StringData("")
+	}
+
+	return args, nil, nil
+}
+
+func (h *mqlHttpGet) id() (string, error) {
+	id := strings.ToUpper(h.Method.Data) + " " + h.Url.Data
+	if len(h.RequestHeaders.Data) == 0 && h.RequestBody.Data == "" {
+		return id, nil
+	}
+
+	// requests with the same url may differ in their headers and body
+	keys := make([]string, 0, len(h.RequestHeaders.Data))
+	for k := range h.RequestHeaders.Data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	hash := sha256.New()
+	for _, k := range keys {
+		v, _ := h.RequestHeaders.Data[k].(string)
+		hash.Write([]byte(k + ":" + v + "\n"))
+	}
+	hash.Write([]byte(h.RequestBody.Data))
+	return id + " " + hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// do sends the request once and caches the response for all fields
+func (h *mqlHttpGet) do() error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if h.executed {
+		return h.err
+	}
+	h.executed = true
+	h.err = h.send()
+	return h.err
+}
+
+func (h *mqlHttpGet) send() error {
+	conn := h.MqlRuntime.Connection.(*connection.HostConnection)
+
+	var body io.Reader
+	if h.RequestBody.Data != "" {
+		body = strings.NewReader(h.RequestBody.Data)
+	}
+
+	req, err := http.NewRequest(strings.ToUpper(h.Method.Data), h.Url.Data, body)
+	if err != nil {
+		return err
+	}
+
+	for k, v := range h.RequestHeaders.Data {
+		s, _ := v.(string)
+		req.Header.Set(k, s)
+	}
+
+	if req.Header.Get("Authorization") == "" {
+		setHttpAuth(req, conn)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	insecure := conn.Conf != nil && conn.Conf.Insecure
+	transport.TLSClientConfig = &tls.Config{
+		InsecureSkipVerify: insecure,
+	}
+
+	client := &http.Client{
+		Transport: transport,
+		Timeout:   httpDefaultTimeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= httpMaxRedirects {
+				return errors.New("stopped after " + strconv.Itoa(httpMaxRedirects) + " redirects")
+			}
+			h.redirectChain = append(h.redirectChain, req.URL.String())
+			return nil
+		},
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	h.response = resp
+	h.respBody = data
+	return nil
+}
+
+// setHttpAuth adds basic or bearer authentication from the connection's
+// credentials. Credentials are only sent to the host the connection is for,
+// to ensure they are not leaked to other endpoints.
+func setHttpAuth(req *http.Request, conn *connection.HostConnection) {
+	if conn.Conf == nil || !strings.EqualFold(req.URL.Hostname(), conn.Conf.Host) {
+		return
+	}
+
+	for _, cred := range conn.Conf.Credentials {
+		switch cred.Type {
+		case vault.CredentialType_password:
+			req.SetBasicAuth(cred.User, string(cred.Secret))
+			return
+		case vault.CredentialType_bearer:
+			req.Header.Set("Authorization", "Bearer "+string(cred.Secret))
+			return
+		}
+	}
+}
+
+func (h *mqlHttpGet) statusCode() (int64, error) {
+	if err := h.do(); err != nil {
+		return 0, err
+	}
+	return int64(h.response.StatusCode), nil
+}
+
+func (h *mqlHttpGet) version() (string, error) {
+	if err := h.do(); err != nil {
+		return "", err
+	}
+	return h.response.Proto, nil
+}
+
+func (h *mqlHttpGet) headers() (map[string]interface{}, error) {
+	if err := h.do(); err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]interface{}, len(h.response.Header))
+	for k, v := range h.response.Header {
+		res[http.CanonicalHeaderKey(k)] = joinHeaderValues(v)
+	}
+	return res, nil
+}
+
+// joinHeaderValues combines the values of a header that was sent
+// multiple times
+func joinHeaderValues(values []string) string {
+	return strings.Join(values, ", ")
+}
+
+func (h *mqlHttpGet) body() (string, error) {
+	if err := h.do(); err != nil {
+		return "", err
+	}
+	return string(h.respBody), nil
+}
+
+func (h *mqlHttpGet) json() (interface{}, error) {
+	if err := h.do(); err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	decoder := json.NewDecoder(bytes.NewReader(h.respBody))
+	decoder.UseNumber()
+	if err := decoder.Decode(&res); err != nil {
+		return nil, errors.New("failed to parse response body as json: " + err.Error())
+	}
+	return jsonNumbersToDict(res), nil
+}
+
+// jsonNumbersToDict converts json numbers into the int64 and float64 values
+// supported by dicts
+func jsonNumbersToDict(v interface{}) interface{} {
+	switch x := v.(type) {
+	case json.Number:
+		if i, err := x.Int64(); err == nil {
+			return i
+		}
+		f, _ := x.Float64()
+		return f
+	case map[string]interface{}:
+		for k := range x {
+			x[k] = jsonNumbersToDict(x[k])
+		}
+		return x
+	case []interface{}:
+		for i := range x {
+			x[i] = jsonNumbersToDict(x[i])
+		}
+		return x
+	default:
+		return v
+	}
+}
+
+func (h *mqlHttpGet) redirects() ([]interface{}, error) {
+	if err := h.do(); err != nil {
+		return nil, err
+	}
+	return llx.TArr2Raw(h.redirectChain), nil
+}
+
+func (h *mqlHttpGet) tls() (*mqlTls, error) {
+	if err := h.do(); err != nil {
+		return nil, err
+	}
+
+	u := h.response.Request.URL
+	if u.Scheme != "https" {
+		h.Tls.State = plugin.StateIsSet | plugin.StateIsNull
+		return nil, nil
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = "443"
+	}
+
+	o, err := NewResource(h.MqlRuntime, "tls", map[string]*llx.RawData{
+		"target": llx.StringData(net.JoinHostPort(u.Hostname(), port)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return o.(*mqlTls), nil
+}
+
+func initHttpGetHeader(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	raw, ok := args["name"]
+	if !ok {
+		return nil, nil, errors.New("http.get.header requires a name")
+	}
+	name, ok := raw.Value.(string)
+	if !ok || name == "" {
+		return nil, nil, errors.New("http.get.header name must be a non-empty string")
+	}
+	args["name"] = llx.StringData(http.CanonicalHeaderKey(name))
+
+	// the request is checked and completed with its defaults like http.get
+	reqArgs := make(map[string]*llx.RawData, 4)
+	for _, k := range []string{"url", "method", "requestHeaders", "requestBody"} {
+		if v, ok := args[k]; ok {
+			reqArgs[k] = v
+		}
+	}
+	reqArgs, _, err := initHttpGet(runtime, reqArgs)
+	if err != nil {
+		return nil, nil, err
+	}
+	for k, v := range reqArgs {
+		args[k] = v
+	}
+
+	return args, nil, nil
+}
+
+func (h *mqlHttpGetHeader) id() (string, error) {
+	req, err := h.request()
+	if err != nil {
+		return "", err
+	}
+	return req.MqlID() + " " + h.Name.Data, nil
+}
+
+// request returns the http.get resource of the request the header is from,
+// so that its response is shared with all of its fields
+func (h *mqlHttpGetHeader) request() (*mqlHttpGet, error) {
+	o, err := NewResource(h.MqlRuntime, "http.get", map[string]*llx.RawData{
+		"url":            llx.StringData(h.Url.Data),
+		"method":         llx.StringData(h.Method.Data),
+		"requestHeaders": llx.MapData(h.RequestHeaders.Data, types.String),
+		"requestBody":    llx.StringData(h.RequestBody.Data),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return o.(*mqlHttpGet), nil
+}
+
+func (h *mqlHttpGetHeader) values() ([]string, error) {
+	req, err := h.request()
+	if err != nil {
+		return nil, err
+	}
+	if err := req.do(); err != nil {
+		return nil, err
+	}
+	return req.response.Header.Values(h.Name.Data), nil
+}
+
+func (h *mqlHttpGetHeader) exists() (bool, error) {
+	values, err := h.values()
+	if err != nil {
+		return false, err
+	}
+	return len(values) != 0, nil
+}
+
+func (h *mqlHttpGetHeader) value() (string, error) {
+	values, err := h.values()
+	if err != nil {
+		return "", err
+	}
+	return joinHeaderValues(values), nil
+}
@@ -0,0 +1,213 @@
+package resources
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/providers-sdk/v1/vault"
+)
+
+func TestResource_HttpGet(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Add("X-Instance", "a")
+		w.Header().Add("X-Instance", "b")
+		w.Write([]byte(`{"status":"ok","uptime":42,"load":0.5,"checks":[{"name":"db"}]}`))
+	})
+	mux.HandleFunc("/old", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/moved", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/moved", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/health", http.StatusFound)
+	})
+	mux.HandleFunc("/echo", func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		w.Header().Set("X-Method", r.Method)
+		w.Header().Set("X-Token", r.Header.Get("X-Token"))
+		w.WriteHeader(http.StatusCreated)
+		w.Write(data)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	runtime := newTestRuntime(&inventory.Config{Host: "localhost"})
+
+	t.Run("get", func(t *testing.T) {
+		res := newTestResource[*mqlHttpGet](t, runtime, "http.get", map[string]*llx.RawData{
+			"url": llx.StringData(srv.URL + "/health"),
+		})
+
+		statusCode := res.GetStatusCode()
+		require.NoError(t, statusCode.Error)
+		assert.Equal(t, int64(200), statusCode.Data)
+		assert.Equal(t, "GET", res.Method.Data)
+		assert.Equal(t, "HTTP/1.1", res.GetVersion().Data)
+
+		headers := res.GetHeaders()
+		require.NoError(t, headers.Error)
+		assert.Equal(t, "application/json", headers.Data["Content-Type"])
+		assert.Equal(t, "a, b", headers.Data["X-Instance"])
+
+		body := res.GetJson()
+		require.NoError(t, body.Error)
+		assert.Equal(t, map[string]interface{}{
+			"status": "ok",
+			"uptime": int64(42),
+			"load":   0.5,
+			"checks": []interface{}{map[string]interface{}{"name": "db"}},
+		}, body.Data)
+
+		assert.Empty(t, res.GetRedirects().Data)
+
+		// plain http has no tls connection
+		tls := res.GetTls()
+		require.NoError(t, tls.Error)
+		assert.NotZero(t, tls.State&plugin.StateIsNull)
+	})
+
+	t.Run("redirects", func(t *testing.T) {
+		res := newTestResource[*mqlHttpGet](t, runtime, "http.get", map[string]*llx.RawData{
+			"url": llx.StringData(srv.URL + "/old"),
+		})
+
+		assert.Equal(t, int64(200), res.GetStatusCode().Data)
+		assert.Equal(t, []interface{}{srv.URL + "/moved", srv.URL + "/health"}, res.GetRedirects().Data)
+	})
+
+	t.Run("method, headers and body", func(t *testing.T) {
+		res := newTestResource[*mqlHttpGet](t, runtime, "http.get", map[string]*llx.RawData{
+			"url":            llx.StringData(srv.URL + "/echo"),
+			"method":         llx.StringData("post"),
+			"requestHeaders": llx.MapData(map[string]interface{}{"X-Token": "secret"}, "string"),
+			"requestBody":    llx.StringData("hello"),
+		})
+
+		assert.Equal(t, int64(201), res.GetStatusCode().Data)
+		assert.Equal(t, "POST", res.GetHeaders().Data["X-Method"])
+		assert.Equal(t, "secret", res.GetHeaders().Data["X-Token"])
+		assert.Equal(t, "hello", res.GetBody().Data)
+
+		// requests with different bodies must not share the same resource
+		other := newTestResource[*mqlHttpGet](t, runtime, "http.get", map[string]*llx.RawData{
+			"url":         llx.StringData(srv.URL + "/echo"),
+			"method":      llx.StringData("post"),
+			"requestBody": llx.StringData("world"),
+		})
+		assert.NotEqual(t, res.MqlID(), other.MqlID())
+		assert.Equal(t, "world", other.GetBody().Data)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		res := newTestResource[*mqlHttpGet](t, runtime, "http.get", map[string]*llx.RawData{
+			"url":         llx.StringData(srv.URL + "/echo"),
+			"requestBody": llx.StringData("not json"),
+		})
+		assert.Error(t, res.GetJson().Error)
+	})
+
+	t.Run("header", func(t *testing.T) {
+		header := func(name string) *mqlHttpGetHeader {
+			return newTestResource[*mqlHttpGetHeader](t, runtime, "http.get.header", map[string]*llx.RawData{
+				"url":  llx.StringData(srv.URL + "/health"),
+				"name": llx.StringData(name),
+			})
+		}
+
+		contentType := header("content-type")
+		assert.Equal(t, "Content-Type", contentType.Name.Data)
+		assert.Equal(t, "GET", contentType.Method.Data)
+		assert.True(t, contentType.GetExists().Data)
+		assert.Equal(t, "application/json", contentType.GetValue().Data)
+
+		// multiple values are joined like in headers
+		res := newTestResource[*mqlHttpGet](t, runtime, "http.get", map[string]*llx.RawData{"url": llx.StringData(srv.URL + "/health")})
+		instance := header("X-INSTANCE")
+		assert.Equal(t, res.GetHeaders().Data["X-Instance"], instance.GetValue().Data)
+		assert.Equal(t, "a, b", instance.GetValue().Data)
+
+		missing := header("x-missing")
+		require.NoError(t, missing.GetValue().Error)
+		assert.False(t, missing.GetExists().Data)
+		assert.Equal(t, "", missing.GetValue().Data)
+
+		_, err := NewResource(runtime, "http.get.header", map[string]*llx.RawData{
+			"url": llx.StringData(srv.URL + "/health"),
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("unsupported scheme", func(t *testing.T) {
+		_, err := NewResource(runtime, "http.get", map[string]*llx.RawData{
+			"url": llx.StringData("ftp://example.com"),
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestResource_HttpGetAuth(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.Header.Get("Authorization")))
+	}))
+	defer srv.Close()
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	t.Run("basic auth", func(t *testing.T) {
+		runtime := newTestRuntime(&inventory.Config{
+			Host:        u.Hostname(),
+			Credentials: []*vault.Credential{vault.NewPasswordCredential("admin", "s3cr3t")},
+		})
+		res := newTestResource[*mqlHttpGet](t, runtime, "http.get", map[string]*llx.RawData{"url": llx.StringData(srv.URL)})
+		assert.Equal(t, "Basic YWRtaW46czNjcjN0", res.GetBody().Data)
+	})
+
+	t.Run("bearer auth", func(t *testing.T) {
+		runtime := newTestRuntime(&inventory.Config{
+			Host: u.Hostname(),
+			Credentials: []*vault.Credential{{
+				Type:   vault.CredentialType_bearer,
+				Secret: []byte("token"),
+			}},
+		})
+		res := newTestResource[*mqlHttpGet](t, runtime, "http.get", map[string]*llx.RawData{"url": llx.StringData(srv.URL)})
+		assert.Equal(t, "Bearer token", res.GetBody().Data)
+	})
+
+	t.Run("credentials are not sent to other hosts", func(t *testing.T) {
+		runtime := newTestRuntime(&inventory.Config{
+			Host:        "api.example.com",
+			Credentials: []*vault.Credential{vault.NewPasswordCredential("admin", "s3cr3t")},
+		})
+		res := newTestResource[*mqlHttpGet](t, runtime, "http.get", map[string]*llx.RawData{"url": llx.StringData(srv.URL)})
+		assert.Equal(t, "", res.GetBody().Data)
+	})
+}
+
+func TestResource_HttpGetInsecure(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	res := newTestResource[*mqlHttpGet](t, newTestRuntime(&inventory.Config{}), "http.get", map[string]*llx.RawData{"url": llx.StringData(srv.URL)})
+	assert.Error(t, res.GetStatusCode().Error, "self-signed certificates are rejected")
+
+	res = newTestResource[*mqlHttpGet](t, newTestRuntime(&inventory.Config{Insecure: true}), "http.get", map[string]*llx.RawData{"url": llx.StringData(srv.URL)})
+	require.NoError(t, res.GetStatusCode().Error)
+	assert.Equal(t, "ok", res.GetBody().Data)
+
+	tls := res.GetTls()
+	require.NoError(t, tls.Error)
+	require.NotNil(t, tls.Data)
+	assert.Equal(t, "127.0.0.1", tls.Data.GetSocket().Data.Address.Data)
+}
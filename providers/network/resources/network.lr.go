@@ -22,6 +22,18 @@ func init() {
 			Init: initTls,
 			Create: createTls,
 		},
+		"ssh": {
+			Init: initSsh,
+			Create: createSsh,
+		},
+		"ssh.hostKey": {
+			// to override args, implement: initSshHostKey(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createSshHostKey,
+		},
+		"banner": {
+			Init: initBanner,
+			Create: createBanner,
+		},
 		"certificates": {
 			// to override args, implement: initCertificates(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createCertificates,
@@ -78,6 +90,34 @@ func init() {
 			// to override args, implement: initDnsDkimRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createDnsDkimRecord,
 		},
+		"dns.spfRecord": {
+			// to override args, implement: initDnsSpfRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createDnsSpfRecord,
+		},
+		"dns.spfMechanism": {
+			// to override args, implement: initDnsSpfMechanism(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createDnsSpfMechanism,
+		},
+		"dns.dmarcRecord": {
+			// to override args, implement: initDnsDmarcRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createDnsDmarcRecord,
+		},
+		"dns.caaRecord": {
+			// to override args, implement: initDnsCaaRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createDnsCaaRecord,
+		},
+		"dns.dnssec": {
+			// to override args, implement: initDnsDnssec(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createDnsDnssec,
+		},
+		"http.get": {
+			Init: initHttpGet,
+			Create: createHttpGet,
+		},
+		"http.get.header": {
+			Init: initHttpGetHeader,
+			Create: createHttpGetHeader,
+		},
 	}
 }
 
@@ -179,6 +219,54 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"tls.nonSniCertificates": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlTls).GetNonSniCertificates()).ToDataRes(types.Array(types.Resource("certificate")))
 	},
+	"ssh.socket": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSsh).GetSocket()).ToDataRes(types.Resource("socket"))
+	},
+	"ssh.params": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSsh).GetParams()).ToDataRes(types.Dict)
+	},
+	"ssh.identification": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSsh).GetIdentification()).ToDataRes(types.String)
+	},
+	"ssh.protocolVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSsh).GetProtocolVersion()).ToDataRes(types.String)
+	},
+	"ssh.softwareVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSsh).GetSoftwareVersion()).ToDataRes(types.String)
+	},
+	"ssh.hostKeys": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSsh).GetHostKeys()).ToDataRes(types.Array(types.Resource("ssh.hostKey")))
+	},
+	"ssh.hostKeyAlgorithms": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSsh).GetHostKeyAlgorithms()).ToDataRes(types.Array(types.String))
+	},
+	"ssh.kexs": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSsh).GetKexs()).ToDataRes(types.Array(types.String))
+	},
+	"ssh.ciphers": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSsh).GetCiphers()).ToDataRes(types.Array(types.String))
+	},
+	"ssh.macs": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSsh).GetMacs()).ToDataRes(types.Array(types.String))
+	},
+	"ssh.hostKey.type": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshHostKey).GetType()).ToDataRes(types.String)
+	},
+	"ssh.hostKey.publicKey": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshHostKey).GetPublicKey()).ToDataRes(types.String)
+	},
+	"ssh.hostKey.fingerprint": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshHostKey).GetFingerprint()).ToDataRes(types.String)
+	},
+	"ssh.hostKey.fingerprintMd5": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshHostKey).GetFingerprintMd5()).ToDataRes(types.String)
+	},
+	"banner.socket": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBanner).GetSocket()).ToDataRes(types.Resource("socket"))
+	},
+	"banner.text": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBanner).GetText()).ToDataRes(types.String)
+	},
 	"certificates.pem": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlCertificates).GetPem()).ToDataRes(types.String)
 	},
@@ -416,6 +504,18 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"dns.dkim": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlDns).GetDkim()).ToDataRes(types.Array(types.Resource("dns.dkimRecord")))
 	},
+	"dns.spf": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDns).GetSpf()).ToDataRes(types.Resource("dns.spfRecord"))
+	},
+	"dns.dmarc": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDns).GetDmarc()).ToDataRes(types.Resource("dns.dmarcRecord"))
+	},
+	"dns.caa": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDns).GetCaa()).ToDataRes(types.Array(types.Resource("dns.caaRecord")))
+	},
+	"dns.dnssec": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDns).GetDnssec()).ToDataRes(types.Resource("dns.dnssec"))
+	},
 	"dns.record.name": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlDnsRecord).GetName()).ToDataRes(types.String)
 	},
@@ -470,6 +570,165 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"dns.dkimRecord.valid": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlDnsDkimRecord).GetValid()).ToDataRes(types.Bool)
 	},
+	"dns.spfRecord.dnsTxt": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsSpfRecord).GetDnsTxt()).ToDataRes(types.String)
+	},
+	"dns.spfRecord.domain": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsSpfRecord).GetDomain()).ToDataRes(types.String)
+	},
+	"dns.spfRecord.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsSpfRecord).GetVersion()).ToDataRes(types.String)
+	},
+	"dns.spfRecord.mechanisms": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsSpfRecord).GetMechanisms()).ToDataRes(types.Array(types.Resource("dns.spfMechanism")))
+	},
+	"dns.spfRecord.modifiers": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsSpfRecord).GetModifiers()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"dns.spfRecord.includes": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsSpfRecord).GetIncludes()).ToDataRes(types.Array(types.String))
+	},
+	"dns.spfRecord.dnsLookups": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsSpfRecord).GetDnsLookups()).ToDataRes(types.Int)
+	},
+	"dns.spfRecord.valid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsSpfRecord).GetValid()).ToDataRes(types.Bool)
+	},
+	"dns.spfMechanism.qualifier": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsSpfMechanism).GetQualifier()).ToDataRes(types.String)
+	},
+	"dns.spfMechanism.mechanism": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsSpfMechanism).GetMechanism()).ToDataRes(types.String)
+	},
+	"dns.spfMechanism.value": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsSpfMechanism).GetValue()).ToDataRes(types.String)
+	},
+	"dns.spfMechanism.cidr": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsSpfMechanism).GetCidr()).ToDataRes(types.String)
+	},
+	"dns.dmarcRecord.dnsTxt": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetDnsTxt()).ToDataRes(types.String)
+	},
+	"dns.dmarcRecord.domain": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetDomain()).ToDataRes(types.String)
+	},
+	"dns.dmarcRecord.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetVersion()).ToDataRes(types.String)
+	},
+	"dns.dmarcRecord.policy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetPolicy()).ToDataRes(types.String)
+	},
+	"dns.dmarcRecord.subdomainPolicy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetSubdomainPolicy()).ToDataRes(types.String)
+	},
+	"dns.dmarcRecord.pct": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetPct()).ToDataRes(types.Int)
+	},
+	"dns.dmarcRecord.rua": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetRua()).ToDataRes(types.Array(types.String))
+	},
+	"dns.dmarcRecord.ruf": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetRuf()).ToDataRes(types.Array(types.String))
+	},
+	"dns.dmarcRecord.adkim": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetAdkim()).ToDataRes(types.String)
+	},
+	"dns.dmarcRecord.aspf": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetAspf()).ToDataRes(types.String)
+	},
+	"dns.dmarcRecord.fo": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetFo()).ToDataRes(types.String)
+	},
+	"dns.dmarcRecord.ri": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetRi()).ToDataRes(types.Int)
+	},
+	"dns.caaRecord.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsCaaRecord).GetName()).ToDataRes(types.String)
+	},
+	"dns.caaRecord.flag": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsCaaRecord).GetFlag()).ToDataRes(types.Int)
+	},
+	"dns.caaRecord.critical": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsCaaRecord).GetCritical()).ToDataRes(types.Bool)
+	},
+	"dns.caaRecord.tag": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsCaaRecord).GetTag()).ToDataRes(types.String)
+	},
+	"dns.caaRecord.value": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsCaaRecord).GetValue()).ToDataRes(types.String)
+	},
+	"dns.dnssec.fqdn": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssec).GetFqdn()).ToDataRes(types.String)
+	},
+	"dns.dnssec.enabled": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssec).GetEnabled()).ToDataRes(types.Bool)
+	},
+	"dns.dnssec.dsPresent": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssec).GetDsPresent()).ToDataRes(types.Bool)
+	},
+	"dns.dnssec.dnskeyPresent": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssec).GetDnskeyPresent()).ToDataRes(types.Bool)
+	},
+	"dns.dnssec.rrsigPresent": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssec).GetRrsigPresent()).ToDataRes(types.Bool)
+	},
+	"dns.dnssec.validated": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssec).GetValidated()).ToDataRes(types.Bool)
+	},
+	"http.get.url": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpGet).GetUrl()).ToDataRes(types.String)
+	},
+	"http.get.method": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpGet).GetMethod()).ToDataRes(types.String)
+	},
+	"http.get.requestHeaders": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpGet).GetRequestHeaders()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"http.get.requestBody": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpGet).GetRequestBody()).ToDataRes(types.String)
+	},
+	"http.get.statusCode": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpGet).GetStatusCode()).ToDataRes(types.Int)
+	},
+	"http.get.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpGet).GetVersion()).ToDataRes(types.String)
+	},
+	"http.get.headers": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpGet).GetHeaders()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"http.get.body": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpGet).GetBody()).ToDataRes(types.String)
+	},
+	"http.get.json": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpGet).GetJson()).ToDataRes(types.Dict)
+	},
+	"http.get.redirects": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpGet).GetRedirects()).ToDataRes(types.Array(types.String))
+	},
+	"http.get.tls": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpGet).GetTls()).ToDataRes(types.Resource("tls"))
+	},
+	"http.get.header.url": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpGetHeader).GetUrl()).ToDataRes(types.String)
+	},
+	"http.get.header.method": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpGetHeader).GetMethod()).ToDataRes(types.String)
+	},
+	"http.get.header.requestHeaders": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpGetHeader).GetRequestHeaders()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"http.get.header.requestBody": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpGetHeader).GetRequestBody()).ToDataRes(types.String)
+	},
+	"http.get.header.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpGetHeader).GetName()).ToDataRes(types.String)
+	},
+	"http.get.header.exists": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpGetHeader).GetExists()).ToDataRes(types.Bool)
+	},
+	"http.get.header.value": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpGetHeader).GetValue()).ToDataRes(types.String)
+	},
 }
 
 func GetData(resource plugin.Resource, field string, args map[string]*llx.RawData) *plugin.DataRes {
@@ -534,6 +793,82 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
 		r.(*mqlTls).NonSniCertificates, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
+	"ssh.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlSsh).__id, ok = v.Value.(string)
+			return
+		},
+	"ssh.socket": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSsh).Socket, ok = plugin.RawToTValue[*mqlSocket](v.Value, v.Error)
+		return
+	},
+	"ssh.params": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSsh).Params, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"ssh.identification": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSsh).Identification, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ssh.protocolVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSsh).ProtocolVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ssh.softwareVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSsh).SoftwareVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ssh.hostKeys": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSsh).HostKeys, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"ssh.hostKeyAlgorithms": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSsh).HostKeyAlgorithms, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"ssh.kexs": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSsh).Kexs, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"ssh.ciphers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSsh).Ciphers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"ssh.macs": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSsh).Macs, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"ssh.hostKey.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlSshHostKey).__id, ok = v.Value.(string)
+			return
+		},
+	"ssh.hostKey.type": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshHostKey).Type, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ssh.hostKey.publicKey": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshHostKey).PublicKey, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ssh.hostKey.fingerprint": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshHostKey).Fingerprint, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ssh.hostKey.fingerprintMd5": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshHostKey).FingerprintMd5, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"banner.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlBanner).__id, ok = v.Value.(string)
+			return
+		},
+	"banner.socket": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBanner).Socket, ok = plugin.RawToTValue[*mqlSocket](v.Value, v.Error)
+		return
+	},
+	"banner.text": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBanner).Text, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
 	"certificates.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 			r.(*mqlCertificates).__id, ok = v.Value.(string)
 			return
@@ -894,6 +1229,22 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
 		r.(*mqlDns).Dkim, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
+	"dns.spf": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDns).Spf, ok = plugin.RawToTValue[*mqlDnsSpfRecord](v.Value, v.Error)
+		return
+	},
+	"dns.dmarc": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDns).Dmarc, ok = plugin.RawToTValue[*mqlDnsDmarcRecord](v.Value, v.Error)
+		return
+	},
+	"dns.caa": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDns).Caa, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDns).Dnssec, ok = plugin.RawToTValue[*mqlDnsDnssec](v.Value, v.Error)
+		return
+	},
 	"dns.record.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 			r.(*mqlDnsRecord).__id, ok = v.Value.(string)
 			return
@@ -978,6 +1329,246 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
 		r.(*mqlDnsDkimRecord).Valid, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
+	"dns.spfRecord.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDnsSpfRecord).__id, ok = v.Value.(string)
+			return
+		},
+	"dns.spfRecord.dnsTxt": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsSpfRecord).DnsTxt, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.spfRecord.domain": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsSpfRecord).Domain, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.spfRecord.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsSpfRecord).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.spfRecord.mechanisms": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsSpfRecord).Mechanisms, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.spfRecord.modifiers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsSpfRecord).Modifiers, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.spfRecord.includes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsSpfRecord).Includes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.spfRecord.dnsLookups": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsSpfRecord).DnsLookups, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"dns.spfRecord.valid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsSpfRecord).Valid, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"dns.spfMechanism.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDnsSpfMechanism).__id, ok = v.Value.(string)
+			return
+		},
+	"dns.spfMechanism.qualifier": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsSpfMechanism).Qualifier, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.spfMechanism.mechanism": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsSpfMechanism).Mechanism, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.spfMechanism.value": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsSpfMechanism).Value, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.spfMechanism.cidr": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsSpfMechanism).Cidr, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDnsDmarcRecord).__id, ok = v.Value.(string)
+			return
+		},
+	"dns.dmarcRecord.dnsTxt": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).DnsTxt, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.domain": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).Domain, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.policy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).Policy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.subdomainPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).SubdomainPolicy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.pct": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).Pct, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.rua": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).Rua, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.ruf": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).Ruf, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.adkim": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).Adkim, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.aspf": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).Aspf, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.fo": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).Fo, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.ri": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).Ri, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"dns.caaRecord.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDnsCaaRecord).__id, ok = v.Value.(string)
+			return
+		},
+	"dns.caaRecord.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsCaaRecord).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.caaRecord.flag": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsCaaRecord).Flag, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"dns.caaRecord.critical": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsCaaRecord).Critical, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"dns.caaRecord.tag": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsCaaRecord).Tag, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.caaRecord.value": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsCaaRecord).Value, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDnsDnssec).__id, ok = v.Value.(string)
+			return
+		},
+	"dns.dnssec.fqdn": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssec).Fqdn, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.enabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssec).Enabled, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.dsPresent": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssec).DsPresent, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.dnskeyPresent": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssec).DnskeyPresent, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.rrsigPresent": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssec).RrsigPresent, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.validated": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssec).Validated, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"http.get.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlHttpGet).__id, ok = v.Value.(string)
+			return
+		},
+	"http.get.url": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpGet).Url, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.get.method": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpGet).Method, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.get.requestHeaders": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpGet).RequestHeaders, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"http.get.requestBody": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpGet).RequestBody, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.get.statusCode": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpGet).StatusCode, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"http.get.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpGet).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.get.headers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpGet).Headers, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"http.get.body": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpGet).Body, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.get.json": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpGet).Json, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"http.get.redirects": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpGet).Redirects, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"http.get.tls": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpGet).Tls, ok = plugin.RawToTValue[*mqlTls](v.Value, v.Error)
+		return
+	},
+	"http.get.header.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlHttpGetHeader).__id, ok = v.Value.(string)
+			return
+		},
+	"http.get.header.url": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpGetHeader).Url, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.get.header.method": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpGetHeader).Method, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.get.header.requestHeaders": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpGetHeader).RequestHeaders, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"http.get.header.requestBody": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpGetHeader).RequestBody, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.get.header.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpGetHeader).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.get.header.exists": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpGetHeader).Exists, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"http.get.header.value": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpGetHeader).Value, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
 }
 
 func SetData(resource plugin.Resource, field string, val *llx.RawData) error {
@@ -1212,15 +1803,302 @@ func (c *mqlTls) GetNonSniCertificates() *plugin.TValue[[]interface{}] {
 	})
 }
 
-// mqlCertificates for the certificates resource
-type mqlCertificates struct {
+// mqlSsh for the ssh resource
+type mqlSsh struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlCertificatesInternal it will be used here
-	Pem plugin.TValue[string]
-	List plugin.TValue[[]interface{}]
-}
-
+	// optional: if you define mqlSshInternal it will be used here
+	Socket plugin.TValue[*mqlSocket]
+	Params plugin.TValue[interface{}]
+	Identification plugin.TValue[string]
+	ProtocolVersion plugin.TValue[string]
+	SoftwareVersion plugin.TValue[string]
+	HostKeys plugin.TValue[[]interface{}]
+	HostKeyAlgorithms plugin.TValue[[]interface{}]
+	Kexs plugin.TValue[[]interface{}]
+	Ciphers plugin.TValue[[]interface{}]
+	Macs plugin.TValue[[]interface{}]
+}
+
+// createSsh creates a new instance of this resource
+func createSsh(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSsh{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("ssh", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlSsh) MqlName() string {
+	return "ssh"
+}
+
+func (c *mqlSsh) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlSsh) GetSocket() *plugin.TValue[*mqlSocket] {
+	return &c.Socket
+}
+
+func (c *mqlSsh) GetParams() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Params, func() (interface{}, error) {
+		vargSocket := c.GetSocket()
+		if vargSocket.Error != nil {
+			return nil, vargSocket.Error
+		}
+
+		return c.params(vargSocket.Data)
+	})
+}
+
+func (c *mqlSsh) GetIdentification() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Identification, func() (string, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return "", vargParams.Error
+		}
+
+		return c.identification(vargParams.Data)
+	})
+}
+
+func (c *mqlSsh) GetProtocolVersion() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.ProtocolVersion, func() (string, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return "", vargParams.Error
+		}
+
+		return c.protocolVersion(vargParams.Data)
+	})
+}
+
+func (c *mqlSsh) GetSoftwareVersion() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.SoftwareVersion, func() (string, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return "", vargParams.Error
+		}
+
+		return c.softwareVersion(vargParams.Data)
+	})
+}
+
+func (c *mqlSsh) GetHostKeys() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.HostKeys, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("ssh", c.__id, "hostKeys")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.hostKeys(vargParams.Data)
+	})
+}
+
+func (c *mqlSsh) GetHostKeyAlgorithms() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.HostKeyAlgorithms, func() ([]interface{}, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.hostKeyAlgorithms(vargParams.Data)
+	})
+}
+
+func (c *mqlSsh) GetKexs() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Kexs, func() ([]interface{}, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.kexs(vargParams.Data)
+	})
+}
+
+func (c *mqlSsh) GetCiphers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Ciphers, func() ([]interface{}, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.ciphers(vargParams.Data)
+	})
+}
+
+func (c *mqlSsh) GetMacs() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Macs, func() ([]interface{}, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.macs(vargParams.Data)
+	})
+}
+
+// mqlSshHostKey for the ssh.hostKey resource
+type mqlSshHostKey struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlSshHostKeyInternal it will be used here
+	Type plugin.TValue[string]
+	PublicKey plugin.TValue[string]
+	Fingerprint plugin.TValue[string]
+	FingerprintMd5 plugin.TValue[string]
+}
+
+// createSshHostKey creates a new instance of this resource
+func createSshHostKey(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSshHostKey{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("ssh.hostKey", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlSshHostKey) MqlName() string {
+	return "ssh.hostKey"
+}
+
+func (c *mqlSshHostKey) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlSshHostKey) GetType() *plugin.TValue[string] {
+	return &c.Type
+}
+
+func (c *mqlSshHostKey) GetPublicKey() *plugin.TValue[string] {
+	return &c.PublicKey
+}
+
+func (c *mqlSshHostKey) GetFingerprint() *plugin.TValue[string] {
+	return &c.Fingerprint
+}
+
+func (c *mqlSshHostKey) GetFingerprintMd5() *plugin.TValue[string] {
+	return &c.FingerprintMd5
+}
+
+// mqlBanner for the banner resource
+type mqlBanner struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlBannerInternal it will be used here
+	Socket plugin.TValue[*mqlSocket]
+	Text plugin.TValue[string]
+}
+
+// createBanner creates a new instance of this resource
+func createBanner(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlBanner{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("banner", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlBanner) MqlName() string {
+	return "banner"
+}
+
+func (c *mqlBanner) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlBanner) GetSocket() *plugin.TValue[*mqlSocket] {
+	return &c.Socket
+}
+
+func (c *mqlBanner) GetText() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Text, func() (string, error) {
+		return c.text()
+	})
+}
+
+// mqlCertificates for the certificates resource
+type mqlCertificates struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlCertificatesInternal it will be used here
+	Pem plugin.TValue[string]
+	List plugin.TValue[[]interface{}]
+}
+
 // createCertificates creates a new instance of this resource
 func createCertificates(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
 	res := &mqlCertificates{
@@ -2156,6 +3034,10 @@ type mqlDns struct {
 	Records plugin.TValue[[]interface{}]
 	Mx plugin.TValue[[]interface{}]
 	Dkim plugin.TValue[[]interface{}]
+	Spf plugin.TValue[*mqlDnsSpfRecord]
+	Dmarc plugin.TValue[*mqlDnsDmarcRecord]
+	Caa plugin.TValue[[]interface{}]
+	Dnssec plugin.TValue[*mqlDnsDnssec]
 }
 
 // createDns creates a new instance of this resource
@@ -2273,40 +3155,124 @@ func (c *mqlDns) GetDkim() *plugin.TValue[[]interface{}] {
 	})
 }
 
-// mqlDnsRecord for the dns.record resource
-type mqlDnsRecord struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	// optional: if you define mqlDnsRecordInternal it will be used here
-	Name plugin.TValue[string]
-	Ttl plugin.TValue[int64]
-	Class plugin.TValue[string]
-	Type plugin.TValue[string]
-	Rdata plugin.TValue[[]interface{}]
-}
-
-// createDnsRecord creates a new instance of this resource
-func createDnsRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDnsRecord{
-		MqlRuntime: runtime,
-	}
-
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
-	}
-
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
+func (c *mqlDns) GetSpf() *plugin.TValue[*mqlDnsSpfRecord] {
+	return plugin.GetOrCompute[*mqlDnsSpfRecord](&c.Spf, func() (*mqlDnsSpfRecord, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "spf")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlDnsSpfRecord), nil
+			}
 		}
-	}
 
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("dns.record", res.__id)
-		if err != nil || args == nil {
-			return res, err
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.spf(vargParams.Data)
+	})
+}
+
+func (c *mqlDns) GetDmarc() *plugin.TValue[*mqlDnsDmarcRecord] {
+	return plugin.GetOrCompute[*mqlDnsDmarcRecord](&c.Dmarc, func() (*mqlDnsDmarcRecord, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "dmarc")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlDnsDmarcRecord), nil
+			}
+		}
+
+		vargFqdn := c.GetFqdn()
+		if vargFqdn.Error != nil {
+			return nil, vargFqdn.Error
+		}
+
+		return c.dmarc(vargFqdn.Data)
+	})
+}
+
+func (c *mqlDns) GetCaa() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Caa, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "caa")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.caa(vargParams.Data)
+	})
+}
+
+func (c *mqlDns) GetDnssec() *plugin.TValue[*mqlDnsDnssec] {
+	return plugin.GetOrCompute[*mqlDnsDnssec](&c.Dnssec, func() (*mqlDnsDnssec, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "dnssec")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlDnsDnssec), nil
+			}
+		}
+
+		vargFqdn := c.GetFqdn()
+		if vargFqdn.Error != nil {
+			return nil, vargFqdn.Error
+		}
+
+		return c.dnssec(vargFqdn.Data)
+	})
+}
+
+// mqlDnsRecord for the dns.record resource
+type mqlDnsRecord struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlDnsRecordInternal it will be used here
+	Name plugin.TValue[string]
+	Ttl plugin.TValue[int64]
+	Class plugin.TValue[string]
+	Type plugin.TValue[string]
+	Rdata plugin.TValue[[]interface{}]
+}
+
+// createDnsRecord creates a new instance of this resource
+func createDnsRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsRecord{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("dns.record", res.__id)
+		if err != nil || args == nil {
+			return res, err
 		}
 		return res, SetAllData(res, args)
 	}
@@ -2496,3 +3462,608 @@ func (c *mqlDnsDkimRecord) GetValid() *plugin.TValue[bool] {
 		return c.valid()
 	})
 }
+
+// mqlDnsSpfRecord for the dns.spfRecord resource
+type mqlDnsSpfRecord struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlDnsSpfRecordInternal
+	DnsTxt plugin.TValue[string]
+	Domain plugin.TValue[string]
+	Version plugin.TValue[string]
+	Mechanisms plugin.TValue[[]interface{}]
+	Modifiers plugin.TValue[map[string]interface{}]
+	Includes plugin.TValue[[]interface{}]
+	DnsLookups plugin.TValue[int64]
+	Valid plugin.TValue[bool]
+}
+
+// createDnsSpfRecord creates a new instance of this resource
+func createDnsSpfRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsSpfRecord{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("dns.spfRecord", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlDnsSpfRecord) MqlName() string {
+	return "dns.spfRecord"
+}
+
+func (c *mqlDnsSpfRecord) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlDnsSpfRecord) GetDnsTxt() *plugin.TValue[string] {
+	return &c.DnsTxt
+}
+
+func (c *mqlDnsSpfRecord) GetDomain() *plugin.TValue[string] {
+	return &c.Domain
+}
+
+func (c *mqlDnsSpfRecord) GetVersion() *plugin.TValue[string] {
+	return &c.Version
+}
+
+func (c *mqlDnsSpfRecord) GetMechanisms() *plugin.TValue[[]interface{}] {
+	return &c.Mechanisms
+}
+
+func (c *mqlDnsSpfRecord) GetModifiers() *plugin.TValue[map[string]interface{}] {
+	return &c.Modifiers
+}
+
+func (c *mqlDnsSpfRecord) GetIncludes() *plugin.TValue[[]interface{}] {
+	return &c.Includes
+}
+
+func (c *mqlDnsSpfRecord) GetDnsLookups() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.DnsLookups, func() (int64, error) {
+		return c.dnsLookups()
+	})
+}
+
+func (c *mqlDnsSpfRecord) GetValid() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Valid, func() (bool, error) {
+		return c.valid()
+	})
+}
+
+// mqlDnsSpfMechanism for the dns.spfMechanism resource
+type mqlDnsSpfMechanism struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlDnsSpfMechanismInternal it will be used here
+	Qualifier plugin.TValue[string]
+	Mechanism plugin.TValue[string]
+	Value plugin.TValue[string]
+	Cidr plugin.TValue[string]
+}
+
+// createDnsSpfMechanism creates a new instance of this resource
+func createDnsSpfMechanism(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsSpfMechanism{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("dns.spfMechanism", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlDnsSpfMechanism) MqlName() string {
+	return "dns.spfMechanism"
+}
+
+func (c *mqlDnsSpfMechanism) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlDnsSpfMechanism) GetQualifier() *plugin.TValue[string] {
+	return &c.Qualifier
+}
+
+func (c *mqlDnsSpfMechanism) GetMechanism() *plugin.TValue[string] {
+	return &c.Mechanism
+}
+
+func (c *mqlDnsSpfMechanism) GetValue() *plugin.TValue[string] {
+	return &c.Value
+}
+
+func (c *mqlDnsSpfMechanism) GetCidr() *plugin.TValue[string] {
+	return &c.Cidr
+}
+
+// mqlDnsDmarcRecord for the dns.dmarcRecord resource
+type mqlDnsDmarcRecord struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlDnsDmarcRecordInternal it will be used here
+	DnsTxt plugin.TValue[string]
+	Domain plugin.TValue[string]
+	Version plugin.TValue[string]
+	Policy plugin.TValue[string]
+	SubdomainPolicy plugin.TValue[string]
+	Pct plugin.TValue[int64]
+	Rua plugin.TValue[[]interface{}]
+	Ruf plugin.TValue[[]interface{}]
+	Adkim plugin.TValue[string]
+	Aspf plugin.TValue[string]
+	Fo plugin.TValue[string]
+	Ri plugin.TValue[int64]
+}
+
+// createDnsDmarcRecord creates a new instance of this resource
+func createDnsDmarcRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsDmarcRecord{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("dns.dmarcRecord", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlDnsDmarcRecord) MqlName() string {
+	return "dns.dmarcRecord"
+}
+
+func (c *mqlDnsDmarcRecord) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlDnsDmarcRecord) GetDnsTxt() *plugin.TValue[string] {
+	return &c.DnsTxt
+}
+
+func (c *mqlDnsDmarcRecord) GetDomain() *plugin.TValue[string] {
+	return &c.Domain
+}
+
+func (c *mqlDnsDmarcRecord) GetVersion() *plugin.TValue[string] {
+	return &c.Version
+}
+
+func (c *mqlDnsDmarcRecord) GetPolicy() *plugin.TValue[string] {
+	return &c.Policy
+}
+
+func (c *mqlDnsDmarcRecord) GetSubdomainPolicy() *plugin.TValue[string] {
+	return &c.SubdomainPolicy
+}
+
+func (c *mqlDnsDmarcRecord) GetPct() *plugin.TValue[int64] {
+	return &c.Pct
+}
+
+func (c *mqlDnsDmarcRecord) GetRua() *plugin.TValue[[]interface{}] {
+	return &c.Rua
+}
+
+func (c *mqlDnsDmarcRecord) GetRuf() *plugin.TValue[[]interface{}] {
+	return &c.Ruf
+}
+
+func (c *mqlDnsDmarcRecord) GetAdkim() *plugin.TValue[string] {
+	return &c.Adkim
+}
+
+func (c *mqlDnsDmarcRecord) GetAspf() *plugin.TValue[string] {
+	return &c.Aspf
+}
+
+func (c *mqlDnsDmarcRecord) GetFo() *plugin.TValue[string] {
+	return &c.Fo
+}
+
+func (c *mqlDnsDmarcRecord) GetRi() *plugin.TValue[int64] {
+	return &c.Ri
+}
+
+// mqlDnsCaaRecord for the dns.caaRecord resource
+type mqlDnsCaaRecord struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlDnsCaaRecordInternal it will be used here
+	Name plugin.TValue[string]
+	Flag plugin.TValue[int64]
+	Critical plugin.TValue[bool]
+	Tag plugin.TValue[string]
+	Value plugin.TValue[string]
+}
+
+// createDnsCaaRecord creates a new instance of this resource
+func createDnsCaaRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsCaaRecord{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("dns.caaRecord", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlDnsCaaRecord) MqlName() string {
+	return "dns.caaRecord"
+}
+
+func (c *mqlDnsCaaRecord) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlDnsCaaRecord) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlDnsCaaRecord) GetFlag() *plugin.TValue[int64] {
+	return &c.Flag
+}
+
+func (c *mqlDnsCaaRecord) GetCritical() *plugin.TValue[bool] {
+	return &c.Critical
+}
+
+func (c *mqlDnsCaaRecord) GetTag() *plugin.TValue[string] {
+	return &c.Tag
+}
+
+func (c *mqlDnsCaaRecord) GetValue() *plugin.TValue[string] {
+	return &c.Value
+}
+
+// mqlDnsDnssec for the dns.dnssec resource
+type mqlDnsDnssec struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlDnsDnssecInternal it will be used here
+	Fqdn plugin.TValue[string]
+	Enabled plugin.TValue[bool]
+	DsPresent plugin.TValue[bool]
+	DnskeyPresent plugin.TValue[bool]
+	RrsigPresent plugin.TValue[bool]
+	Validated plugin.TValue[bool]
+}
+
+// createDnsDnssec creates a new instance of this resource
+func createDnsDnssec(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsDnssec{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("dns.dnssec", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlDnsDnssec) MqlName() string {
+	return "dns.dnssec"
+}
+
+func (c *mqlDnsDnssec) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlDnsDnssec) GetFqdn() *plugin.TValue[string] {
+	return &c.Fqdn
+}
+
+func (c *mqlDnsDnssec) GetEnabled() *plugin.TValue[bool] {
+	return &c.Enabled
+}
+
+func (c *mqlDnsDnssec) GetDsPresent() *plugin.TValue[bool] {
+	return &c.DsPresent
+}
+
+func (c *mqlDnsDnssec) GetDnskeyPresent() *plugin.TValue[bool] {
+	return &c.DnskeyPresent
+}
+
+func (c *mqlDnsDnssec) GetRrsigPresent() *plugin.TValue[bool] {
+	return &c.RrsigPresent
+}
+
+func (c *mqlDnsDnssec) GetValidated() *plugin.TValue[bool] {
+	return &c.Validated
+}
+
+// mqlHttpGet for the http.get resource
+type mqlHttpGet struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlHttpGetInternal
+	Url plugin.TValue[string]
+	Method plugin.TValue[string]
+	RequestHeaders plugin.TValue[map[string]interface{}]
+	RequestBody plugin.TValue[string]
+	StatusCode plugin.TValue[int64]
+	Version plugin.TValue[string]
+	Headers plugin.TValue[map[string]interface{}]
+	Body plugin.TValue[string]
+	Json plugin.TValue[interface{}]
+	Redirects plugin.TValue[[]interface{}]
+	Tls plugin.TValue[*mqlTls]
+}
+
+// createHttpGet creates a new instance of this resource
+func createHttpGet(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlHttpGet{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("http.get", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlHttpGet) MqlName() string {
+	return "http.get"
+}
+
+func (c *mqlHttpGet) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlHttpGet) GetUrl() *plugin.TValue[string] {
+	return &c.Url
+}
+
+func (c *mqlHttpGet) GetMethod() *plugin.TValue[string] {
+	return &c.Method
+}
+
+func (c *mqlHttpGet) GetRequestHeaders() *plugin.TValue[map[string]interface{}] {
+	return &c.RequestHeaders
+}
+
+func (c *mqlHttpGet) GetRequestBody() *plugin.TValue[string] {
+	return &c.RequestBody
+}
+
+func (c *mqlHttpGet) GetStatusCode() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.StatusCode, func() (int64, error) {
+		return c.statusCode()
+	})
+}
+
+func (c *mqlHttpGet) GetVersion() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Version, func() (string, error) {
+		return c.version()
+	})
+}
+
+func (c *mqlHttpGet) GetHeaders() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Headers, func() (map[string]interface{}, error) {
+		return c.headers()
+	})
+}
+
+func (c *mqlHttpGet) GetBody() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Body, func() (string, error) {
+		return c.body()
+	})
+}
+
+func (c *mqlHttpGet) GetJson() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Json, func() (interface{}, error) {
+		return c.json()
+	})
+}
+
+func (c *mqlHttpGet) GetRedirects() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Redirects, func() ([]interface{}, error) {
+		return c.redirects()
+	})
+}
+
+func (c *mqlHttpGet) GetTls() *plugin.TValue[*mqlTls] {
+	return plugin.GetOrCompute[*mqlTls](&c.Tls, func() (*mqlTls, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("http.get", c.__id, "tls")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlTls), nil
+			}
+		}
+
+		return c.tls()
+	})
+}
+
+// mqlHttpGetHeader for the http.get.header resource
+type mqlHttpGetHeader struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlHttpGetHeaderInternal it will be used here
+	Url plugin.TValue[string]
+	Method plugin.TValue[string]
+	RequestHeaders plugin.TValue[map[string]interface{}]
+	RequestBody plugin.TValue[string]
+	Name plugin.TValue[string]
+	Exists plugin.TValue[bool]
+	Value plugin.TValue[string]
+}
+
+// createHttpGetHeader creates a new instance of this resource
+func createHttpGetHeader(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlHttpGetHeader{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("http.get.header", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlHttpGetHeader) MqlName() string {
+	return "http.get.header"
+}
+
+func (c *mqlHttpGetHeader) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlHttpGetHeader) GetUrl() *plugin.TValue[string] {
+	return &c.Url
+}
+
+func (c *mqlHttpGetHeader) GetMethod() *plugin.TValue[string] {
+	return &c.Method
+}
+
+func (c *mqlHttpGetHeader) GetRequestHeaders() *plugin.TValue[map[string]interface{}] {
+	return &c.RequestHeaders
+}
+
+func (c *mqlHttpGetHeader) GetRequestBody() *plugin.TValue[string] {
+	return &c.RequestBody
+}
+
+func (c *mqlHttpGetHeader) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlHttpGetHeader) GetExists() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Exists, func() (bool, error) {
+		return c.exists()
+	})
+}
+
+func (c *mqlHttpGetHeader) GetValue() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Value, func() (string, error) {
+		return c.value()
+	})
+}
@@ -1,5 +1,26 @@
-package resources_test
+package resources
 
-import "go.mondoo.com/cnquery/providers-sdk/v1/testutils"
+import (
+	"testing"
 
-var x = testutils.InitTester(testutils.LinuxMock("../../../providers-sdk/v1/testutils"))
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/providers/network/connection"
+)
+
+// newTestRuntime creates a runtime with a host connection for the given config
+func newTestRuntime(conf *inventory.Config) *plugin.Runtime {
+	return &plugin.Runtime{
+		Connection: connection.NewHostConnection(1, &inventory.Asset{}, conf),
+		Resources:  map[string]plugin.Resource{},
+	}
+}
+
+// newTestResource creates a resource and fails the test if that isn't possible
+func newTestResource[T plugin.Resource](t *testing.T, runtime *plugin.Runtime, name string, args map[string]*llx.RawData) T {
+	o, err := NewResource(runtime, name, args)
+	require.NoError(t, err)
+	return o.(T)
+}
@@ -1,7 +1,33 @@
 package resources
 
-import "strconv"
+import (
+	"errors"
+	"regexp"
+	"strconv"
+)
+
+var reTarget = regexp.MustCompile("([^/:]+?)(:\\d+)?$")
 
 func (s *mqlSocket) id() (string, error) {
 	return s.Protocol.Data + "://" + s.Address.Data + ":" + strconv.Itoa(int(s.Port.Data)), nil
 }
+
+// parseTarget splits a target into its address and port. The default port is
+// used if the target does not contain a port.
+func parseTarget(target string, defaultPort int64) (string, int64, error) {
+	m := reTarget.FindStringSubmatch(target)
+	if len(m) == 0 {
+		return "", 0, errors.New("target must be provided in the form of: tcp://target:port, udp://target:port, or target:port (defaults to tcp)")
+	}
+
+	port := defaultPort
+	if len(m[2]) != 0 {
+		rawPort, err := strconv.ParseUint(m[2][1:], 10, 64)
+		if err != nil {
+			return "", 0, errors.New("failed to parse port: " + m[2])
+		}
+		port = int64(rawPort)
+	}
+
+	return m[1], port, nil
+}
@@ -0,0 +1,173 @@
+package resources
+
+import (
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/providers/network/connection"
+	"go.mondoo.com/cnquery/providers/network/resources/sshshake"
+)
+
+func initSsh(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	// if the socket is set already, we have nothing else to do
+	if _, ok := args["socket"]; ok {
+		return args, nil, nil
+	}
+
+	conn := runtime.Connection.(*connection.HostConnection)
+
+	var address string
+	var port int64
+	if target, ok := args["target"]; ok {
+		var err error
+		address, port, err = parseTarget(target.Value.(string), 22)
+		if err != nil {
+			return nil, nil, err
+		}
+		delete(args, "target")
+	} else {
+		address = conn.FQDN()
+		port = 22
+		if conn.Conf.Port != 0 {
+			port = int64(conn.Conf.Port)
+		}
+	}
+
+	socket, err := CreateResource(runtime, "socket", map[string]*llx.RawData{
+		"protocol": llx.StringData("tcp"),
+		"port":     llx.IntData(port),
+		"address":  llx.StringData(address),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	args["socket"] = llx.ResourceData(socket, "socket")
+
+	return args, nil, nil
+}
+
+func (s *mqlSsh) id() (string, error) {
+	return "ssh+" + s.Socket.Data.__id, nil
+}
+
+func (s *mqlSsh) params(socket *mqlSocket) (map[string]interface{}, error) {
+	tester := sshshake.New(socket.Protocol.Data, socket.Address.Data, int(socket.Port.Data))
+	if err := tester.Test(); err != nil {
+		return nil, err
+	}
+
+	findings := tester.Findings
+	res := map[string]interface{}{
+		"identification":  findings.Identification,
+		"protocolVersion": findings.ProtocolVersion,
+		"softwareVersion": findings.SoftwareVersion,
+		"comments":        findings.Comments,
+	}
+
+	lists := map[string][]string{
+		"kexAlgorithms":     findings.KexAlgorithms,
+		"hostKeyAlgorithms": findings.HostKeyAlgorithms,
+		"ciphers":           findings.Ciphers,
+		"macs":              findings.MACs,
+		"compressions":      findings.Compressions,
+		"errors":            findings.Errors,
+	}
+	for field, data := range lists {
+		res[field] = llx.TArr2Raw(data)
+	}
+
+	hostKeys := make([]interface{}, len(findings.HostKeys))
+	for i := range findings.HostKeys {
+		key := findings.HostKeys[i]
+		hostKeys[i] = map[string]interface{}{
+			"type":              key.Type,
+			"publicKey":         key.PublicKey,
+			"fingerprintSHA256": key.FingerprintSHA256,
+			"fingerprintMD5":    key.FingerprintMD5,
+		}
+	}
+	res["hostKeys"] = hostKeys
+
+	return res, nil
+}
+
+func sshParamString(params interface{}, field string) string {
+	paramsM, ok := params.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	res, _ := paramsM[field].(string)
+	return res
+}
+
+func sshParamList(params interface{}, field string) []interface{} {
+	paramsM, ok := params.(map[string]interface{})
+	if !ok {
+		return []interface{}{}
+	}
+	res, ok := paramsM[field].([]interface{})
+	if !ok {
+		return []interface{}{}
+	}
+	return res
+}
+
+func (s *mqlSsh) identification(params interface{}) (string, error) {
+	return sshParamString(params, "identification"), nil
+}
+
+func (s *mqlSsh) protocolVersion(params interface{}) (string, error) {
+	return sshParamString(params, "protocolVersion"), nil
+}
+
+func (s *mqlSsh) softwareVersion(params interface{}) (string, error) {
+	return sshParamString(params, "softwareVersion"), nil
+}
+
+func (s *mqlSsh) hostKeyAlgorithms(params interface{}) ([]interface{}, error) {
+	return sshParamList(params, "hostKeyAlgorithms"), nil
+}
+
+func (s *mqlSsh) kexs(params interface{}) ([]interface{}, error) {
+	return sshParamList(params, "kexAlgorithms"), nil
+}
+
+func (s *mqlSsh) ciphers(params interface{}) ([]interface{}, error) {
+	return sshParamList(params, "ciphers"), nil
+}
+
+func (s *mqlSsh) macs(params interface{}) ([]interface{}, error) {
+	return sshParamList(params, "macs"), nil
+}
+
+func (s *mqlSsh) hostKeys(params interface{}) ([]interface{}, error) {
+	keys := sshParamList(params, "hostKeys")
+
+	res := make([]interface{}, 0, len(keys))
+	for i := range keys {
+		key, ok := keys[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		typ, _ := key["type"].(string)
+		publicKey, _ := key["publicKey"].(string)
+		fingerprint, _ := key["fingerprintSHA256"].(string)
+		fingerprintMd5, _ := key["fingerprintMD5"].(string)
+
+		o, err := CreateResource(s.MqlRuntime, "ssh.hostKey", map[string]*llx.RawData{
+			"type":           llx.StringData(typ),
+			"publicKey":      llx.StringData(publicKey),
+			"fingerprint":    llx.StringData(fingerprint),
+			"fingerprintMd5": llx.StringData(fingerprintMd5),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, o)
+	}
+
+	return res, nil
+}
+
+func (k *mqlSshHostKey) id() (string, error) {
+	return "ssh.hostKey/" + k.Fingerprint.Data, nil
+}
@@ -0,0 +1,59 @@
+package resources
+
+import (
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/providers/network/resources/sshshake/sshtest"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestResource_Ssh(t *testing.T) {
+	config, keys := sshtest.NewServerConfig(t)
+	host, port := sshtest.StartServer(t, config)
+
+	runtime := newTestRuntime(&inventory.Config{Host: "localhost"})
+	res := newTestResource[*mqlSsh](t, runtime, "ssh", map[string]*llx.RawData{
+		"target": llx.StringData(net.JoinHostPort(host, strconv.Itoa(port))),
+	})
+
+	socket := res.Socket.Data
+	assert.Equal(t, "127.0.0.1", socket.Address.Data)
+
+	identification := res.GetIdentification()
+	require.NoError(t, identification.Error)
+	assert.Equal(t, "SSH-2.0-OpenSSH_9.3p1 Debian-1", identification.Data)
+	assert.Equal(t, "2.0", res.GetProtocolVersion().Data)
+	assert.Equal(t, "OpenSSH_9.3p1", res.GetSoftwareVersion().Data)
+	assert.Equal(t, []interface{}{"aes128-gcm@openssh.com", "aes256-ctr"}, res.GetCiphers().Data)
+	assert.Equal(t, []interface{}{"hmac-sha2-256-etm@openssh.com", "hmac-sha2-512"}, res.GetMacs().Data)
+	assert.Contains(t, res.GetKexs().Data, "curve25519-sha256")
+	assert.ElementsMatch(t, []interface{}{ssh.KeyAlgoED25519, ssh.KeyAlgoECDSA256}, res.GetHostKeyAlgorithms().Data)
+
+	hostKeys := res.GetHostKeys()
+	require.NoError(t, hostKeys.Error)
+	require.Len(t, hostKeys.Data, len(keys))
+	fingerprints := map[string]string{}
+	for i := range hostKeys.Data {
+		hostKey := hostKeys.Data[i].(*mqlSshHostKey)
+		fingerprints[hostKey.Fingerprint.Data] = hostKey.FingerprintMd5.Data
+	}
+	for _, key := range keys {
+		assert.Equal(t, ssh.FingerprintLegacyMD5(key), fingerprints[ssh.FingerprintSHA256(key)])
+	}
+}
+
+func TestResource_SshDefaultPort(t *testing.T) {
+	runtime := newTestRuntime(&inventory.Config{Host: "example.com"})
+	o, err := NewResource(runtime, "ssh", map[string]*llx.RawData{})
+	require.NoError(t, err)
+
+	socket := o.(*mqlSsh).Socket.Data
+	assert.Equal(t, "example.com", socket.Address.Data)
+	assert.Equal(t, int64(22), socket.Port.Data)
+}
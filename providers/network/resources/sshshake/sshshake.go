@@ -0,0 +1,291 @@
+// shake that SSH
+
+package sshshake
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	// DefaultTimeout for connecting to the target and for each handshake
+	DefaultTimeout = 10 * time.Second
+
+	clientIdentification = "SSH-2.0-cnquery"
+	msgKexInit           = 20
+	// RFC 4253 requires implementations to handle packets of up to 35000 bytes
+	maxPacketLength = 35000
+	// RFC 4253 limits the identification string to 255 characters, but servers
+	// may send other lines before it
+	maxIdentificationLines = 64
+)
+
+var errHostKeyCaptured = errors.New("host key captured")
+
+// Tester connects to an SSH server and collects its identification, the
+// algorithms it offers during key exchange and its host keys
+type Tester struct {
+	Findings Findings
+	proto    string
+	target   string
+	timeout  time.Duration
+}
+
+// Findings of an SSH handshake
+type Findings struct {
+	// Identification string sent by the server, e.g. SSH-2.0-OpenSSH_9.3p1 Debian-1
+	Identification  string
+	ProtocolVersion string
+	SoftwareVersion string
+	Comments        string
+
+	KexAlgorithms     []string
+	HostKeyAlgorithms []string
+	// Ciphers and MACs offered for server to client traffic
+	Ciphers      []string
+	MACs         []string
+	Compressions []string
+
+	HostKeys []HostKey
+	Errors   []string
+}
+
+// HostKey is a public key the server uses to authenticate itself
+type HostKey struct {
+	Type string
+	// PublicKey in the authorized_keys format
+	PublicKey         string
+	FingerprintSHA256 string
+	FingerprintMD5    string
+}
+
+// New creates a new tester object for the given target (via proto, host, port)
+func New(proto string, host string, port int) *Tester {
+	return &Tester{
+		proto:   proto,
+		target:  net.JoinHostPort(host, strconv.Itoa(port)),
+		timeout: DefaultTimeout,
+	}
+}
+
+// Test runs the SSH probes. The first connection collects the identification
+// and the key exchange offer, every further connection completes a key exchange
+// for one type of host key.
+func (s *Tester) Test() error {
+	if err := s.testKexInit(); err != nil {
+		return err
+	}
+
+	for i := range s.Findings.HostKeyAlgorithms {
+		algo := s.Findings.HostKeyAlgorithms[i]
+		// certificates are bound to a host key we collect via its plain algorithm
+		if strings.Contains(algo, "-cert-") {
+			continue
+		}
+		if s.hasHostKey(hostKeyType(algo)) {
+			continue
+		}
+
+		key, err := s.testHostKey(algo)
+		if err != nil {
+			s.Findings.Errors = append(s.Findings.Errors, "failed to retrieve host key for "+algo+": "+err.Error())
+			continue
+		}
+
+		s.Findings.HostKeys = append(s.Findings.HostKeys, HostKey{
+			Type:              key.Type(),
+			PublicKey:         strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key))),
+			FingerprintSHA256: ssh.FingerprintSHA256(key),
+			FingerprintMD5:    ssh.FingerprintLegacyMD5(key),
+		})
+	}
+
+	return nil
+}
+
+func (s *Tester) hasHostKey(typ string) bool {
+	for i := range s.Findings.HostKeys {
+		if s.Findings.HostKeys[i].Type == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// hostKeyType returns the key type for a host key algorithm. RSA keys may be
+// used with different signature algorithms.
+func hostKeyType(algo string) string {
+	switch algo {
+	case ssh.KeyAlgoRSASHA256, ssh.KeyAlgoRSASHA512:
+		return ssh.KeyAlgoRSA
+	default:
+		return algo
+	}
+}
+
+func (s *Tester) testKexInit() error {
+	conn, err := net.DialTimeout(s.proto, s.target, s.timeout)
+	if err != nil {
+		return errors.New("failed to connect to target: " + err.Error())
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(s.timeout)); err != nil {
+		return err
+	}
+
+	if _, err := conn.Write([]byte(clientIdentification + "\r\n")); err != nil {
+		return errors.New("failed to send identification: " + err.Error())
+	}
+
+	reader := bufio.NewReader(conn)
+	ident, err := readIdentification(reader)
+	if err != nil {
+		return err
+	}
+	s.Findings.Identification = ident
+	s.Findings.ProtocolVersion, s.Findings.SoftwareVersion, s.Findings.Comments = ParseIdentification(ident)
+
+	payload, err := readPacket(reader)
+	if err != nil {
+		return err
+	}
+
+	return s.parseKexInit(payload)
+}
+
+func readIdentification(r *bufio.Reader) (string, error) {
+	for i := 0; i < maxIdentificationLines; i++ {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return "", errors.New("failed to read ssh identification: " + err.Error())
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if strings.HasPrefix(line, "SSH-") {
+			return line, nil
+		}
+	}
+	return "", errors.New("target did not send an ssh identification")
+}
+
+// ParseIdentification splits the identification string into the protocol
+// version, the software version and optional comments, see
+// https://datatracker.ietf.org/doc/html/rfc4253#section-4.2
+func ParseIdentification(ident string) (string, string, string) {
+	ident = strings.TrimPrefix(ident, "SSH-")
+	ident, comments, _ := strings.Cut(ident, " ")
+	protocol, software, _ := strings.Cut(ident, "-")
+	return protocol, software, comments
+}
+
+// readPacket reads an unencrypted binary packet, see
+// https://datatracker.ietf.org/doc/html/rfc4253#section-6
+func readPacket(r io.Reader) ([]byte, error) {
+	var length uint32
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return nil, errors.New("failed to read ssh packet: " + err.Error())
+	}
+	if length < 2 || length > maxPacketLength {
+		return nil, errors.New("invalid ssh packet length " + strconv.Itoa(int(length)))
+	}
+
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, errors.New("failed to read ssh packet: " + err.Error())
+	}
+
+	padding := int(data[0])
+	if padding >= len(data) {
+		return nil, errors.New("invalid ssh packet padding")
+	}
+	return data[1 : len(data)-padding], nil
+}
+
+// parseKexInit reads the algorithms offered by the server, see
+// https://datatracker.ietf.org/doc/html/rfc4253#section-7.1
+func (s *Tester) parseKexInit(payload []byte) error {
+	if len(payload) < 17 || payload[0] != msgKexInit {
+		return errors.New("target did not send a key exchange init message")
+	}
+
+	// skip the message type and the cookie
+	rest := payload[17:]
+	lists := make([][]string, 8)
+	for i := range lists {
+		var list []string
+		var err error
+		list, rest, err = parseNameList(rest)
+		if err != nil {
+			return err
+		}
+		lists[i] = list
+	}
+
+	s.Findings.KexAlgorithms = lists[0]
+	s.Findings.HostKeyAlgorithms = lists[1]
+	s.Findings.Ciphers = lists[3]
+	s.Findings.MACs = lists[5]
+	s.Findings.Compressions = lists[7]
+	return nil
+}
+
+func parseNameList(data []byte) ([]string, []byte, error) {
+	if len(data) < 4 {
+		return nil, nil, errors.New("invalid name-list in key exchange init message")
+	}
+	length := binary.BigEndian.Uint32(data)
+	data = data[4:]
+	if uint32(len(data)) < length {
+		return nil, nil, errors.New("invalid name-list in key exchange init message")
+	}
+
+	res := []string{}
+	if length > 0 {
+		res = strings.Split(string(data[:length]), ",")
+	}
+	return res, data[length:], nil
+}
+
+// testHostKey completes a key exchange with the given host key algorithm and
+// returns the host key of the server. The connection is closed before any
+// authentication takes place.
+func (s *Tester) testHostKey(algo string) (ssh.PublicKey, error) {
+	conn, err := net.DialTimeout(s.proto, s.target, s.timeout)
+	if err != nil {
+		return nil, errors.New("failed to connect to target: " + err.Error())
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(s.timeout)); err != nil {
+		return nil, err
+	}
+
+	var hostKey ssh.PublicKey
+	config := &ssh.ClientConfig{
+		User:              "cnquery",
+		ClientVersion:     clientIdentification,
+		HostKeyAlgorithms: []string{algo},
+		HostKeyCallback: func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+			hostKey = key
+			return errHostKeyCaptured
+		},
+		Timeout: s.timeout,
+	}
+
+	_, _, _, err = ssh.NewClientConn(conn, s.target, config)
+	if hostKey != nil {
+		return hostKey, nil
+	}
+	if err == nil {
+		err = errors.New("no host key received")
+	}
+	return nil, err
+}
@@ -0,0 +1,78 @@
+package sshshake
+
+import (
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/providers/network/resources/sshshake/sshtest"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestSshShake(t *testing.T) {
+	config, keys := sshtest.NewServerConfig(t)
+	host, port := sshtest.StartServer(t, config)
+
+	tester := New("tcp", host, port)
+	require.NoError(t, tester.Test())
+
+	findings := tester.Findings
+	assert.Equal(t, "SSH-2.0-OpenSSH_9.3p1 Debian-1", findings.Identification)
+	assert.Equal(t, "2.0", findings.ProtocolVersion)
+	assert.Equal(t, "OpenSSH_9.3p1", findings.SoftwareVersion)
+	assert.Equal(t, "Debian-1", findings.Comments)
+
+	assert.Contains(t, findings.KexAlgorithms, "curve25519-sha256")
+	assert.Contains(t, findings.KexAlgorithms, "ecdh-sha2-nistp256")
+	assert.Equal(t, []string{"aes128-gcm@openssh.com", "aes256-ctr"}, findings.Ciphers)
+	assert.Equal(t, []string{"hmac-sha2-256-etm@openssh.com", "hmac-sha2-512"}, findings.MACs)
+	assert.Equal(t, []string{"none"}, findings.Compressions)
+	assert.ElementsMatch(t, []string{ssh.KeyAlgoED25519, ssh.KeyAlgoECDSA256}, findings.HostKeyAlgorithms)
+
+	require.Len(t, findings.HostKeys, 2)
+	for _, key := range keys {
+		found := false
+		for _, hostKey := range findings.HostKeys {
+			if hostKey.FingerprintSHA256 == ssh.FingerprintSHA256(key) {
+				found = true
+				assert.Equal(t, key.Type(), hostKey.Type)
+				assert.Equal(t, ssh.FingerprintLegacyMD5(key), hostKey.FingerprintMD5)
+			}
+		}
+		assert.True(t, found, "host key "+key.Type())
+	}
+	assert.Empty(t, findings.Errors)
+}
+
+func TestSshShakeNoSshServer(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		conn.Write([]byte("220 mail.example.com ESMTP\r\n"))
+		conn.Close()
+	}()
+
+	host, port, _ := net.SplitHostPort(l.Addr().String())
+	p, _ := strconv.Atoi(port)
+	assert.Error(t, New("tcp", host, p).Test())
+}
+
+func TestParseIdentification(t *testing.T) {
+	protocol, software, comments := ParseIdentification("SSH-2.0-OpenSSH_8.9p1 Ubuntu-3ubuntu0.1")
+	assert.Equal(t, "2.0", protocol)
+	assert.Equal(t, "OpenSSH_8.9p1", software)
+	assert.Equal(t, "Ubuntu-3ubuntu0.1", comments)
+
+	protocol, software, comments = ParseIdentification("SSH-1.99-Cisco-1.25")
+	assert.Equal(t, "1.99", protocol)
+	assert.Equal(t, "Cisco-1.25", software)
+	assert.Equal(t, "", comments)
+}
@@ -0,0 +1,73 @@
+// Package sshtest provides an in-process ssh server for tests
+package sshtest
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+// StartServer runs an in-process ssh server which never accepts any authentication
+func StartServer(t testing.TB, config *ssh.ServerConfig) (string, int) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				ssh.NewServerConn(conn, config)
+			}()
+		}
+	}()
+
+	host, port, err := net.SplitHostPort(l.Addr().String())
+	require.NoError(t, err)
+	p, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	return host, p
+}
+
+// NewServerConfig returns a server config with a fixed set of algorithms and
+// freshly generated ed25519 and ecdsa host keys, in that order
+func NewServerConfig(t testing.TB) (*ssh.ServerConfig, []ssh.PublicKey) {
+	config := &ssh.ServerConfig{
+		ServerVersion: "SSH-2.0-OpenSSH_9.3p1 Debian-1",
+		PasswordCallback: func(conn ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			return nil, errors.New("access denied")
+		},
+		Config: ssh.Config{
+			KeyExchanges: []string{"curve25519-sha256", "ecdh-sha2-nistp256"},
+			Ciphers:      []string{"aes128-gcm@openssh.com", "aes256-ctr"},
+			MACs:         []string{"hmac-sha2-256-etm@openssh.com", "hmac-sha2-512"},
+		},
+	}
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	keys := []ssh.PublicKey{}
+	for _, key := range []interface{}{edKey, ecKey} {
+		signer, err := ssh.NewSignerFromKey(key)
+		require.NoError(t, err)
+		config.AddHostKey(signer)
+		keys = append(keys, signer.PublicKey())
+	}
+
+	return config, keys
+}
@@ -3,11 +3,9 @@ package resources
 import (
 	"crypto/x509"
 	"regexp"
-	"strconv"
 	"sync"
 	"time"
 
-	"github.com/cockroachdb/errors"
 	"go.mondoo.com/cnquery/llx"
 	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
 	"go.mondoo.com/cnquery/providers/core/resources/regex"
@@ -17,8 +15,6 @@ import (
 	"go.mondoo.com/cnquery/types"
 )
 
-var reTarget = regexp.MustCompile("([^/:]+?)(:\\d+)?$")
-
 var rexUrlDomain = regexp.MustCompile(regex.UrlDomain)
 
 func initTls(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
@@ -33,23 +29,13 @@ func initTls(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]
 	}
 
 	if target, ok := args["target"]; ok {
-		m := reTarget.FindStringSubmatch(target.Value.(string))
-		if len(m) == 0 {
-			return nil, nil, errors.New("target must be provided in the form of: tcp://target:port, udp://target:port, or target:port (defaults to tcp)")
-		}
-
 		proto := "tcp"
 
-		var port int64 = 443
-		if len(m[2]) != 0 {
-			rawPort, err := strconv.ParseUint(m[2][1:], 10, 64)
-			if err != nil {
-				return nil, nil, errors.New("failed to parse port: " + m[2])
-			}
-			port = int64(rawPort)
+		address, port, err := parseTarget(target.Value.(string), 443)
+		if err != nil {
+			return nil, nil, err
 		}
 
-		address := m[1]
 		domainName := ""
 		if rexUrlDomain.MatchString(address) {
 			domainName = address
@@ -85,6 +85,12 @@ var Config = plugin.Provider{
 			MinArgs: 1,
 			MaxArgs: 1,
 			Flags: []plugin.Flag{
+				{
+					Long:    "https",
+					Default: "false",
+					Desc:    "Connect via HTTPS, on port 5986 unless a port is given.",
+					Type:    plugin.FlagType_Bool,
+				},
 				{
 					Long:    "insecure",
 					Default: "false",
@@ -2,6 +2,7 @@ package connection
 
 import (
 	"bytes"
+	"context"
 	"os/exec"
 	"runtime"
 	"strings"
@@ -19,6 +20,7 @@ const (
 )
 
 type LocalConnection struct {
+	shared.RequestContext
 	shell   []string
 	fs      afero.Fs
 	Sudo    *shared.Sudo
@@ -70,7 +72,7 @@ func (p *LocalConnection) RunCommand(command string) (*shared.Command, error) {
 	if p.Sudo != nil {
 		command = p.Sudo.Build(command)
 	}
-	c := &commandRunner{Shell: p.shell}
+	c := &commandRunner{Shell: p.shell, ctx: p.Context()}
 	args := []string{}
 
 	res, err := c.Exec(command, args)
@@ -86,7 +88,7 @@ func (p *LocalConnection) FileSystem() afero.Fs {
 		// p.fs = cat.New(p)
 		panic("NOT MIGRATED")
 	} else {
-		p.fs = afero.NewOsFs()
+		p.fs = shared.NewContextFs(afero.NewOsFs(), p.Context)
 	}
 
 	return p.fs
@@ -119,6 +121,8 @@ type commandRunner struct {
 	shared.Command
 	cmdExecutor *exec.Cmd
 	Shell       []string
+	// ctx stops the command once it is done
+	ctx context.Context
 }
 
 func (c *commandRunner) Exec(usercmd string, args []string) (*shared.Command, error) {
@@ -139,7 +143,13 @@ func (c *commandRunner) Exec(usercmd string, args []string) (*shared.Command, er
 
 	// this only stores the user command, not the shell
 	c.Command.Command = usercmd + " " + strings.Join(args, " ")
-	c.cmdExecutor = exec.Command(cmd, cmdArgs...)
+	ctx := c.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	c.cmdExecutor = exec.CommandContext(ctx, cmd, cmdArgs...)
+	// child processes of a killed shell may keep its output open
+	c.cmdExecutor.WaitDelay = time.Second
 
 	var stdoutBuffer bytes.Buffer
 	var stderrBuffer bytes.Buffer
@@ -154,6 +164,11 @@ func (c *commandRunner) Exec(usercmd string, args []string) (*shared.Command, er
 	err := c.cmdExecutor.Run()
 	c.Command.Stats.Duration = time.Since(c.Command.Stats.Start)
 
+	// the command was killed because the request was cancelled
+	if ctxErr := shared.ContextErr(ctx); ctxErr != nil {
+		return &c.Command, ctxErr
+	}
+
 	// command completed successfully, great :-)
 	if err == nil {
 		return &c.Command, nil
@@ -0,0 +1,32 @@
+//go:build !windows
+// +build !windows
+
+package connection
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+)
+
+func TestLocalConnection_RunCommandContext(t *testing.T) {
+	conn := NewLocalConnection(1, &inventory.Asset{})
+
+	cmd, err := conn.RunCommand("echo hello")
+	require.NoError(t, err)
+	assert.Equal(t, 0, cmd.ExitStatus)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	release := conn.Use(ctx)
+	defer release()
+
+	start := time.Now()
+	_, err = conn.RunCommand("sleep 10")
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
@@ -0,0 +1,167 @@
+package shared
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"github.com/spf13/afero"
+)
+
+// RequestContext tracks the requests that currently use a connection.
+// Connections embed it to stop their commands and file reads once all
+// requests that use them were cancelled, ran into their deadline or
+// finished. Requests run concurrently, so a single hung request doesn't
+// block others.
+type RequestContext struct {
+	lock   sync.Mutex
+	users  int
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+}
+
+// Use marks the connection as used by a request, until the request's
+// context is done or release is called
+func (r *RequestContext) Use(ctx context.Context) (release func()) {
+	r.lock.Lock()
+	if r.users == 0 {
+		r.ctx, r.cancel = context.WithCancelCause(context.Background())
+	}
+	r.users++
+	r.lock.Unlock()
+
+	done := make(chan struct{})
+	var once sync.Once
+	leave := func(cause error) {
+		once.Do(func() {
+			close(done)
+			r.lock.Lock()
+			defer r.lock.Unlock()
+			r.users--
+			if r.users == 0 {
+				// commands of the last request stop with its error, e.g.
+				// its deadline
+				r.cancel(cause)
+			}
+		})
+	}
+
+	if ctx.Done() != nil {
+		go func() {
+			select {
+			case <-ctx.Done():
+				leave(ctx.Err())
+			case <-done:
+			}
+		}()
+	}
+	return func() { leave(ctx.Err()) }
+}
+
+// Context returns the context for commands and file operations of the
+// connection. It is done once no request uses the connection anymore.
+func (r *RequestContext) Context() context.Context {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.users == 0 {
+		return context.Background()
+	}
+	return r.ctx
+}
+
+// ContextErr returns the reason why the context is done, e.g. the deadline
+// of the request that used the connection last
+func ContextErr(ctx context.Context) error {
+	if ctx.Err() == nil {
+		return nil
+	}
+	return context.Cause(ctx)
+}
+
+// ContextFs stops file operations once the context of the requests that
+// use the filesystem is done. Files keep the context they were opened with.
+// Reads that already block, e.g. on an unresponsive network mount, cannot
+// be interrupted, but no further operations are started.
+type ContextFs struct {
+	afero.Fs
+	ctx func() context.Context
+}
+
+func NewContextFs(fs afero.Fs, ctx func() context.Context) *ContextFs {
+	return &ContextFs{Fs: fs, ctx: ctx}
+}
+
+func (c *ContextFs) Open(name string) (afero.File, error) {
+	ctx := c.ctx()
+	if err := ContextErr(ctx); err != nil {
+		return nil, err
+	}
+	f, err := c.Fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return &contextFile{File: f, ctx: ctx}, nil
+}
+
+func (c *ContextFs) OpenFile(name string, flag int, perm os.FileMode) (afero.File, error) {
+	ctx := c.ctx()
+	if err := ContextErr(ctx); err != nil {
+		return nil, err
+	}
+	f, err := c.Fs.OpenFile(name, flag, perm)
+	if err != nil {
+		return nil, err
+	}
+	return &contextFile{File: f, ctx: ctx}, nil
+}
+
+func (c *ContextFs) Stat(name string) (os.FileInfo, error) {
+	if err := ContextErr(c.ctx()); err != nil {
+		return nil, err
+	}
+	return c.Fs.Stat(name)
+}
+
+func (c *ContextFs) LstatIfPossible(name string) (os.FileInfo, bool, error) {
+	if err := ContextErr(c.ctx()); err != nil {
+		return nil, false, err
+	}
+	if lstater, ok := c.Fs.(afero.Lstater); ok {
+		return lstater.LstatIfPossible(name)
+	}
+	fi, err := c.Fs.Stat(name)
+	return fi, false, err
+}
+
+type contextFile struct {
+	afero.File
+	ctx context.Context
+}
+
+func (f *contextFile) Read(p []byte) (int, error) {
+	if err := ContextErr(f.ctx); err != nil {
+		return 0, err
+	}
+	return f.File.Read(p)
+}
+
+func (f *contextFile) ReadAt(p []byte, off int64) (int, error) {
+	if err := ContextErr(f.ctx); err != nil {
+		return 0, err
+	}
+	return f.File.ReadAt(p, off)
+}
+
+func (f *contextFile) Readdir(count int) ([]os.FileInfo, error) {
+	if err := ContextErr(f.ctx); err != nil {
+		return nil, err
+	}
+	return f.File.Readdir(count)
+}
+
+func (f *contextFile) Readdirnames(n int) ([]string, error) {
+	if err := ContextErr(f.ctx); err != nil {
+		return nil, err
+	}
+	return f.File.Readdirnames(n)
+}
@@ -0,0 +1,79 @@
+package shared
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestContext(t *testing.T) {
+	var reqCtx RequestContext
+	assert.Equal(t, context.Background(), reqCtx.Context())
+
+	t.Run("done once all requests are done", func(t *testing.T) {
+		first, cancel := context.WithCancel(context.Background())
+		releaseFirst := reqCtx.Use(first)
+		releaseSecond := reqCtx.Use(context.Background())
+		ctx := reqCtx.Context()
+
+		cancel()
+		releaseFirst()
+		assert.NoError(t, ctx.Err())
+
+		releaseSecond()
+		assert.Equal(t, context.Canceled, ContextErr(ctx))
+		assert.Equal(t, context.Background(), reqCtx.Context())
+	})
+
+	t.Run("stops with the deadline of the last request", func(t *testing.T) {
+		req, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		release := reqCtx.Use(req)
+		defer release()
+
+		ctx := reqCtx.Context()
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Second):
+			t.Fatal("context wasn't done after the request's deadline")
+		}
+		assert.Equal(t, context.DeadlineExceeded, ContextErr(ctx))
+	})
+}
+
+func TestContextFs(t *testing.T) {
+	mem := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(mem, "/etc/hostname", []byte("example"), 0o644))
+
+	var reqCtx RequestContext
+	fs := NewContextFs(mem, reqCtx.Context)
+
+	data, err := afero.ReadFile(fs, "/etc/hostname")
+	require.NoError(t, err)
+	assert.Equal(t, "example", string(data))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	release := reqCtx.Use(ctx)
+	defer release()
+
+	f, err := fs.Open("/etc/hostname")
+	require.NoError(t, err)
+	defer f.Close()
+
+	// files stop once the request they were opened by is done
+	cancel()
+	buf := make([]byte, 3)
+	require.Eventually(t, func() bool {
+		_, err := f.ReadAt(buf, 0)
+		return err == context.Canceled
+	}, time.Second, time.Millisecond)
+
+	// the next request can use the filesystem again
+	data, err = afero.ReadFile(fs, "/etc/hostname")
+	require.NoError(t, err)
+	assert.Equal(t, "example", string(data))
+}
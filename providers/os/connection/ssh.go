@@ -20,6 +20,7 @@ import (
 	"github.com/spf13/afero"
 	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
 	"go.mondoo.com/cnquery/providers-sdk/v1/vault"
+	"go.mondoo.com/cnquery/providers-sdk/v1/vault/credentials_resolver"
 	"go.mondoo.com/cnquery/providers/os/connection/shared"
 	"go.mondoo.com/cnquery/providers/os/connection/ssh/awsinstanceconnect"
 	"go.mondoo.com/cnquery/providers/os/connection/ssh/awsssmsession"
@@ -36,6 +37,7 @@ const (
 )
 
 type SshConnection struct {
+	shared.RequestContext
 	fs    afero.Fs
 	Sudo  *shared.Sudo
 	id    uint32
@@ -170,10 +172,28 @@ func (c *SshConnection) runRawCommand(command string) (*shared.Command, error) {
 	}
 	defer session.Close()
 
+	// stop the command once the request is cancelled
+	ctx := c.Context()
+	if ctx.Done() != nil {
+		finished := make(chan struct{})
+		defer close(finished)
+		go func() {
+			select {
+			case <-ctx.Done():
+				session.Signal(ssh.SIGKILL)
+				session.Close()
+			case <-finished:
+			}
+		}()
+	}
+
 	// start ssh call
 	session.Stdout = res.Stdout
 	session.Stderr = res.Stderr
 	err = session.Run(res.Command)
+	if ctxErr := shared.ContextErr(ctx); ctxErr != nil {
+		return &res, ctxErr
+	}
 	if err == nil {
 		return &res, nil
 	}
@@ -224,7 +244,7 @@ func (c *SshConnection) FileSystem() afero.Fs {
 			// enable fallback
 			c.UseScpFilesystem = true
 		} else {
-			c.fs = fs
+			c.fs = shared.NewContextFs(fs, c.Context)
 			return c.fs
 		}
 	}
@@ -475,6 +495,10 @@ func establishClientConnection(pCfg *inventory.Config, hostKeyCallback ssh.HostK
 		Auth:            authMethods,
 		HostKeyCallback: hostKeyCallback,
 	})
+	// report rejected credentials, so that they can be resolved again, e.g. after a rotation
+	if err != nil && strings.Contains(err.Error(), "unable to authenticate") {
+		err = credentials_resolver.NewAuthError(err)
+	}
 	return conn, closer, err
 }
 
@@ -0,0 +1,222 @@
+package connection
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/masterzen/winrm"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/providers-sdk/v1/vault"
+	"go.mondoo.com/cnquery/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/providers/os/connection/winrm/cat"
+	"go.mondoo.com/cnquery/providers/os/resources/powershell"
+	"go.mondoo.com/cnquery/utils/multierr"
+)
+
+const (
+	Winrm shared.ConnectionType = "winrm"
+
+	// default ports for WinRM over http and https
+	winrmHTTPPort  = 5985
+	winrmHTTPSPort = 5986
+)
+
+type WinrmConnection struct {
+	shared.RequestContext
+	fs    afero.Fs
+	id    uint32
+	conf  *inventory.Config
+	asset *inventory.Asset
+
+	Endpoint *winrm.Endpoint
+	Client   *winrm.Client
+}
+
+func NewWinrmConnection(id uint32, conf *inventory.Config, asset *inventory.Asset) (*WinrmConnection, error) {
+	var user, password string
+	for i := range conf.Credentials {
+		cred := conf.Credentials[i]
+		if cred.Type == vault.CredentialType_password {
+			user = cred.User
+			password = string(cred.Secret)
+			break
+		}
+	}
+	if user == "" {
+		return nil, errors.New("winrm connection requires a user and password")
+	}
+
+	// WinRM listens for https connections on a dedicated port, but hosts
+	// may be configured to use any port for either
+	useHTTPS := conf.Options["winrm_https"] == "true"
+	port := int(conf.Port)
+	if port == 0 {
+		port = winrmHTTPPort
+		if useHTTPS {
+			port = winrmHTTPSPort
+		}
+	}
+
+	endpoint := winrm.NewEndpoint(conf.Host, port, useHTTPS, conf.Insecure, nil, nil, nil, 0)
+
+	params := winrm.DefaultParameters
+	// Windows hosts only accept NTLM by default, basic auth is disabled
+	params.TransportDecorator = func() winrm.Transporter { return &winrm.ClientNTLM{} }
+
+	client, err := winrm.NewClientWithParameters(endpoint, user, password, params)
+	if err != nil {
+		return nil, multierr.Wrap(err, "failed to create winrm client")
+	}
+
+	// verify that we can reach the host and authenticate
+	shell, err := client.CreateShell()
+	if err != nil {
+		log.Debug().Err(err).Str("provider", "winrm").Str("host", conf.Host).Int("port", port).Bool("https", useHTTPS).Msg("could not establish winrm session")
+		return nil, multierr.Wrap(err, "failed to connect via winrm")
+	}
+	if err := shell.Close(); err != nil {
+		log.Debug().Err(err).Str("provider", "winrm").Msg("could not close winrm shell")
+	}
+
+	return &WinrmConnection{
+		id:       id,
+		conf:     conf,
+		asset:    asset,
+		Endpoint: endpoint,
+		Client:   client,
+	}, nil
+}
+
+func (c *WinrmConnection) ID() uint32 {
+	return c.id
+}
+
+func (c *WinrmConnection) Name() string {
+	return "winrm"
+}
+
+func (c *WinrmConnection) Type() shared.ConnectionType {
+	return Winrm
+}
+
+func (c *WinrmConnection) Asset() *inventory.Asset {
+	return c.asset
+}
+
+func (c *WinrmConnection) Capabilities() shared.Capabilities {
+	return shared.Capability_File | shared.Capability_RunCommand
+}
+
+// RunCommand runs the command in PowerShell, which is the same behavior we
+// have for local connections on Windows
+func (c *WinrmConnection) RunCommand(command string) (*shared.Command, error) {
+	log.Debug().Str("command", command).Str("provider", "winrm").Msg("run command")
+
+	res := shared.Command{
+		Command: command,
+		Stats: shared.PerfStats{
+			Start: time.Now(),
+		},
+		Stdout: &bytes.Buffer{},
+		Stderr: &bytes.Buffer{},
+	}
+	defer func() {
+		res.Stats.Duration = time.Since(res.Stats.Start)
+	}()
+
+	ctx := c.Context()
+	if err := shared.ContextErr(ctx); err != nil {
+		return nil, err
+	}
+
+	exitCode, err := c.run(ctx, powershell.Encode(command), res.Stdout, res.Stderr)
+	if err != nil {
+		return nil, err
+	}
+	res.ExitStatus = exitCode
+
+	return &res, nil
+}
+
+// run is like winrm.Client.Run, but stops the command once ctx is done.
+// The client can't cancel its requests, so we don't wait for the remote
+// command to terminate but return right away.
+func (c *WinrmConnection) run(ctx context.Context, command string, stdout io.Writer, stderr io.Writer) (int, error) {
+	shell, err := c.Client.CreateShell()
+	if err != nil {
+		return 1, err
+	}
+	cmd, err := shell.Execute(command)
+	if err != nil {
+		shell.Close()
+		return 1, err
+	}
+
+	// both streams are closed once the command finished or failed
+	var wg sync.WaitGroup
+	var stdoutErr, stderrErr error
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_, stdoutErr = io.Copy(stdout, cmd.Stdout)
+	}()
+	go func() {
+		defer wg.Done()
+		_, stderrErr = io.Copy(stderr, cmd.Stderr)
+	}()
+	finished := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+	case <-ctx.Done():
+		go func() {
+			cmd.Close()
+			shell.Close()
+		}()
+		return 1, shared.ContextErr(ctx)
+	}
+
+	cmd.Wait()
+	cmd.Close()
+	shell.Close()
+	if stdoutErr != nil {
+		return 1, stdoutErr
+	}
+	return cmd.ExitCode(), stderrErr
+}
+
+func (c *WinrmConnection) FileSystem() afero.Fs {
+	if c.fs == nil {
+		c.fs = cat.New(c)
+	}
+	return c.fs
+}
+
+func (c *WinrmConnection) FileInfo(path string) (shared.FileInfoDetails, error) {
+	fs := c.FileSystem()
+	afs := &afero.Afero{Fs: fs}
+	stat, err := afs.Stat(path)
+	if err != nil {
+		return shared.FileInfoDetails{}, err
+	}
+
+	return shared.FileInfoDetails{
+		Mode: shared.FileModeDetails{FileMode: stat.Mode()},
+		Size: stat.Size(),
+		Uid:  -1,
+		Gid:  -1,
+	}, nil
+}
+
+// Close is a no-op for WinRM, every command runs in its own shell
+func (c *WinrmConnection) Close() {}
@@ -0,0 +1,155 @@
+package cat
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/providers/os/resources/powershell"
+)
+
+// CommandRunner runs PowerShell scripts on the target
+type CommandRunner interface {
+	RunCommand(command string) (*shared.Command, error)
+}
+
+// New creates a read-only file system that reads files via PowerShell
+func New(cmdRunner CommandRunner) *Fs {
+	return &Fs{
+		commandRunner: cmdRunner,
+	}
+}
+
+type Fs struct {
+	commandRunner CommandRunner
+}
+
+func (cat *Fs) Name() string {
+	return "PowerShell Cat FS"
+}
+
+// EscapePath quotes a path for use in a PowerShell script
+func EscapePath(path string) string {
+	return "'" + strings.ReplaceAll(path, "'", "''") + "'"
+}
+
+const statScript = "Get-Item -Force -LiteralPath %s | Select-Object Name, Length, Mode, LastWriteTimeUtc, PSIsContainer | ConvertTo-Json"
+
+type statInfo struct {
+	Name             string
+	Length           int64
+	Mode             string
+	LastWriteTimeUtc string
+	PSIsContainer    bool
+}
+
+func (cat *Fs) runScript(script string) ([]byte, error) {
+	cmd, err := cat.commandRunner.RunCommand(script)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(cmd.Stdout)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.ExitStatus != 0 {
+		stderr, _ := io.ReadAll(cmd.Stderr)
+		return nil, errors.New("powershell script failed: " + strings.TrimSpace(string(stderr)))
+	}
+
+	return data, nil
+}
+
+func (cat *Fs) Open(name string) (afero.File, error) {
+	_, err := cat.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFile(cat, name), nil
+}
+
+func (cat *Fs) Stat(name string) (os.FileInfo, error) {
+	data, err := cat.runScript(fmt.Sprintf(statScript, EscapePath(name)))
+	if err != nil || len(strings.TrimSpace(string(data))) == 0 {
+		return nil, &os.PathError{Op: "stat", Path: name, Err: os.ErrNotExist}
+	}
+
+	var info statInfo
+	if err := json.Unmarshal(data, &info); err != nil {
+		return nil, errors.Wrap(err, "could not parse file information for "+name)
+	}
+
+	// Windows does not have unix permissions, so we only approximate them
+	// based on the directory and read-only attributes
+	mode := os.FileMode(0o644)
+	if strings.Contains(info.Mode, "r") {
+		mode = 0o444
+	}
+	if info.PSIsContainer {
+		mode = os.ModeDir | 0o755
+	}
+
+	var modTime time.Time
+	if ts := powershell.PSJsonTimestamp(info.LastWriteTimeUtc); ts != nil {
+		modTime = *ts
+	}
+
+	return &shared.FileInfo{
+		FName:    info.Name,
+		FSize:    info.Length,
+		FIsDir:   info.PSIsContainer,
+		FModTime: modTime,
+		FMode:    mode,
+		Uid:      -1,
+		Gid:      -1,
+	}, nil
+}
+
+func (cat *Fs) Create(name string) (afero.File, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (cat *Fs) Mkdir(name string, perm os.FileMode) error {
+	return errors.New("not implemented")
+}
+
+func (cat *Fs) MkdirAll(path string, perm os.FileMode) error {
+	return errors.New("not implemented")
+}
+
+func (cat *Fs) OpenFile(name string, flag int, perm os.FileMode) (afero.File, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (cat *Fs) Remove(name string) error {
+	return errors.New("not implemented")
+}
+
+func (cat *Fs) RemoveAll(path string) error {
+	return errors.New("not implemented")
+}
+
+func (cat *Fs) Rename(oldname, newname string) error {
+	return errors.New("not implemented")
+}
+
+func (cat *Fs) Chmod(name string, mode os.FileMode) error {
+	return errors.New("not implemented")
+}
+
+func (cat *Fs) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	return errors.New("not implemented")
+}
+
+func (cat *Fs) Chown(name string, uid, gid int) error {
+	return errors.New("not implemented")
+}
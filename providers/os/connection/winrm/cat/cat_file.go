@@ -0,0 +1,108 @@
+package cat
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+func NewFile(catfs *Fs, path string) *File {
+	return &File{catfs: catfs, path: path}
+}
+
+type File struct {
+	catfs *Fs
+	buf   *bytes.Buffer
+	path  string
+}
+
+func (f *File) readContent() (*bytes.Buffer, error) {
+	// we transfer the content base64 encoded to retain binary data
+	script := "[Convert]::ToBase64String([IO.File]::ReadAllBytes(" + EscapePath(f.path) + "))"
+	data, err := f.catfs.runScript(script)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode base64 data stream")
+	}
+
+	return bytes.NewBuffer(data), nil
+}
+
+func (f *File) Close() error {
+	return nil
+}
+
+func (f *File) Name() string {
+	return f.path
+}
+
+func (f *File) Stat() (os.FileInfo, error) {
+	return f.catfs.Stat(f.path)
+}
+
+func (f *File) Sync() error {
+	return nil
+}
+
+func (f *File) Truncate(size int64) error {
+	return nil
+}
+
+func (f *File) Read(b []byte) (n int, err error) {
+	if f.buf == nil {
+		bufData, err := f.readContent()
+		if err != nil {
+			return 0, err
+		}
+		f.buf = bufData
+	}
+	return f.buf.Read(b)
+}
+
+func (f *File) ReadAt(b []byte, off int64) (n int, err error) {
+	return 0, errors.New("not implemented")
+}
+
+func (f *File) Readdir(count int) (res []os.FileInfo, err error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *File) Readdirnames(n int) (names []string, err error) {
+	// TODO: input n is ignored
+	data, err := f.catfs.runScript("Get-ChildItem -Force -Name -LiteralPath " + EscapePath(f.path))
+	if err != nil {
+		return nil, err
+	}
+
+	list := []string{}
+	for _, line := range strings.Split(string(data), "\n") {
+		name := strings.TrimSpace(line)
+		if name != "" {
+			list = append(list, name)
+		}
+	}
+	return list, nil
+}
+
+func (f *File) Seek(offset int64, whence int) (int64, error) {
+	return 0, errors.New("not implemented")
+}
+
+func (f *File) Write(b []byte) (n int, err error) {
+	return 0, errors.New("not implemented")
+}
+
+func (f *File) WriteAt(b []byte, off int64) (n int, err error) {
+	return 0, errors.New("not implemented")
+}
+
+func (f *File) WriteString(s string) (ret int, err error) {
+	return 0, errors.New("not implemented")
+}
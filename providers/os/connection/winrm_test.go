@@ -0,0 +1,275 @@
+package connection
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/providers-sdk/v1/vault"
+	"golang.org/x/text/encoding/unicode"
+)
+
+const (
+	wsmanShellURI   = "http://schemas.microsoft.com/wbem/wsman/1/windows/shell"
+	wsmanEnvelope   = `<s:Envelope xml:lang="en-US" xmlns:s="http://www.w3.org/2003/05/soap-envelope" xmlns:a="http://schemas.xmlsoap.org/ws/2004/08/addressing" xmlns:x="http://schemas.xmlsoap.org/ws/2004/09/transfer" xmlns:w="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd" xmlns:rsp="http://schemas.microsoft.com/wbem/wsman/1/windows/shell"><s:Header><a:Action>%s</a:Action></s:Header><s:Body>%s</s:Body></s:Envelope>`
+	wsmanShellID    = "67A74734-DD32-4F10-89DE-49A060483810"
+	winIniContent   = "; for 16-bit app support\r\n[fonts]\r\n"
+	winIniStatReply = `{"Name":"win.ini","Length":36,"Mode":"-a----","LastWriteTimeUtc":"\/Date(1688045264000)\/","PSIsContainer":false}`
+)
+
+var (
+	cdataCommand = regexp.MustCompile(`<!\[CDATA\[(.*?)\]\]>`)
+	commandIDRef = regexp.MustCompile(`CommandId="([^"]+)"`)
+)
+
+type wsmanResult struct {
+	stdout   string
+	stderr   string
+	exitCode int
+}
+
+// wsmanServer is a minimal stand-in for the WS-Management service on Windows.
+// It decodes the PowerShell scripts we send and replies with canned output.
+type wsmanServer struct {
+	mu       sync.Mutex
+	scripts  []string
+	commands map[string]wsmanResult
+	handle   func(script string) wsmanResult
+	// hang blocks all requests for command output until it is closed
+	hang chan struct{}
+}
+
+func newWsmanServer() *wsmanServer {
+	return &wsmanServer{
+		commands: map[string]wsmanResult{},
+		handle:   windowsHost,
+	}
+}
+
+func (s *wsmanServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	raw, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	body := string(raw)
+
+	if s.hang != nil && strings.Contains(body, "/shell/Receive<") {
+		<-s.hang
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/soap+xml;charset=UTF-8")
+	switch {
+	case strings.Contains(body, "/transfer/Create<"):
+		fmt.Fprintf(w, wsmanEnvelope, "http://schemas.xmlsoap.org/ws/2004/09/transfer/CreateResponse",
+			`<rsp:Shell><rsp:ShellId>`+wsmanShellID+`</rsp:ShellId></rsp:Shell>`)
+
+	case strings.Contains(body, "/shell/Command<"):
+		m := cdataCommand.FindStringSubmatch(body)
+		if m == nil {
+			http.Error(w, "missing command", http.StatusBadRequest)
+			return
+		}
+		script, err := decodeEncodedCommand(m[1])
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.scripts = append(s.scripts, script)
+		id := strconv.Itoa(len(s.scripts))
+		s.commands[id] = s.handle(script)
+		fmt.Fprintf(w, wsmanEnvelope, wsmanShellURI+"/CommandResponse",
+			`<rsp:CommandResponse><rsp:CommandId>`+id+`</rsp:CommandId></rsp:CommandResponse>`)
+
+	case strings.Contains(body, "/shell/Receive<"):
+		m := commandIDRef.FindStringSubmatch(body)
+		if m == nil {
+			http.Error(w, "missing command id", http.StatusBadRequest)
+			return
+		}
+		res := s.commands[m[1]]
+		streams := ""
+		if res.stdout != "" {
+			streams += `<rsp:Stream Name="stdout" CommandId="` + m[1] + `">` + base64.StdEncoding.EncodeToString([]byte(res.stdout)) + `</rsp:Stream>`
+		}
+		if res.stderr != "" {
+			streams += `<rsp:Stream Name="stderr" CommandId="` + m[1] + `">` + base64.StdEncoding.EncodeToString([]byte(res.stderr)) + `</rsp:Stream>`
+		}
+		fmt.Fprintf(w, wsmanEnvelope, wsmanShellURI+"/ReceiveResponse",
+			`<rsp:ReceiveResponse>`+streams+`<rsp:CommandState CommandId="`+m[1]+`" State="`+wsmanShellURI+`/CommandState/Done"><rsp:ExitCode>`+strconv.Itoa(res.exitCode)+`</rsp:ExitCode></rsp:CommandState></rsp:ReceiveResponse>`)
+
+	default:
+		// signal and delete requests only need an empty acknowledgement
+		fmt.Fprintf(w, wsmanEnvelope, "", "")
+	}
+}
+
+// decodeEncodedCommand reverses powershell.Encode
+func decodeEncodedCommand(cmd string) (string, error) {
+	const prefix = "powershell.exe -NoProfile -EncodedCommand "
+	if !strings.HasPrefix(cmd, prefix) {
+		return "", fmt.Errorf("unexpected command: %s", cmd)
+	}
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(cmd, prefix))
+	if err != nil {
+		return "", err
+	}
+	script, err := unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM).NewDecoder().String(string(data))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimPrefix(script, "$ProgressPreference='SilentlyContinue';"), nil
+}
+
+func windowsHost(script string) wsmanResult {
+	switch script {
+	case "Write-Output 'hello'":
+		return wsmanResult{stdout: "hello\r\n"}
+	case "Get-Item -Force -LiteralPath 'C:\\Windows\\win.ini' | Select-Object Name, Length, Mode, LastWriteTimeUtc, PSIsContainer | ConvertTo-Json":
+		return wsmanResult{stdout: winIniStatReply}
+	case "Get-Item -Force -LiteralPath 'C:\\Windows' | Select-Object Name, Length, Mode, LastWriteTimeUtc, PSIsContainer | ConvertTo-Json":
+		return wsmanResult{stdout: `{"Name":"Windows","Length":null,"Mode":"d-----","LastWriteTimeUtc":"\/Date(1688045264000)\/","PSIsContainer":true}`}
+	case "[Convert]::ToBase64String([IO.File]::ReadAllBytes('C:\\Windows\\win.ini'))":
+		return wsmanResult{stdout: base64.StdEncoding.EncodeToString([]byte(winIniContent)) + "\r\n"}
+	case "Get-ChildItem -Force -Name -LiteralPath 'C:\\Windows'":
+		return wsmanResult{stdout: "System32\r\nwin.ini\r\n"}
+	default:
+		return wsmanResult{stderr: "Cannot find path", exitCode: 1}
+	}
+}
+
+func newTestWinrmConfig(t *testing.T, serverURL string) *inventory.Config {
+	u, err := url.Parse(serverURL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(u.Port())
+	require.NoError(t, err)
+
+	return &inventory.Config{
+		Type:        "winrm",
+		Host:        u.Hostname(),
+		Port:        int32(port),
+		Credentials: []*vault.Credential{vault.NewPasswordCredential("administrator", "secret")},
+	}
+}
+
+func newTestWinrmConnection(t *testing.T) (*WinrmConnection, *wsmanServer) {
+	handler := newWsmanServer()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	conn, err := NewWinrmConnection(1, newTestWinrmConfig(t, srv.URL), &inventory.Asset{})
+	require.NoError(t, err)
+	return conn, handler
+}
+
+func TestWinrmConnection_RunCommand(t *testing.T) {
+	conn, srv := newTestWinrmConnection(t)
+	assert.Equal(t, Winrm, conn.Type())
+
+	cmd, err := conn.RunCommand("Write-Output 'hello'")
+	require.NoError(t, err)
+	assert.Equal(t, 0, cmd.ExitStatus)
+	stdout, err := io.ReadAll(cmd.Stdout)
+	require.NoError(t, err)
+	assert.Equal(t, "hello\r\n", string(stdout))
+	assert.Equal(t, []string{"Write-Output 'hello'"}, srv.scripts)
+
+	cmd, err = conn.RunCommand("Get-Unknown")
+	require.NoError(t, err)
+	assert.Equal(t, 1, cmd.ExitStatus)
+	stderr, err := io.ReadAll(cmd.Stderr)
+	require.NoError(t, err)
+	assert.Equal(t, "Cannot find path", string(stderr))
+}
+
+func TestWinrmConnection_FileSystem(t *testing.T) {
+	conn, _ := newTestWinrmConnection(t)
+	fs := conn.FileSystem()
+
+	stat, err := fs.Stat("C:\\Windows\\win.ini")
+	require.NoError(t, err)
+	assert.Equal(t, "win.ini", stat.Name())
+	assert.Equal(t, int64(36), stat.Size())
+	assert.False(t, stat.IsDir())
+	assert.Equal(t, int64(1688045264), stat.ModTime().Unix())
+
+	f, err := fs.Open("C:\\Windows\\win.ini")
+	require.NoError(t, err)
+	data, err := io.ReadAll(f)
+	require.NoError(t, err)
+	assert.Equal(t, winIniContent, string(data))
+
+	dir, err := fs.Open("C:\\Windows")
+	require.NoError(t, err)
+	names, err := dir.Readdirnames(-1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"System32", "win.ini"}, names)
+
+	_, err = fs.Stat("C:\\missing.txt")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	details, err := conn.FileInfo("C:\\Windows")
+	require.NoError(t, err)
+	assert.True(t, details.Mode.IsDir())
+	assert.Equal(t, int64(-1), details.Uid)
+}
+
+func TestWinrmConnection_Https(t *testing.T) {
+	srv := httptest.NewTLSServer(newWsmanServer())
+	t.Cleanup(srv.Close)
+	conf := newTestWinrmConfig(t, srv.URL)
+	conf.Insecure = true
+
+	// the port doesn't tell us if the host expects https
+	_, err := NewWinrmConnection(1, conf, &inventory.Asset{})
+	require.Error(t, err)
+
+	conf.Options = map[string]string{"winrm_https": "true"}
+	conn, err := NewWinrmConnection(1, conf, &inventory.Asset{})
+	require.NoError(t, err)
+	assert.True(t, conn.Endpoint.HTTPS)
+
+	cmd, err := conn.RunCommand("Write-Output 'hello'")
+	require.NoError(t, err)
+	assert.Equal(t, 0, cmd.ExitStatus)
+}
+
+func TestWinrmConnection_RunCommandDeadline(t *testing.T) {
+	handler := newWsmanServer()
+	handler.hang = make(chan struct{})
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	// cleanups run in reverse order, the server only closes once all
+	// requests are done
+	t.Cleanup(func() { close(handler.hang) })
+
+	conn, err := NewWinrmConnection(1, newTestWinrmConfig(t, srv.URL), &inventory.Asset{})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	release := conn.Use(ctx)
+	defer release()
+
+	start := time.Now()
+	_, err = conn.RunCommand("Write-Output 'hello'")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"errors"
 	"net/url"
 	"strconv"
@@ -18,14 +19,12 @@ import (
 )
 
 type Service struct {
-	runtimes         map[uint32]*plugin.Runtime
-	lastConnectionID uint32
+	*plugin.Service
 }
 
 func Init() *Service {
 	return &Service{
-		runtimes:         map[uint32]*plugin.Runtime{},
-		lastConnectionID: 0,
+		Service: plugin.NewService(),
 	}
 }
 
@@ -56,6 +55,12 @@ func (s *Service) ParseCLI(req *plugin.ParseCLIReq) (*plugin.ParseCLIRes, error)
 	case "winrm":
 		conn.Type = "winrm"
 		port = 5985
+		if x, ok := flags["https"]; ok {
+			if https, ok := x.RawData().Value.(bool); ok && https {
+				conn.Options = map[string]string{"winrm_https": "true"}
+				port = 5986
+			}
+		}
 	}
 
 	user := ""
@@ -86,6 +91,12 @@ func (s *Service) ParseCLI(req *plugin.ParseCLIReq) (*plugin.ParseCLIRes, error)
 		conn.Port = int32(port)
 	}
 
+	if x, ok := flags["insecure"]; ok {
+		if insecure, ok := x.RawData().Value.(bool); ok {
+			conn.Insecure = insecure
+		}
+	}
+
 	if x, ok := flags["password"]; ok && len(x.Value) != 0 {
 		conn.Credentials = append(conn.Credentials, vault.NewPasswordCredential(user, string(x.Value)))
 	}
@@ -158,24 +169,22 @@ func (s *Service) connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 
 	switch conf.Type {
 	case "local":
-		s.lastConnectionID++
-		conn = connection.NewLocalConnection(s.lastConnectionID, asset)
+		conn = connection.NewLocalConnection(s.NewConnectionID(), asset)
 
 	case "ssh":
-		s.lastConnectionID++
-		conn, err = connection.NewSshConnection(s.lastConnectionID, conf, asset)
+		conn, err = connection.NewSshConnection(s.NewConnectionID(), conf, asset)
+
+	case "winrm":
+		conn, err = connection.NewWinrmConnection(s.NewConnectionID(), conf, asset)
 
 	case "mock":
-		s.lastConnectionID++
 		conn, err = mock.New("", asset)
 
 	case "tar":
-		s.lastConnectionID++
-		conn, err = connection.NewTarConnection(s.lastConnectionID, conf, asset)
+		conn, err = connection.NewTarConnection(s.NewConnectionID(), conf, asset)
 
 	case "docker-snapshot":
-		s.lastConnectionID++
-		conn, err = connection.NewDockerSnapshotConnection(s.lastConnectionID, conf, asset)
+		conn, err = connection.NewDockerSnapshotConnection(s.NewConnectionID(), conf, asset)
 
 	default:
 		return nil, errors.New("cannot find connection type " + conf.Type)
@@ -194,24 +203,30 @@ func (s *Service) connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 	}
 
 	asset.Connections[0].Id = conn.ID()
-	s.runtimes[conn.ID()] = &plugin.Runtime{
+	s.AddRuntime(conn.ID(), &plugin.Runtime{
 		Connection:     conn,
 		Resources:      map[string]plugin.Resource{},
 		Callback:       callback,
 		HasRecording:   req.HasRecording,
 		CreateResource: resources.CreateResource,
 		Upstream:       upstream,
-	}
+	})
 
 	return conn, err
 }
 
-func (s *Service) GetData(req *plugin.DataReq) (*plugin.DataRes, error) {
-	runtime, ok := s.runtimes[req.Connection]
-	if !ok {
-		return nil, errors.New("connection " + strconv.FormatUint(uint64(req.Connection), 10) + " not found")
+func (s *Service) GetData(ctx context.Context, req *plugin.DataReq) (*plugin.DataRes, error) {
+	runtime, err := s.GetRuntime(req.Connection)
+	if err != nil {
+		return nil, err
 	}
 
+	return runtime.Do(ctx, func() (*plugin.DataRes, error) {
+		return s.getData(runtime, req)
+	})
+}
+
+func (s *Service) getData(runtime *plugin.Runtime, req *plugin.DataReq) (*plugin.DataRes, error) {
 	args := plugin.PrimitiveArgsToRawDataArgs(req.Args, runtime)
 
 	if req.ResourceId == "" && req.Field == "" {
@@ -254,9 +269,9 @@ func (s *Service) GetData(req *plugin.DataReq) (*plugin.DataRes, error) {
 }
 
 func (s *Service) StoreData(req *plugin.StoreReq) (*plugin.StoreRes, error) {
-	runtime, ok := s.runtimes[req.Connection]
-	if !ok {
-		return nil, errors.New("connection " + strconv.FormatUint(uint64(req.Connection), 10) + " not found")
+	runtime, err := s.GetRuntime(req.Connection)
+	if err != nil {
+		return nil, err
 	}
 
 	var errs []string
@@ -0,0 +1,215 @@
+package resources
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"strings"
+
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/providers/os/resources/macos"
+	"go.mondoo.com/cnquery/providers/os/resources/plist"
+)
+
+func (m *mqlMacos) id() (string, error) {
+	return "macos", nil
+}
+
+func (m *mqlMacos) userPreferences() (map[string]interface{}, error) {
+	conn := m.MqlRuntime.Connection.(shared.Connection)
+	preferences, err := macos.NewPreferences(conn).UserPreferences()
+	if err != nil {
+		return nil, err
+	}
+
+	res := map[string]interface{}{}
+	for k := range preferences {
+		res[k] = preferences[k]
+	}
+	return res, nil
+}
+
+func (m *mqlMacos) userHostPreferences() (map[string]interface{}, error) {
+	conn := m.MqlRuntime.Connection.(shared.Connection)
+	preferences, err := macos.NewPreferences(conn).UserHostPreferences()
+	if err != nil {
+		return nil, err
+	}
+
+	res := map[string]interface{}{}
+	for k := range preferences {
+		res[k] = preferences[k]
+	}
+	return res, nil
+}
+
+func (m *mqlMacos) globalAccountPolicies() (map[string]interface{}, error) {
+	out, err := runCommand(m.MqlRuntime, "pwpolicy -getaccountpolicies")
+	if err != nil {
+		return nil, err
+	}
+
+	return plist.Decode(strings.NewReader(out))
+}
+
+func (m *mqlMacosTimemachine) id() (string, error) {
+	return "macos.timemachine", nil
+}
+
+// preferences returns the time machine preferences
+//
+// NOTE: this cannot be implemented via:
+// parse.plist('/Library/Preferences/com.apple.TimeMachine.plist').params['AutoBackup'] == 1
+// since the binary is missing the Full Disk Access (FDA), therefore even applications with
+// sudo permissions cannot access the file. Instead we need to call
+// defaults read /Library/Preferences/com.apple.TimeMachine.plist which has FDA
+// see https://developer.apple.com/forums/thread/108348
+func (m *mqlMacosTimemachine) preferences() (map[string]interface{}, error) {
+	out, err := runCommand(m.MqlRuntime, "defaults read /Library/Preferences/com.apple.TimeMachine.plist")
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		line := scanner.Text()
+		// skip the BackupAlias since they are not parsable when returned by the `defaults` command
+		if strings.HasPrefix(strings.TrimSpace(line), "BackupAlias") {
+			continue
+		}
+		buf.WriteString(line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return plist.Decode(bytes.NewReader(buf.Bytes()))
+}
+
+func (m *mqlMacosSystemsetup) id() (string, error) {
+	return "macos.systemsetup", nil
+}
+
+func (m *mqlMacosSystemsetup) runCmd(command string) (string, error) {
+	out, err := runCommand(m.MqlRuntime, command)
+	if err != nil {
+		return "", err
+	}
+
+	// NOTE: systemsetup returns exit 0 even if it does not have enough permissions
+	// Therefore we need to handle this case here
+	if strings.TrimSpace(out) == "You need administrator access to run this tool... exiting!" {
+		return "", errors.New("macos.systemsetup needs elevated permissions")
+	}
+	return out, nil
+}
+
+func (m *mqlMacosSystemsetup) date() (string, error) {
+	data, err := m.runCmd("systemsetup -getdate")
+	return macos.SystemSetupCmdOutput{}.ParseDate(data), err
+}
+
+func (m *mqlMacosSystemsetup) time() (string, error) {
+	data, err := m.runCmd("systemsetup -gettime")
+	return macos.SystemSetupCmdOutput{}.ParseTime(data), err
+}
+
+func (m *mqlMacosSystemsetup) timeZone() (string, error) {
+	data, err := m.runCmd("systemsetup -gettimezone")
+	return macos.SystemSetupCmdOutput{}.ParseTimeZone(data), err
+}
+
+func (m *mqlMacosSystemsetup) usingNetworkTime() (string, error) {
+	data, err := m.runCmd("systemsetup -getusingnetworktime")
+	return macos.SystemSetupCmdOutput{}.ParseUsingNetworktTime(data), err
+}
+
+func (m *mqlMacosSystemsetup) networkTimeServer() (string, error) {
+	data, err := m.runCmd("systemsetup -getnetworktimeserver")
+	return macos.SystemSetupCmdOutput{}.ParseNetworkTimeServer(data), err
+}
+
+func (m *mqlMacosSystemsetup) sleep() ([]interface{}, error) {
+	data, err := m.runCmd("systemsetup -getsleep")
+	return llx.TArr2Raw(macos.SystemSetupCmdOutput{}.ParseSleep(data)), err
+}
+
+func (m *mqlMacosSystemsetup) displaySleep() (string, error) {
+	data, err := m.runCmd("systemsetup -getdisplaysleep")
+	return macos.SystemSetupCmdOutput{}.ParseDisplaySleep(data), err
+}
+
+func (m *mqlMacosSystemsetup) harddiskSleep() (string, error) {
+	data, err := m.runCmd("systemsetup -getharddisksleep")
+	return macos.SystemSetupCmdOutput{}.ParseHardDiskSleep(data), err
+}
+
+func (m *mqlMacosSystemsetup) wakeOnModem() (string, error) {
+	data, err := m.runCmd("systemsetup -getwakeonmodem")
+	return macos.SystemSetupCmdOutput{}.ParseWakeOnModem(data), err
+}
+
+func (m *mqlMacosSystemsetup) wakeOnNetworkAccess() (string, error) {
+	data, err := m.runCmd("systemsetup -getwakeonnetworkaccess")
+	return macos.SystemSetupCmdOutput{}.ParseWakeOnNetwork(data), err
+}
+
+func (m *mqlMacosSystemsetup) restartPowerFailure() (string, error) {
+	data, err := m.runCmd("systemsetup -getrestartpowerfailure")
+	return macos.SystemSetupCmdOutput{}.ParseRestartPowerFailure(data), err
+}
+
+func (m *mqlMacosSystemsetup) restartFreeze() (string, error) {
+	data, err := m.runCmd("systemsetup -getrestartfreeze")
+	return macos.SystemSetupCmdOutput{}.ParseRestartFreeze(data), err
+}
+
+func (m *mqlMacosSystemsetup) allowPowerButtonToSleepComputer() (string, error) {
+	data, err := m.runCmd("systemsetup -getallowpowerbuttontosleepcomputer")
+	return macos.SystemSetupCmdOutput{}.ParseAllowPowerButtonToSleep(data), err
+}
+
+func (m *mqlMacosSystemsetup) remoteLogin() (string, error) {
+	data, err := m.runCmd("systemsetup -getremotelogin")
+	return macos.SystemSetupCmdOutput{}.ParseRemoteLogin(data), err
+}
+
+func (m *mqlMacosSystemsetup) remoteAppleEvents() (string, error) {
+	data, err := m.runCmd("systemsetup -getremoteappleevents")
+	return macos.SystemSetupCmdOutput{}.ParseRemoteAppleEvents(data), err
+}
+
+func (m *mqlMacosSystemsetup) computerName() (string, error) {
+	data, err := m.runCmd("systemsetup -getcomputername")
+	return macos.SystemSetupCmdOutput{}.ParseComputerName(data), err
+}
+
+func (m *mqlMacosSystemsetup) localSubnetName() (string, error) {
+	data, err := m.runCmd("systemsetup -getlocalsubnetname")
+	return macos.SystemSetupCmdOutput{}.ParseLocalSubnetname(data), err
+}
+
+func (m *mqlMacosSystemsetup) startupDisk() (string, error) {
+	data, err := m.runCmd("systemsetup -getstartupdisk")
+	return data, err
+}
+
+func (m *mqlMacosSystemsetup) waitForStartupAfterPowerFailure() (string, error) {
+	data, err := m.runCmd("systemsetup -getwaitforstartupafterpowerfailure")
+	return macos.SystemSetupCmdOutput{}.ParseWaitForStartupAfterPowerFailure(data), err
+}
+
+func (m *mqlMacosSystemsetup) disableKeyboardWhenEnclosureLockIsEngaged() (string, error) {
+	data, err := m.runCmd("systemsetup -getdisablekeyboardwhenenclosurelockisengaged")
+	return macos.SystemSetupCmdOutput{}.ParseDisableKeyboardWhenEnclosureLockIsEngaged(data), err
+}
+
+func (m *mqlMacosSecurity) id() (string, error) {
+	return "macos.security", nil
+}
+
+func (m *mqlMacosSecurity) authorizationDB() (map[string]interface{}, error) {
+	return nil, errors.New("the implementation is deprecated")
+}
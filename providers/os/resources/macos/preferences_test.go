@@ -0,0 +1,26 @@
+package macos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/providers/os/connection/mock"
+)
+
+func TestPreferences(t *testing.T) {
+	conn, err := mock.New("./testdata/user_preferences.toml", nil)
+	require.NoError(t, err)
+
+	prefs := NewPreferences(conn)
+
+	preferences, err := prefs.UserHostPreferences()
+	require.NoError(t, err)
+	assert.NotNil(t, preferences["com.apple.Bluetooth"])
+	assert.NotNil(t, preferences["com.apple.MIDI"])
+
+	preferences, err = prefs.UserPreferences()
+	require.NoError(t, err)
+	assert.NotNil(t, preferences["com.apple.iCal.helper"])
+	assert.NotNil(t, preferences["com.apple.iChat"])
+}
@@ -0,0 +1,49 @@
+package macos
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/providers/os/connection/mock"
+	"go.mondoo.com/cnquery/providers/os/connection/shared"
+)
+
+func TestSystemSetup(t *testing.T) {
+	conn, err := mock.New("./testdata/systemsetup.toml", nil)
+	require.NoError(t, err)
+
+	so := SystemSetupCmdOutput{}
+	assert.Equal(t, "8/4/2021", so.ParseDate(mustRunCmd(conn, "systemsetup -getdate")))
+	assert.Equal(t, "20:22:54", so.ParseTime(mustRunCmd(conn, "systemsetup -gettime")))
+	assert.Equal(t, "Europe/Berlin", so.ParseTimeZone(mustRunCmd(conn, "systemsetup -gettimezone")))
+	assert.Equal(t, "time.euro.apple.com", so.ParseNetworkTimeServer(mustRunCmd(conn, "systemsetup -getnetworktimeserver")))
+	assert.Equal(t, "On", so.ParseUsingNetworktTime(mustRunCmd(conn, "systemsetup -getusingnetworktime")))
+	assert.Equal(t, []string{"Computer sleeps after 1 minutes", "Display sleeps after 10 minutes", "Disk sleeps after 10 minutes"}, so.ParseSleep(mustRunCmd(conn, "systemsetup -getsleep")))
+	assert.Equal(t, "after 10 minutes", so.ParseDisplaySleep(mustRunCmd(conn, "systemsetup -getdisplaysleep")))
+	assert.Equal(t, "after 10 minutes", so.ParseHardDiskSleep(mustRunCmd(conn, "systemsetup -getharddisksleep")))
+	assert.Equal(t, "Not supported on this machine", so.ParseWakeOnModem(mustRunCmd(conn, "systemsetup -getwakeonmodem")))
+	assert.Equal(t, "On", so.ParseWakeOnNetwork(mustRunCmd(conn, "systemsetup -getwakeonnetworkaccess")))
+	assert.Equal(t, "Not supported on this machine", so.ParseRestartPowerFailure(mustRunCmd(conn, "systemsetup -getrestartpowerfailure")))
+	assert.Equal(t, "On", so.ParseRestartFreeze(mustRunCmd(conn, "systemsetup -getrestartfreeze")))
+	assert.Equal(t, "On", so.ParseAllowPowerButtonToSleep(mustRunCmd(conn, "systemsetup -getallowpowerbuttontosleepcomputer")))
+	assert.Equal(t, "Off", so.ParseRemoteLogin(mustRunCmd(conn, "systemsetup -getremotelogin")))
+	assert.Equal(t, "Off", so.ParseRemoteAppleEvents(mustRunCmd(conn, "systemsetup -getremoteappleevents")))
+	assert.Equal(t, "spacerocket", so.ParseComputerName(mustRunCmd(conn, "systemsetup -getcomputername")))
+	assert.Equal(t, "spacerocket", so.ParseLocalSubnetname(mustRunCmd(conn, "systemsetup -getlocalsubnetname")))
+	assert.Equal(t, "0 seconds", so.ParseWaitForStartupAfterPowerFailure(mustRunCmd(conn, "systemsetup -getwaitforstartupafterpowerfailure")))
+	assert.Equal(t, "No", so.ParseDisableKeyboardWhenEnclosureLockIsEngaged(mustRunCmd(conn, "systemsetup -getdisablekeyboardwhenenclosurelockisengaged")))
+}
+
+func mustRunCmd(c shared.Connection, command string) string {
+	cmd, err := c.RunCommand(command)
+	if err != nil {
+		panic(err)
+	}
+	data, err := io.ReadAll(cmd.Stdout)
+	if err != nil {
+		panic(err)
+	}
+	return string(data)
+}
@@ -0,0 +1,81 @@
+package resources
+
+import (
+	"errors"
+
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/providers/os/resources/plist"
+	"go.mondoo.com/cnquery/types"
+)
+
+const macosAlfConfigPath = "/Library/Preferences/com.apple.alf.plist"
+
+func (m *mqlMacosAlf) id() (string, error) {
+	return "macos.alf", nil
+}
+
+func initMacosAlf(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if len(args) > 0 {
+		return args, nil, nil
+	}
+
+	// TODO: use parse.plist with /Library/Preferences/com.apple.alf.plist in future
+	conn := runtime.Connection.(shared.Connection)
+	f, err := conn.FileSystem().Open(macosAlfConfigPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	alfConfig, err := plist.Decode(f)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	explicitAuths := []interface{}{}
+	explicitAuthsRaw, _ := alfConfig["explicitauths"].([]interface{})
+	for i := range explicitAuthsRaw {
+		entry, ok := explicitAuthsRaw[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		explicitAuths = append(explicitAuths, entry["id"])
+	}
+
+	version, ok := alfConfig["version"].(string)
+	if !ok {
+		return nil, nil, errors.New("could not determine macos.alf version from " + macosAlfConfigPath)
+	}
+
+	exceptions, _ := alfConfig["exceptions"].([]interface{})
+	applications, _ := alfConfig["applications"].([]interface{})
+
+	args["allowDownloadSignedEnabled"] = llx.IntData(alfInt(alfConfig["allowdownloadsignedenabled"]))
+	args["allowSignedEnabled"] = llx.IntData(alfInt(alfConfig["allowsignedenabled"]))
+	args["firewallUnload"] = llx.IntData(alfInt(alfConfig["firewallunload"]))
+	args["globalState"] = llx.IntData(alfInt(alfConfig["globalstate"]))
+	args["loggingEnabled"] = llx.IntData(alfInt(alfConfig["loggingenabled"]))
+	args["loggingOption"] = llx.IntData(alfInt(alfConfig["loggingoption"]))
+	args["stealthEnabled"] = llx.IntData(alfInt(alfConfig["stealthenabled"]))
+	args["version"] = llx.StringData(version)
+	args["exceptions"] = llx.ArrayData(exceptions, types.Dict)
+	args["explicitAuths"] = llx.ArrayData(explicitAuths, types.String)
+	args["applications"] = llx.ArrayData(applications, types.Dict)
+
+	return args, nil, nil
+}
+
+// alfInt converts the numeric values of the decoded plist, which are
+// returned as float64, into int64
+func alfInt(v interface{}) int64 {
+	switch x := v.(type) {
+	case float64:
+		return int64(x)
+	case int64:
+		return x
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,91 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/providers/os/connection/mock"
+)
+
+func macosRuntime(t *testing.T) *plugin.Runtime {
+	conn, err := mock.New("./macos/testdata/macos.toml", nil)
+	require.NoError(t, err)
+
+	return &plugin.Runtime{
+		Connection: conn,
+		Resources:  map[string]plugin.Resource{},
+	}
+}
+
+func TestResource_MacosAlf(t *testing.T) {
+	o, err := NewResource(macosRuntime(t), "macos.alf", map[string]*llx.RawData{})
+	require.NoError(t, err)
+	alf := o.(*mqlMacosAlf)
+
+	assert.Equal(t, int64(1), alf.GetGlobalState().Data)
+	assert.Equal(t, int64(1), alf.GetStealthEnabled().Data)
+	assert.Equal(t, int64(0), alf.GetFirewallUnload().Data)
+	assert.Equal(t, "1.6", alf.GetVersion().Data)
+	assert.Equal(t, []interface{}{"org.python.python.app", "com.apple.perl5"}, alf.GetExplicitAuths().Data)
+	assert.Len(t, alf.GetExceptions().Data, 2)
+	assert.Empty(t, alf.GetApplications().Data)
+}
+
+func TestResource_MacosSystemsetup(t *testing.T) {
+	o, err := CreateResource(macosRuntime(t), "macos.systemsetup", nil)
+	require.NoError(t, err)
+	setup := o.(*mqlMacosSystemsetup)
+
+	timeZone := setup.GetTimeZone()
+	require.NoError(t, timeZone.Error)
+	assert.Equal(t, "Europe/Berlin", timeZone.Data)
+
+	sleep := setup.GetSleep()
+	require.NoError(t, sleep.Error)
+	assert.Equal(t, []interface{}{"Computer sleeps after 1 minutes", "Display sleeps after 10 minutes", "Disk sleeps after 10 minutes"}, sleep.Data)
+
+	harddiskSleep := setup.GetHarddiskSleep()
+	require.NoError(t, harddiskSleep.Error)
+	assert.Equal(t, "after 30 minutes", harddiskSleep.Data)
+
+	remoteLogin := setup.GetRemoteLogin()
+	require.NoError(t, remoteLogin.Error)
+	assert.Equal(t, "Off", remoteLogin.Data)
+
+	// systemsetup exits with 0 when it lacks permissions
+	startupDisk := setup.GetStartupDisk()
+	assert.EqualError(t, startupDisk.Error, "macos.systemsetup needs elevated permissions")
+}
+
+func TestResource_Macos(t *testing.T) {
+	runtime := macosRuntime(t)
+
+	t.Run("global account policies", func(t *testing.T) {
+		o, err := CreateResource(runtime, "macos", nil)
+		require.NoError(t, err)
+		policies := o.(*mqlMacos).GetGlobalAccountPolicies()
+		require.NoError(t, policies.Error)
+		require.Contains(t, policies.Data, "policyCategoryPasswordContent")
+	})
+
+	t.Run("time machine preferences", func(t *testing.T) {
+		o, err := CreateResource(runtime, "macos.timemachine", nil)
+		require.NoError(t, err)
+		prefs := o.(*mqlMacosTimemachine).GetPreferences()
+		require.NoError(t, prefs.Error)
+		data := prefs.Data.(map[string]interface{})
+		assert.Equal(t, "1", data["AutoBackup"])
+		destinations := data["Destinations"].([]interface{})
+		require.Len(t, destinations, 1)
+		assert.NotContains(t, destinations[0], "BackupAlias")
+	})
+
+	t.Run("authorization db is deprecated", func(t *testing.T) {
+		o, err := CreateResource(runtime, "macos.security", nil)
+		require.NoError(t, err)
+		assert.Error(t, o.(*mqlMacosSecurity).GetAuthorizationDB().Error)
+	})
+}
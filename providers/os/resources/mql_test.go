@@ -159,6 +159,22 @@ func TestListResource(t *testing.T) {
 			Code:        "users.map(name)",
 			Expectation: []interface{}([]interface{}{"root", "bin", "chris", "christopher"}),
 		},
+		{
+			Code:        "users.list.sort(name).map(name)",
+			Expectation: []interface{}{"bin", "chris", "christopher", "root"},
+		},
+		{
+			Code:        "users.list.map(uid).max",
+			Expectation: int64(1001),
+		},
+		{
+			Code:        "users.list.map(uid).sum",
+			Expectation: int64(2002),
+		},
+		{
+			Code:        "users.list.groupBy(gid)['1000'].map(name)",
+			Expectation: []interface{}{"chris", "christopher"},
+		},
 		{
 			// outside variables cause the block to be standalone
 			Code:        "n=false; users.contains(n)",
@@ -383,6 +399,28 @@ func TestDict_Methods_Map(t *testing.T) {
 			Code:        p + "params['int-array']",
 			Expectation: []interface{}{float64(1), float64(2), float64(3)},
 		},
+		{
+			Code:        p + "params['int-array'].max",
+			Expectation: float64(3),
+		},
+		{
+			Code:        p + "params['int-array'].sum",
+			Expectation: float64(6),
+		},
+		{
+			Code:        p + "params['int-array'].avg",
+			Expectation: float64(2),
+		},
+		{
+			Code:        p + "params['string-array'].sort.last",
+			Expectation: "c",
+		},
+		{
+			Code: p + "params['f'].groupBy(_['ff'])",
+			Expectation: map[string]interface{}{
+				"3": []interface{}{map[string]interface{}{"ff": float64(3)}},
+			},
+		},
 		{
 			Code:        p + "params['hello'] + ' world'",
 			Expectation: "hello world",
@@ -391,6 +429,26 @@ func TestDict_Methods_Map(t *testing.T) {
 			Code:        p + "params['hello'].trim('ho')",
 			Expectation: "ell",
 		},
+		{
+			Code:        p + "params['hello'].replace('l', 'L')",
+			Expectation: "heLLo",
+		},
+		{
+			Code:        p + "params['hello'].substring(1, 4)",
+			Expectation: "ell",
+		},
+		{
+			Code:        p + "params['hello'].startsWith('he')",
+			Expectation: true,
+		},
+		{
+			Code:        p + "params['hello'].capture(/(?P<first>.)/)",
+			Expectation: map[string]interface{}{"first": "h"},
+		},
+		{
+			Code:        "'b'.in(" + p + "params['string-array'])",
+			Expectation: true,
+		},
 		{
 			Code:        p + "params['dict'].length",
 			Expectation: int64(3),
@@ -262,6 +262,78 @@ func init() {
 			// to override args, implement: initSecpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createSecpol,
 		},
+		"registrykey": {
+			// to override args, implement: initRegistrykey(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createRegistrykey,
+		},
+		"registrykey.property": {
+			Init: initRegistrykeyProperty,
+			Create: createRegistrykeyProperty,
+		},
+		"windows": {
+			// to override args, implement: initWindows(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createWindows,
+		},
+		"windows.hotfix": {
+			Init: initWindowsHotfix,
+			Create: createWindowsHotfix,
+		},
+		"windows.feature": {
+			Init: initWindowsFeature,
+			Create: createWindowsFeature,
+		},
+		"windows.firewall": {
+			// to override args, implement: initWindowsFirewall(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createWindowsFirewall,
+		},
+		"windows.firewall.profile": {
+			// to override args, implement: initWindowsFirewallProfile(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createWindowsFirewallProfile,
+		},
+		"windows.firewall.rule": {
+			// to override args, implement: initWindowsFirewallRule(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createWindowsFirewallRule,
+		},
+		"windows.bitlocker": {
+			// to override args, implement: initWindowsBitlocker(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createWindowsBitlocker,
+		},
+		"windows.bitlocker.volume": {
+			// to override args, implement: initWindowsBitlockerVolume(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createWindowsBitlockerVolume,
+		},
+		"windows.security": {
+			// to override args, implement: initWindowsSecurity(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createWindowsSecurity,
+		},
+		"windows.security.product": {
+			// to override args, implement: initWindowsSecurityProduct(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createWindowsSecurityProduct,
+		},
+		"windows.security.health": {
+			Init: initWindowsSecurityHealth,
+			Create: createWindowsSecurityHealth,
+		},
+		"macos": {
+			// to override args, implement: initMacos(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createMacos,
+		},
+		"macos.alf": {
+			Init: initMacosAlf,
+			Create: createMacosAlf,
+		},
+		"macos.systemsetup": {
+			// to override args, implement: initMacosSystemsetup(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createMacosSystemsetup,
+		},
+		"macos.timemachine": {
+			// to override args, implement: initMacosTimemachine(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createMacosTimemachine,
+		},
+		"macos.security": {
+			// to override args, implement: initMacosSecurity(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createMacosSecurity,
+		},
 		"ntp.conf": {
 			Init: initNtpConf,
 			Create: createNtpConf,
@@ -1156,6 +1228,39 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"process.flags": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlProcess).GetFlags()).ToDataRes(types.Map(types.String, types.String))
 	},
+	"process.user": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlProcess).GetUser()).ToDataRes(types.Resource("user"))
+	},
+	"process.parent": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlProcess).GetParent()).ToDataRes(types.Resource("process"))
+	},
+	"process.children": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlProcess).GetChildren()).ToDataRes(types.Array(types.Resource("process")))
+	},
+	"process.cwd": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlProcess).GetCwd()).ToDataRes(types.String)
+	},
+	"process.env": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlProcess).GetEnv()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"process.startTime": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlProcess).GetStartTime()).ToDataRes(types.Time)
+	},
+	"process.rss": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlProcess).GetRss()).ToDataRes(types.Int)
+	},
+	"process.vsize": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlProcess).GetVsize()).ToDataRes(types.Int)
+	},
+	"process.openFiles": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlProcess).GetOpenFiles()).ToDataRes(types.Array(types.String))
+	},
+	"process.cgroup": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlProcess).GetCgroup()).ToDataRes(types.Array(types.String))
+	},
+	"process.namespaces": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlProcess).GetNamespaces()).ToDataRes(types.Map(types.String, types.String))
+	},
 	"processes.list": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlProcesses).GetList()).ToDataRes(types.Array(types.Resource("process")))
 	},
@@ -1225,6 +1330,363 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"secpol.privilegerights": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlSecpol).GetPrivilegerights()).ToDataRes(types.Map(types.String, types.Array(types.String)))
 	},
+	"registrykey.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRegistrykey).GetPath()).ToDataRes(types.String)
+	},
+	"registrykey.exists": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRegistrykey).GetExists()).ToDataRes(types.Bool)
+	},
+	"registrykey.properties": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRegistrykey).GetProperties()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"registrykey.children": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRegistrykey).GetChildren()).ToDataRes(types.Array(types.String))
+	},
+	"registrykey.property.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRegistrykeyProperty).GetPath()).ToDataRes(types.String)
+	},
+	"registrykey.property.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRegistrykeyProperty).GetName()).ToDataRes(types.String)
+	},
+	"registrykey.property.exists": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRegistrykeyProperty).GetExists()).ToDataRes(types.Bool)
+	},
+	"registrykey.property.value": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRegistrykeyProperty).GetValue()).ToDataRes(types.String)
+	},
+	"windows.computerInfo": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindows).GetComputerInfo()).ToDataRes(types.Dict)
+	},
+	"windows.hotfixes": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindows).GetHotfixes()).ToDataRes(types.Array(types.Resource("windows.hotfix")))
+	},
+	"windows.features": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindows).GetFeatures()).ToDataRes(types.Array(types.Resource("windows.feature")))
+	},
+	"windows.hotfix.hotfixId": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsHotfix).GetHotfixId()).ToDataRes(types.String)
+	},
+	"windows.hotfix.description": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsHotfix).GetDescription()).ToDataRes(types.String)
+	},
+	"windows.hotfix.caption": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsHotfix).GetCaption()).ToDataRes(types.String)
+	},
+	"windows.hotfix.installedOn": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsHotfix).GetInstalledOn()).ToDataRes(types.Time)
+	},
+	"windows.hotfix.installedBy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsHotfix).GetInstalledBy()).ToDataRes(types.String)
+	},
+	"windows.feature.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFeature).GetPath()).ToDataRes(types.String)
+	},
+	"windows.feature.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFeature).GetName()).ToDataRes(types.String)
+	},
+	"windows.feature.displayName": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFeature).GetDisplayName()).ToDataRes(types.String)
+	},
+	"windows.feature.description": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFeature).GetDescription()).ToDataRes(types.String)
+	},
+	"windows.feature.installed": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFeature).GetInstalled()).ToDataRes(types.Bool)
+	},
+	"windows.feature.installState": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFeature).GetInstallState()).ToDataRes(types.Int)
+	},
+	"windows.firewall.settings": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewall).GetSettings()).ToDataRes(types.Dict)
+	},
+	"windows.firewall.profiles": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewall).GetProfiles()).ToDataRes(types.Array(types.Resource("windows.firewall.profile")))
+	},
+	"windows.firewall.rules": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewall).GetRules()).ToDataRes(types.Array(types.Resource("windows.firewall.rule")))
+	},
+	"windows.firewall.profile.instanceID": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallProfile).GetInstanceID()).ToDataRes(types.String)
+	},
+	"windows.firewall.profile.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallProfile).GetName()).ToDataRes(types.String)
+	},
+	"windows.firewall.profile.enabled": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallProfile).GetEnabled()).ToDataRes(types.Int)
+	},
+	"windows.firewall.profile.defaultInboundAction": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallProfile).GetDefaultInboundAction()).ToDataRes(types.Int)
+	},
+	"windows.firewall.profile.defaultOutboundAction": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallProfile).GetDefaultOutboundAction()).ToDataRes(types.Int)
+	},
+	"windows.firewall.profile.allowInboundRules": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallProfile).GetAllowInboundRules()).ToDataRes(types.Int)
+	},
+	"windows.firewall.profile.allowLocalFirewallRules": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallProfile).GetAllowLocalFirewallRules()).ToDataRes(types.Int)
+	},
+	"windows.firewall.profile.allowLocalIPsecRules": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallProfile).GetAllowLocalIPsecRules()).ToDataRes(types.Int)
+	},
+	"windows.firewall.profile.allowUserApps": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallProfile).GetAllowUserApps()).ToDataRes(types.Int)
+	},
+	"windows.firewall.profile.allowUserPorts": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallProfile).GetAllowUserPorts()).ToDataRes(types.Int)
+	},
+	"windows.firewall.profile.allowUnicastResponseToMulticast": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallProfile).GetAllowUnicastResponseToMulticast()).ToDataRes(types.Int)
+	},
+	"windows.firewall.profile.notifyOnListen": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallProfile).GetNotifyOnListen()).ToDataRes(types.Int)
+	},
+	"windows.firewall.profile.enableStealthModeForIPsec": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallProfile).GetEnableStealthModeForIPsec()).ToDataRes(types.Int)
+	},
+	"windows.firewall.profile.logMaxSizeKilobytes": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallProfile).GetLogMaxSizeKilobytes()).ToDataRes(types.Int)
+	},
+	"windows.firewall.profile.logAllowed": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallProfile).GetLogAllowed()).ToDataRes(types.Int)
+	},
+	"windows.firewall.profile.logBlocked": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallProfile).GetLogBlocked()).ToDataRes(types.Int)
+	},
+	"windows.firewall.profile.logIgnored": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallProfile).GetLogIgnored()).ToDataRes(types.Int)
+	},
+	"windows.firewall.profile.logFileName": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallProfile).GetLogFileName()).ToDataRes(types.String)
+	},
+	"windows.firewall.rule.instanceID": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallRule).GetInstanceID()).ToDataRes(types.String)
+	},
+	"windows.firewall.rule.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallRule).GetName()).ToDataRes(types.String)
+	},
+	"windows.firewall.rule.displayName": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallRule).GetDisplayName()).ToDataRes(types.String)
+	},
+	"windows.firewall.rule.description": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallRule).GetDescription()).ToDataRes(types.String)
+	},
+	"windows.firewall.rule.displayGroup": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallRule).GetDisplayGroup()).ToDataRes(types.String)
+	},
+	"windows.firewall.rule.enabled": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallRule).GetEnabled()).ToDataRes(types.Int)
+	},
+	"windows.firewall.rule.direction": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallRule).GetDirection()).ToDataRes(types.Int)
+	},
+	"windows.firewall.rule.action": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallRule).GetAction()).ToDataRes(types.Int)
+	},
+	"windows.firewall.rule.edgeTraversalPolicy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallRule).GetEdgeTraversalPolicy()).ToDataRes(types.Int)
+	},
+	"windows.firewall.rule.looseSourceMapping": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallRule).GetLooseSourceMapping()).ToDataRes(types.Bool)
+	},
+	"windows.firewall.rule.localOnlyMapping": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallRule).GetLocalOnlyMapping()).ToDataRes(types.Bool)
+	},
+	"windows.firewall.rule.primaryStatus": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallRule).GetPrimaryStatus()).ToDataRes(types.Int)
+	},
+	"windows.firewall.rule.status": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallRule).GetStatus()).ToDataRes(types.String)
+	},
+	"windows.firewall.rule.enforcementStatus": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallRule).GetEnforcementStatus()).ToDataRes(types.String)
+	},
+	"windows.firewall.rule.policyStoreSource": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallRule).GetPolicyStoreSource()).ToDataRes(types.String)
+	},
+	"windows.firewall.rule.policyStoreSourceType": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsFirewallRule).GetPolicyStoreSourceType()).ToDataRes(types.Int)
+	},
+	"windows.bitlocker.volumes": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsBitlocker).GetVolumes()).ToDataRes(types.Array(types.Resource("windows.bitlocker.volume")))
+	},
+	"windows.bitlocker.volume.deviceID": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsBitlockerVolume).GetDeviceID()).ToDataRes(types.String)
+	},
+	"windows.bitlocker.volume.driveLetter": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsBitlockerVolume).GetDriveLetter()).ToDataRes(types.String)
+	},
+	"windows.bitlocker.volume.conversionStatus": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsBitlockerVolume).GetConversionStatus()).ToDataRes(types.Dict)
+	},
+	"windows.bitlocker.volume.encryptionMethod": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsBitlockerVolume).GetEncryptionMethod()).ToDataRes(types.Dict)
+	},
+	"windows.bitlocker.volume.lockStatus": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsBitlockerVolume).GetLockStatus()).ToDataRes(types.Int)
+	},
+	"windows.bitlocker.volume.persistentVolumeID": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsBitlockerVolume).GetPersistentVolumeID()).ToDataRes(types.String)
+	},
+	"windows.bitlocker.volume.protectionStatus": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsBitlockerVolume).GetProtectionStatus()).ToDataRes(types.Dict)
+	},
+	"windows.bitlocker.volume.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsBitlockerVolume).GetVersion()).ToDataRes(types.Dict)
+	},
+	"windows.security.products": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsSecurity).GetProducts()).ToDataRes(types.Array(types.Resource("windows.security.product")))
+	},
+	"windows.security.product.type": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsSecurityProduct).GetType()).ToDataRes(types.String)
+	},
+	"windows.security.product.guid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsSecurityProduct).GetGuid()).ToDataRes(types.String)
+	},
+	"windows.security.product.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsSecurityProduct).GetName()).ToDataRes(types.String)
+	},
+	"windows.security.product.state": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsSecurityProduct).GetState()).ToDataRes(types.Int)
+	},
+	"windows.security.product.productState": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsSecurityProduct).GetProductState()).ToDataRes(types.String)
+	},
+	"windows.security.product.signatureState": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsSecurityProduct).GetSignatureState()).ToDataRes(types.String)
+	},
+	"windows.security.product.timestamp": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsSecurityProduct).GetTimestamp()).ToDataRes(types.Time)
+	},
+	"windows.security.health.firewall": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsSecurityHealth).GetFirewall()).ToDataRes(types.Dict)
+	},
+	"windows.security.health.autoUpdate": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsSecurityHealth).GetAutoUpdate()).ToDataRes(types.Dict)
+	},
+	"windows.security.health.antiVirus": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsSecurityHealth).GetAntiVirus()).ToDataRes(types.Dict)
+	},
+	"windows.security.health.antiSpyware": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsSecurityHealth).GetAntiSpyware()).ToDataRes(types.Dict)
+	},
+	"windows.security.health.internetSettings": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsSecurityHealth).GetInternetSettings()).ToDataRes(types.Dict)
+	},
+	"windows.security.health.uac": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsSecurityHealth).GetUac()).ToDataRes(types.Dict)
+	},
+	"windows.security.health.securityCenterService": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlWindowsSecurityHealth).GetSecurityCenterService()).ToDataRes(types.Dict)
+	},
+	"macos.userPreferences": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacos).GetUserPreferences()).ToDataRes(types.Map(types.String, types.Dict))
+	},
+	"macos.userHostPreferences": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacos).GetUserHostPreferences()).ToDataRes(types.Map(types.String, types.Dict))
+	},
+	"macos.globalAccountPolicies": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacos).GetGlobalAccountPolicies()).ToDataRes(types.Dict)
+	},
+	"macos.alf.allowDownloadSignedEnabled": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosAlf).GetAllowDownloadSignedEnabled()).ToDataRes(types.Int)
+	},
+	"macos.alf.allowSignedEnabled": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosAlf).GetAllowSignedEnabled()).ToDataRes(types.Int)
+	},
+	"macos.alf.firewallUnload": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosAlf).GetFirewallUnload()).ToDataRes(types.Int)
+	},
+	"macos.alf.globalState": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosAlf).GetGlobalState()).ToDataRes(types.Int)
+	},
+	"macos.alf.loggingEnabled": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosAlf).GetLoggingEnabled()).ToDataRes(types.Int)
+	},
+	"macos.alf.loggingOption": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosAlf).GetLoggingOption()).ToDataRes(types.Int)
+	},
+	"macos.alf.stealthEnabled": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosAlf).GetStealthEnabled()).ToDataRes(types.Int)
+	},
+	"macos.alf.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosAlf).GetVersion()).ToDataRes(types.String)
+	},
+	"macos.alf.exceptions": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosAlf).GetExceptions()).ToDataRes(types.Array(types.Dict))
+	},
+	"macos.alf.explicitAuths": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosAlf).GetExplicitAuths()).ToDataRes(types.Array(types.String))
+	},
+	"macos.alf.applications": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosAlf).GetApplications()).ToDataRes(types.Array(types.Dict))
+	},
+	"macos.systemsetup.date": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosSystemsetup).GetDate()).ToDataRes(types.String)
+	},
+	"macos.systemsetup.time": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosSystemsetup).GetTime()).ToDataRes(types.String)
+	},
+	"macos.systemsetup.timeZone": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosSystemsetup).GetTimeZone()).ToDataRes(types.String)
+	},
+	"macos.systemsetup.usingNetworkTime": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosSystemsetup).GetUsingNetworkTime()).ToDataRes(types.String)
+	},
+	"macos.systemsetup.networkTimeServer": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosSystemsetup).GetNetworkTimeServer()).ToDataRes(types.String)
+	},
+	"macos.systemsetup.sleep": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosSystemsetup).GetSleep()).ToDataRes(types.Array(types.String))
+	},
+	"macos.systemsetup.displaySleep": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosSystemsetup).GetDisplaySleep()).ToDataRes(types.String)
+	},
+	"macos.systemsetup.harddiskSleep": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosSystemsetup).GetHarddiskSleep()).ToDataRes(types.String)
+	},
+	"macos.systemsetup.wakeOnModem": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosSystemsetup).GetWakeOnModem()).ToDataRes(types.String)
+	},
+	"macos.systemsetup.wakeOnNetworkAccess": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosSystemsetup).GetWakeOnNetworkAccess()).ToDataRes(types.String)
+	},
+	"macos.systemsetup.restartPowerFailure": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosSystemsetup).GetRestartPowerFailure()).ToDataRes(types.String)
+	},
+	"macos.systemsetup.restartFreeze": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosSystemsetup).GetRestartFreeze()).ToDataRes(types.String)
+	},
+	"macos.systemsetup.allowPowerButtonToSleepComputer": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosSystemsetup).GetAllowPowerButtonToSleepComputer()).ToDataRes(types.String)
+	},
+	"macos.systemsetup.remoteLogin": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosSystemsetup).GetRemoteLogin()).ToDataRes(types.String)
+	},
+	"macos.systemsetup.remoteAppleEvents": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosSystemsetup).GetRemoteAppleEvents()).ToDataRes(types.String)
+	},
+	"macos.systemsetup.computerName": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosSystemsetup).GetComputerName()).ToDataRes(types.String)
+	},
+	"macos.systemsetup.localSubnetName": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosSystemsetup).GetLocalSubnetName()).ToDataRes(types.String)
+	},
+	"macos.systemsetup.startupDisk": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosSystemsetup).GetStartupDisk()).ToDataRes(types.String)
+	},
+	"macos.systemsetup.waitForStartupAfterPowerFailure": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosSystemsetup).GetWaitForStartupAfterPowerFailure()).ToDataRes(types.String)
+	},
+	"macos.systemsetup.disableKeyboardWhenEnclosureLockIsEngaged": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosSystemsetup).GetDisableKeyboardWhenEnclosureLockIsEngaged()).ToDataRes(types.String)
+	},
+	"macos.timemachine.preferences": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosTimemachine).GetPreferences()).ToDataRes(types.Dict)
+	},
+	"macos.security.authorizationDB": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlMacosSecurity).GetAuthorizationDB()).ToDataRes(types.Dict)
+	},
 	"ntp.conf.file": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlNtpConf).GetFile()).ToDataRes(types.Resource("file"))
 	},
@@ -2667,6 +3129,50 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
 		r.(*mqlProcess).Flags, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
+	"process.user": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).User, ok = plugin.RawToTValue[*mqlUser](v.Value, v.Error)
+		return
+	},
+	"process.parent": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).Parent, ok = plugin.RawToTValue[*mqlProcess](v.Value, v.Error)
+		return
+	},
+	"process.children": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).Children, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"process.cwd": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).Cwd, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"process.env": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).Env, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"process.startTime": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).StartTime, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"process.rss": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).Rss, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"process.vsize": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).Vsize, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"process.openFiles": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).OpenFiles, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"process.cgroup": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).Cgroup, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"process.namespaces": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).Namespaces, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
 	"processes.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 			r.(*mqlProcesses).__id, ok = v.Value.(string)
 			return
@@ -2783,325 +3289,2088 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
 		r.(*mqlSecpol).Privilegerights, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"ntp.conf.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlNtpConf).__id, ok = v.Value.(string)
+	"registrykey.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlRegistrykey).__id, ok = v.Value.(string)
 			return
 		},
-	"ntp.conf.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlNtpConf).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
-		return
-	},
-	"ntp.conf.content": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlNtpConf).Content, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
-	},
-	"ntp.conf.settings": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlNtpConf).Settings, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"registrykey.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRegistrykey).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"ntp.conf.servers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlNtpConf).Servers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"registrykey.exists": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRegistrykey).Exists, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"ntp.conf.restrict": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlNtpConf).Restrict, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"registrykey.properties": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRegistrykey).Properties, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"ntp.conf.fudge": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlNtpConf).Fudge, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"registrykey.children": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRegistrykey).Children, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"rsyslog.conf.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlRsyslogConf).__id, ok = v.Value.(string)
+	"registrykey.property.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlRegistrykeyProperty).__id, ok = v.Value.(string)
 			return
 		},
-	"rsyslog.conf.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlRsyslogConf).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"registrykey.property.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRegistrykeyProperty).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"rsyslog.conf.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlRsyslogConf).Files, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"registrykey.property.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRegistrykeyProperty).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"rsyslog.conf.content": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlRsyslogConf).Content, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"registrykey.property.exists": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRegistrykeyProperty).Exists, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"rsyslog.conf.settings": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlRsyslogConf).Settings, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"registrykey.property.value": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRegistrykeyProperty).Value, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"logindefs.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlLogindefs).__id, ok = v.Value.(string)
+	"windows.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlWindows).__id, ok = v.Value.(string)
 			return
 		},
-	"logindefs.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlLogindefs).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
-		return
-	},
-	"logindefs.content": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlLogindefs).Content, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.computerInfo": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindows).ComputerInfo, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"logindefs.params": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlLogindefs).Params, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"windows.hotfixes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindows).Hotfixes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"lsblk.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlLsblk).__id, ok = v.Value.(string)
-			return
-		},
-	"lsblk.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlLsblk).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"windows.features": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindows).Features, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"lsblk.entry.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlLsblkEntry).__id, ok = v.Value.(string)
+	"windows.hotfix.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlWindowsHotfix).__id, ok = v.Value.(string)
 			return
 		},
-	"lsblk.entry.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlLsblkEntry).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.hotfix.hotfixId": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsHotfix).HotfixId, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"lsblk.entry.fstype": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlLsblkEntry).Fstype, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.hotfix.description": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsHotfix).Description, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"lsblk.entry.label": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlLsblkEntry).Label, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.hotfix.caption": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsHotfix).Caption, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"lsblk.entry.uuid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlLsblkEntry).Uuid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.hotfix.installedOn": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsHotfix).InstalledOn, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
 		return
 	},
-	"lsblk.entry.mountpoints": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlLsblkEntry).Mountpoints, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"windows.hotfix.installedBy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsHotfix).InstalledBy, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"mount.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlMount).__id, ok = v.Value.(string)
+	"windows.feature.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlWindowsFeature).__id, ok = v.Value.(string)
 			return
 		},
-	"mount.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMount).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"windows.feature.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFeature).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"mount.point.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlMountPoint).__id, ok = v.Value.(string)
-			return
-		},
-	"mount.point.device": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMountPoint).Device, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.feature.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFeature).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"mount.point.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMountPoint).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.feature.displayName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFeature).DisplayName, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"mount.point.fstype": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMountPoint).Fstype, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.feature.description": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFeature).Description, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"mount.point.options": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMountPoint).Options, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"windows.feature.installed": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFeature).Installed, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"mount.point.mounted": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMountPoint).Mounted, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+	"windows.feature.installState": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFeature).InstallState, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"shadow.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlShadow).__id, ok = v.Value.(string)
+	"windows.firewall.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlWindowsFirewall).__id, ok = v.Value.(string)
 			return
 		},
-	"shadow.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlShadow).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"windows.firewall.settings": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewall).Settings, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"shadow.entry.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlShadowEntry).__id, ok = v.Value.(string)
-			return
-		},
-	"shadow.entry.user": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlShadowEntry).User, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.firewall.profiles": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewall).Profiles, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"shadow.entry.password": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlShadowEntry).Password, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.firewall.rules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewall).Rules, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"shadow.entry.lastchanged": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlShadowEntry).Lastchanged, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"windows.firewall.profile.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlWindowsFirewallProfile).__id, ok = v.Value.(string)
+			return
+		},
+	"windows.firewall.profile.instanceID": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).InstanceID, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"shadow.entry.mindays": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlShadowEntry).Mindays, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"windows.firewall.profile.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"shadow.entry.maxdays": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlShadowEntry).Maxdays, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"windows.firewall.profile.enabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).Enabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"shadow.entry.warndays": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlShadowEntry).Warndays, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"windows.firewall.profile.defaultInboundAction": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).DefaultInboundAction, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"shadow.entry.inactivedays": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlShadowEntry).Inactivedays, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"windows.firewall.profile.defaultOutboundAction": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).DefaultOutboundAction, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"shadow.entry.expirydates": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlShadowEntry).Expirydates, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.firewall.profile.allowInboundRules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).AllowInboundRules, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"shadow.entry.reserved": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlShadowEntry).Reserved, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.firewall.profile.allowLocalFirewallRules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).AllowLocalFirewallRules, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"yum.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlYum).__id, ok = v.Value.(string)
+	"windows.firewall.profile.allowLocalIPsecRules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).AllowLocalIPsecRules, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.allowUserApps": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).AllowUserApps, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.allowUserPorts": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).AllowUserPorts, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.allowUnicastResponseToMulticast": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).AllowUnicastResponseToMulticast, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.notifyOnListen": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).NotifyOnListen, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.enableStealthModeForIPsec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).EnableStealthModeForIPsec, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.logMaxSizeKilobytes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).LogMaxSizeKilobytes, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.logAllowed": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).LogAllowed, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.logBlocked": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).LogBlocked, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.logIgnored": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).LogIgnored, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.logFileName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).LogFileName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlWindowsFirewallRule).__id, ok = v.Value.(string)
 			return
 		},
-	"yum.vars": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYum).Vars, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"windows.firewall.rule.instanceID": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).InstanceID, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"yum.repos": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYum).Repos, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"windows.firewall.rule.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"yum.repo.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlYumRepo).__id, ok = v.Value.(string)
+	"windows.firewall.rule.displayName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).DisplayName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.description": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).Description, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.displayGroup": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).DisplayGroup, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.enabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).Enabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.direction": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).Direction, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.action": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).Action, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.edgeTraversalPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).EdgeTraversalPolicy, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.looseSourceMapping": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).LooseSourceMapping, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.localOnlyMapping": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).LocalOnlyMapping, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.primaryStatus": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).PrimaryStatus, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.status": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).Status, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.enforcementStatus": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).EnforcementStatus, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.policyStoreSource": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).PolicyStoreSource, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.policyStoreSourceType": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).PolicyStoreSourceType, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.bitlocker.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlWindowsBitlocker).__id, ok = v.Value.(string)
 			return
 		},
-	"yum.repo.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYumRepo).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.bitlocker.volumes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsBitlocker).Volumes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"yum.repo.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYumRepo).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.bitlocker.volume.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlWindowsBitlockerVolume).__id, ok = v.Value.(string)
+			return
+		},
+	"windows.bitlocker.volume.deviceID": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsBitlockerVolume).DeviceID, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"yum.repo.status": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYumRepo).Status, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.bitlocker.volume.driveLetter": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsBitlockerVolume).DriveLetter, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"yum.repo.baseurl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYumRepo).Baseurl, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"windows.bitlocker.volume.conversionStatus": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsBitlockerVolume).ConversionStatus, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"yum.repo.expire": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYumRepo).Expire, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.bitlocker.volume.encryptionMethod": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsBitlockerVolume).EncryptionMethod, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"yum.repo.filename": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYumRepo).Filename, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.bitlocker.volume.lockStatus": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsBitlockerVolume).LockStatus, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"yum.repo.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYumRepo).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
+	"windows.bitlocker.volume.persistentVolumeID": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsBitlockerVolume).PersistentVolumeID, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"yum.repo.revision": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYumRepo).Revision, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.bitlocker.volume.protectionStatus": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsBitlockerVolume).ProtectionStatus, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"yum.repo.pkgs": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYumRepo).Pkgs, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.bitlocker.volume.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsBitlockerVolume).Version, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"yum.repo.size": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYumRepo).Size, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.security.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlWindowsSecurity).__id, ok = v.Value.(string)
+			return
+		},
+	"windows.security.products": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurity).Products, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"yum.repo.mirrors": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYumRepo).Mirrors, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.security.product.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlWindowsSecurityProduct).__id, ok = v.Value.(string)
+			return
+		},
+	"windows.security.product.type": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityProduct).Type, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"yum.repo.enabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYumRepo).Enabled, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+	"windows.security.product.guid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityProduct).Guid, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"container.image.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlContainerImage).__id, ok = v.Value.(string)
+	"windows.security.product.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityProduct).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.security.product.state": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityProduct).State, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.security.product.productState": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityProduct).ProductState, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.security.product.signatureState": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityProduct).SignatureState, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.security.product.timestamp": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityProduct).Timestamp, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"windows.security.health.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlWindowsSecurityHealth).__id, ok = v.Value.(string)
 			return
 		},
-	"container.image.reference": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlContainerImage).Reference, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.security.health.firewall": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityHealth).Firewall, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"container.image.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlContainerImage).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.security.health.autoUpdate": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityHealth).AutoUpdate, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"container.image.identifier": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlContainerImage).Identifier, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.security.health.antiVirus": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityHealth).AntiVirus, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"container.image.identifierType": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlContainerImage).IdentifierType, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"windows.security.health.antiSpyware": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityHealth).AntiSpyware, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"container.image.repository": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlContainerImage).Repository, ok = plugin.RawToTValue[*mqlContainerRepository](v.Value, v.Error)
+	"windows.security.health.internetSettings": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityHealth).InternetSettings, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"container.repository.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlContainerRepository).__id, ok = v.Value.(string)
+	"windows.security.health.uac": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityHealth).Uac, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"windows.security.health.securityCenterService": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityHealth).SecurityCenterService, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"macos.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlMacos).__id, ok = v.Value.(string)
 			return
 		},
-	"container.repository.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlContainerRepository).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"macos.userPreferences": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacos).UserPreferences, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"container.repository.scheme": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlContainerRepository).Scheme, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"macos.userHostPreferences": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacos).UserHostPreferences, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"container.repository.fullName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlContainerRepository).FullName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"macos.globalAccountPolicies": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacos).GlobalAccountPolicies, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"container.repository.registry": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlContainerRepository).Registry, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"macos.alf.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlMacosAlf).__id, ok = v.Value.(string)
+			return
+		},
+	"macos.alf.allowDownloadSignedEnabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).AllowDownloadSignedEnabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"macos.alf.allowSignedEnabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).AllowSignedEnabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"macos.alf.firewallUnload": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).FirewallUnload, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"macos.alf.globalState": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).GlobalState, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"macos.alf.loggingEnabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).LoggingEnabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"macos.alf.loggingOption": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).LoggingOption, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"macos.alf.stealthEnabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).StealthEnabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"macos.alf.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.alf.exceptions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).Exceptions, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"macos.alf.explicitAuths": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).ExplicitAuths, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"macos.alf.applications": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).Applications, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlMacosSystemsetup).__id, ok = v.Value.(string)
+			return
+		},
+	"macos.systemsetup.date": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).Date, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.time": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).Time, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.timeZone": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).TimeZone, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.usingNetworkTime": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).UsingNetworkTime, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.networkTimeServer": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).NetworkTimeServer, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.sleep": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).Sleep, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.displaySleep": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).DisplaySleep, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.harddiskSleep": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).HarddiskSleep, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.wakeOnModem": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).WakeOnModem, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.wakeOnNetworkAccess": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).WakeOnNetworkAccess, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.restartPowerFailure": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).RestartPowerFailure, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.restartFreeze": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).RestartFreeze, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.allowPowerButtonToSleepComputer": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).AllowPowerButtonToSleepComputer, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.remoteLogin": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).RemoteLogin, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.remoteAppleEvents": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).RemoteAppleEvents, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.computerName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).ComputerName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.localSubnetName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).LocalSubnetName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.startupDisk": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).StartupDisk, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.waitForStartupAfterPowerFailure": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).WaitForStartupAfterPowerFailure, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.disableKeyboardWhenEnclosureLockIsEngaged": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).DisableKeyboardWhenEnclosureLockIsEngaged, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.timemachine.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlMacosTimemachine).__id, ok = v.Value.(string)
+			return
+		},
+	"macos.timemachine.preferences": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosTimemachine).Preferences, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"macos.security.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlMacosSecurity).__id, ok = v.Value.(string)
+			return
+		},
+	"macos.security.authorizationDB": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSecurity).AuthorizationDB, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"ntp.conf.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlNtpConf).__id, ok = v.Value.(string)
+			return
+		},
+	"ntp.conf.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNtpConf).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
+		return
+	},
+	"ntp.conf.content": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNtpConf).Content, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ntp.conf.settings": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNtpConf).Settings, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"ntp.conf.servers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNtpConf).Servers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"ntp.conf.restrict": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNtpConf).Restrict, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"ntp.conf.fudge": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNtpConf).Fudge, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"rsyslog.conf.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlRsyslogConf).__id, ok = v.Value.(string)
+			return
+		},
+	"rsyslog.conf.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRsyslogConf).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"rsyslog.conf.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRsyslogConf).Files, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"rsyslog.conf.content": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRsyslogConf).Content, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
+	"rsyslog.conf.settings": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRsyslogConf).Settings, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"logindefs.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlLogindefs).__id, ok = v.Value.(string)
+			return
+		},
+	"logindefs.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLogindefs).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
+		return
+	},
+	"logindefs.content": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLogindefs).Content, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"logindefs.params": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLogindefs).Params, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"lsblk.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlLsblk).__id, ok = v.Value.(string)
+			return
+		},
+	"lsblk.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLsblk).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"lsblk.entry.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlLsblkEntry).__id, ok = v.Value.(string)
+			return
+		},
+	"lsblk.entry.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLsblkEntry).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"lsblk.entry.fstype": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLsblkEntry).Fstype, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"lsblk.entry.label": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLsblkEntry).Label, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"lsblk.entry.uuid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLsblkEntry).Uuid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"lsblk.entry.mountpoints": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLsblkEntry).Mountpoints, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"mount.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlMount).__id, ok = v.Value.(string)
+			return
+		},
+	"mount.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMount).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"mount.point.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlMountPoint).__id, ok = v.Value.(string)
+			return
+		},
+	"mount.point.device": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMountPoint).Device, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"mount.point.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMountPoint).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"mount.point.fstype": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMountPoint).Fstype, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"mount.point.options": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMountPoint).Options, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"mount.point.mounted": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMountPoint).Mounted, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"shadow.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlShadow).__id, ok = v.Value.(string)
+			return
+		},
+	"shadow.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlShadow).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"shadow.entry.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlShadowEntry).__id, ok = v.Value.(string)
+			return
+		},
+	"shadow.entry.user": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlShadowEntry).User, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"shadow.entry.password": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlShadowEntry).Password, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"shadow.entry.lastchanged": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlShadowEntry).Lastchanged, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"shadow.entry.mindays": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlShadowEntry).Mindays, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"shadow.entry.maxdays": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlShadowEntry).Maxdays, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"shadow.entry.warndays": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlShadowEntry).Warndays, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"shadow.entry.inactivedays": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlShadowEntry).Inactivedays, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"shadow.entry.expirydates": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlShadowEntry).Expirydates, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"shadow.entry.reserved": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlShadowEntry).Reserved, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"yum.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlYum).__id, ok = v.Value.(string)
+			return
+		},
+	"yum.vars": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYum).Vars, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"yum.repos": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYum).Repos, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"yum.repo.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlYumRepo).__id, ok = v.Value.(string)
+			return
+		},
+	"yum.repo.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYumRepo).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"yum.repo.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYumRepo).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"yum.repo.status": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYumRepo).Status, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"yum.repo.baseurl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYumRepo).Baseurl, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"yum.repo.expire": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYumRepo).Expire, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"yum.repo.filename": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYumRepo).Filename, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"yum.repo.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYumRepo).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
+		return
+	},
+	"yum.repo.revision": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYumRepo).Revision, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"yum.repo.pkgs": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYumRepo).Pkgs, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"yum.repo.size": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYumRepo).Size, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"yum.repo.mirrors": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYumRepo).Mirrors, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"yum.repo.enabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYumRepo).Enabled, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"container.image.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlContainerImage).__id, ok = v.Value.(string)
+			return
+		},
+	"container.image.reference": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlContainerImage).Reference, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"container.image.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlContainerImage).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"container.image.identifier": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlContainerImage).Identifier, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"container.image.identifierType": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlContainerImage).IdentifierType, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"container.image.repository": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlContainerImage).Repository, ok = plugin.RawToTValue[*mqlContainerRepository](v.Value, v.Error)
+		return
+	},
+	"container.repository.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlContainerRepository).__id, ok = v.Value.(string)
+			return
+		},
+	"container.repository.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlContainerRepository).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"container.repository.scheme": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlContainerRepository).Scheme, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"container.repository.fullName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlContainerRepository).FullName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"container.repository.registry": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlContainerRepository).Registry, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+}
+
+func SetData(resource plugin.Resource, field string, val *llx.RawData) error {
+	f, ok := setDataFields[resource.MqlName() + "." + field]
+	if !ok {
+		return errors.New("[os] cannot set '"+field+"' in resource '"+resource.MqlName()+"', field not found")
+	}
+
+	if ok := f(resource, val); !ok {
+		return errors.New("[os] cannot set '"+field+"' in resource '"+resource.MqlName()+"', type does not match")
+	}
+	return nil
+}
+
+func SetAllData(resource plugin.Resource, args map[string]*llx.RawData) error {
+	var err error
+	for k, v := range args {
+		if err = SetData(resource, k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mqlAsset for the asset resource
+type mqlAsset struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlAssetInternal it will be used here
+	VulnerabilityReport plugin.TValue[interface{}]
+}
+
+// createAsset creates a new instance of this resource
+func createAsset(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAsset{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("asset", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlAsset) MqlName() string {
+	return "asset"
+}
+
+func (c *mqlAsset) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlAsset) GetVulnerabilityReport() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.VulnerabilityReport, func() (interface{}, error) {
+		return c.vulnerabilityReport()
+	})
+}
+
+// mqlAssetEol for the asset.eol resource
+type mqlAssetEol struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlAssetEolInternal it will be used here
+	DocsUrl plugin.TValue[string]
+	ProductUrl plugin.TValue[string]
+	Date plugin.TValue[*time.Time]
+}
+
+// createAssetEol creates a new instance of this resource
+func createAssetEol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAssetEol{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("asset.eol", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlAssetEol) MqlName() string {
+	return "asset.eol"
+}
+
+func (c *mqlAssetEol) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlAssetEol) GetDocsUrl() *plugin.TValue[string] {
+	return &c.DocsUrl
+}
+
+func (c *mqlAssetEol) GetProductUrl() *plugin.TValue[string] {
+	return &c.ProductUrl
+}
+
+func (c *mqlAssetEol) GetDate() *plugin.TValue[*time.Time] {
+	return &c.Date
+}
+
+// mqlMondooEol for the mondoo.eol resource
+type mqlMondooEol struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlMondooEolInternal it will be used here
+	Product plugin.TValue[string]
+	Version plugin.TValue[string]
+	Date plugin.TValue[*time.Time]
+}
+
+// createMondooEol creates a new instance of this resource
+func createMondooEol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlMondooEol{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("mondoo.eol", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlMondooEol) MqlName() string {
+	return "mondoo.eol"
+}
+
+func (c *mqlMondooEol) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlMondooEol) GetProduct() *plugin.TValue[string] {
+	return &c.Product
+}
+
+func (c *mqlMondooEol) GetVersion() *plugin.TValue[string] {
+	return &c.Version
+}
+
+func (c *mqlMondooEol) GetDate() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.Date, func() (*time.Time, error) {
+		return c.date()
+	})
+}
+
+// mqlPlatformEol for the platform.eol resource
+type mqlPlatformEol struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlPlatformEolInternal it will be used here
+	DocsUrl plugin.TValue[string]
+	ProductUrl plugin.TValue[string]
+	Date plugin.TValue[*time.Time]
+}
+
+// createPlatformEol creates a new instance of this resource
+func createPlatformEol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPlatformEol{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("platform.eol", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlPlatformEol) MqlName() string {
+	return "platform.eol"
+}
+
+func (c *mqlPlatformEol) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlPlatformEol) GetDocsUrl() *plugin.TValue[string] {
+	return &c.DocsUrl
+}
+
+func (c *mqlPlatformEol) GetProductUrl() *plugin.TValue[string] {
+	return &c.ProductUrl
+}
+
+func (c *mqlPlatformEol) GetDate() *plugin.TValue[*time.Time] {
+	return &c.Date
+}
+
+// mqlPlatformAdvisories for the platform.advisories resource
+type mqlPlatformAdvisories struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlPlatformAdvisoriesInternal it will be used here
+	Cvss plugin.TValue[*mqlAuditCvss]
+	Stats plugin.TValue[interface{}]
+	List plugin.TValue[[]interface{}]
+}
+
+// createPlatformAdvisories creates a new instance of this resource
+func createPlatformAdvisories(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPlatformAdvisories{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("platform.advisories", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlPlatformAdvisories) MqlName() string {
+	return "platform.advisories"
+}
+
+func (c *mqlPlatformAdvisories) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlPlatformAdvisories) GetCvss() *plugin.TValue[*mqlAuditCvss] {
+	return plugin.GetOrCompute[*mqlAuditCvss](&c.Cvss, func() (*mqlAuditCvss, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("platform.advisories", c.__id, "cvss")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlAuditCvss), nil
+			}
+		}
+
+		return c.cvss()
+	})
+}
+
+func (c *mqlPlatformAdvisories) GetStats() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Stats, func() (interface{}, error) {
+		return c.stats()
+	})
+}
+
+func (c *mqlPlatformAdvisories) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("platform.advisories", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.list()
+	})
+}
+
+// mqlPlatformCves for the platform.cves resource
+type mqlPlatformCves struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlPlatformCvesInternal it will be used here
+	Cvss plugin.TValue[*mqlAuditCvss]
+	Stats plugin.TValue[interface{}]
+	List plugin.TValue[[]interface{}]
+}
+
+// createPlatformCves creates a new instance of this resource
+func createPlatformCves(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPlatformCves{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("platform.cves", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlPlatformCves) MqlName() string {
+	return "platform.cves"
+}
+
+func (c *mqlPlatformCves) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlPlatformCves) GetCvss() *plugin.TValue[*mqlAuditCvss] {
+	return plugin.GetOrCompute[*mqlAuditCvss](&c.Cvss, func() (*mqlAuditCvss, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("platform.cves", c.__id, "cvss")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlAuditCvss), nil
+			}
+		}
+
+		return c.cvss()
+	})
+}
+
+func (c *mqlPlatformCves) GetStats() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Stats, func() (interface{}, error) {
+		return c.stats()
+	})
+}
+
+func (c *mqlPlatformCves) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("platform.cves", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.list()
+	})
+}
+
+// mqlAuditCvss for the audit.cvss resource
+type mqlAuditCvss struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlAuditCvssInternal it will be used here
+	Score plugin.TValue[float64]
+	Vector plugin.TValue[string]
+}
+
+// createAuditCvss creates a new instance of this resource
+func createAuditCvss(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAuditCvss{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("audit.cvss", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlAuditCvss) MqlName() string {
+	return "audit.cvss"
+}
+
+func (c *mqlAuditCvss) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlAuditCvss) GetScore() *plugin.TValue[float64] {
+	return &c.Score
+}
+
+func (c *mqlAuditCvss) GetVector() *plugin.TValue[string] {
+	return &c.Vector
+}
+
+// mqlAuditAdvisory for the audit.advisory resource
+type mqlAuditAdvisory struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlAuditAdvisoryInternal it will be used here
+	Id plugin.TValue[string]
+	Mrn plugin.TValue[string]
+	Title plugin.TValue[string]
+	Description plugin.TValue[string]
+	Published plugin.TValue[*time.Time]
+	Modified plugin.TValue[*time.Time]
+	WorstScore plugin.TValue[*mqlAuditCvss]
+}
+
+// createAuditAdvisory creates a new instance of this resource
+func createAuditAdvisory(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAuditAdvisory{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("audit.advisory", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlAuditAdvisory) MqlName() string {
+	return "audit.advisory"
+}
+
+func (c *mqlAuditAdvisory) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlAuditAdvisory) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlAuditAdvisory) GetMrn() *plugin.TValue[string] {
+	return &c.Mrn
+}
+
+func (c *mqlAuditAdvisory) GetTitle() *plugin.TValue[string] {
+	return &c.Title
+}
+
+func (c *mqlAuditAdvisory) GetDescription() *plugin.TValue[string] {
+	return &c.Description
+}
+
+func (c *mqlAuditAdvisory) GetPublished() *plugin.TValue[*time.Time] {
+	return &c.Published
+}
+
+func (c *mqlAuditAdvisory) GetModified() *plugin.TValue[*time.Time] {
+	return &c.Modified
+}
+
+func (c *mqlAuditAdvisory) GetWorstScore() *plugin.TValue[*mqlAuditCvss] {
+	return &c.WorstScore
+}
+
+// mqlAuditCve for the audit.cve resource
+type mqlAuditCve struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlAuditCveInternal it will be used here
+	Id plugin.TValue[string]
+	Mrn plugin.TValue[string]
+	State plugin.TValue[string]
+	Summary plugin.TValue[string]
+	Unscored plugin.TValue[bool]
+	Published plugin.TValue[*time.Time]
+	Modified plugin.TValue[*time.Time]
+	WorstScore plugin.TValue[*mqlAuditCvss]
+}
+
+// createAuditCve creates a new instance of this resource
+func createAuditCve(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAuditCve{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("audit.cve", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlAuditCve) MqlName() string {
+	return "audit.cve"
+}
+
+func (c *mqlAuditCve) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlAuditCve) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlAuditCve) GetMrn() *plugin.TValue[string] {
+	return &c.Mrn
+}
+
+func (c *mqlAuditCve) GetState() *plugin.TValue[string] {
+	return &c.State
+}
+
+func (c *mqlAuditCve) GetSummary() *plugin.TValue[string] {
+	return &c.Summary
+}
+
+func (c *mqlAuditCve) GetUnscored() *plugin.TValue[bool] {
+	return &c.Unscored
+}
+
+func (c *mqlAuditCve) GetPublished() *plugin.TValue[*time.Time] {
+	return &c.Published
+}
+
+func (c *mqlAuditCve) GetModified() *plugin.TValue[*time.Time] {
+	return &c.Modified
+}
+
+func (c *mqlAuditCve) GetWorstScore() *plugin.TValue[*mqlAuditCvss] {
+	return &c.WorstScore
+}
+
+// mqlMachine for the machine resource
+type mqlMachine struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlMachineInternal it will be used here
+}
+
+// createMachine creates a new instance of this resource
+func createMachine(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlMachine{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("machine", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlMachine) MqlName() string {
+	return "machine"
+}
+
+func (c *mqlMachine) MqlID() string {
+	return c.__id
+}
+
+// mqlMachineBios for the machine.bios resource
+type mqlMachineBios struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlMachineBiosInternal it will be used here
+	Vendor plugin.TValue[string]
+	Version plugin.TValue[string]
+	ReleaseDate plugin.TValue[string]
+}
+
+// createMachineBios creates a new instance of this resource
+func createMachineBios(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlMachineBios{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("machine.bios", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlMachineBios) MqlName() string {
+	return "machine.bios"
+}
+
+func (c *mqlMachineBios) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlMachineBios) GetVendor() *plugin.TValue[string] {
+	return &c.Vendor
+}
+
+func (c *mqlMachineBios) GetVersion() *plugin.TValue[string] {
+	return &c.Version
+}
+
+func (c *mqlMachineBios) GetReleaseDate() *plugin.TValue[string] {
+	return &c.ReleaseDate
+}
+
+// mqlMachineSystem for the machine.system resource
+type mqlMachineSystem struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlMachineSystemInternal it will be used here
+	Manufacturer plugin.TValue[string]
+	Product plugin.TValue[string]
+	Version plugin.TValue[string]
+	Serial plugin.TValue[string]
+	Uuid plugin.TValue[string]
+	Sku plugin.TValue[string]
+	Family plugin.TValue[string]
+}
+
+// createMachineSystem creates a new instance of this resource
+func createMachineSystem(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlMachineSystem{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("machine.system", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlMachineSystem) MqlName() string {
+	return "machine.system"
+}
+
+func (c *mqlMachineSystem) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlMachineSystem) GetManufacturer() *plugin.TValue[string] {
+	return &c.Manufacturer
+}
+
+func (c *mqlMachineSystem) GetProduct() *plugin.TValue[string] {
+	return &c.Product
+}
+
+func (c *mqlMachineSystem) GetVersion() *plugin.TValue[string] {
+	return &c.Version
+}
+
+func (c *mqlMachineSystem) GetSerial() *plugin.TValue[string] {
+	return &c.Serial
+}
+
+func (c *mqlMachineSystem) GetUuid() *plugin.TValue[string] {
+	return &c.Uuid
+}
+
+func (c *mqlMachineSystem) GetSku() *plugin.TValue[string] {
+	return &c.Sku
+}
+
+func (c *mqlMachineSystem) GetFamily() *plugin.TValue[string] {
+	return &c.Family
+}
+
+// mqlMachineBaseboard for the machine.baseboard resource
+type mqlMachineBaseboard struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlMachineBaseboardInternal it will be used here
+	Manufacturer plugin.TValue[string]
+	Product plugin.TValue[string]
+	Version plugin.TValue[string]
+	Serial plugin.TValue[string]
+	AssetTag plugin.TValue[string]
+}
+
+// createMachineBaseboard creates a new instance of this resource
+func createMachineBaseboard(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlMachineBaseboard{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("machine.baseboard", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlMachineBaseboard) MqlName() string {
+	return "machine.baseboard"
+}
+
+func (c *mqlMachineBaseboard) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlMachineBaseboard) GetManufacturer() *plugin.TValue[string] {
+	return &c.Manufacturer
+}
+
+func (c *mqlMachineBaseboard) GetProduct() *plugin.TValue[string] {
+	return &c.Product
+}
+
+func (c *mqlMachineBaseboard) GetVersion() *plugin.TValue[string] {
+	return &c.Version
+}
+
+func (c *mqlMachineBaseboard) GetSerial() *plugin.TValue[string] {
+	return &c.Serial
+}
+
+func (c *mqlMachineBaseboard) GetAssetTag() *plugin.TValue[string] {
+	return &c.AssetTag
+}
+
+// mqlMachineChassis for the machine.chassis resource
+type mqlMachineChassis struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlMachineChassisInternal it will be used here
+	Manufacturer plugin.TValue[string]
+	Version plugin.TValue[string]
+	Serial plugin.TValue[string]
+	AssetTag plugin.TValue[string]
+}
+
+// createMachineChassis creates a new instance of this resource
+func createMachineChassis(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlMachineChassis{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("machine.chassis", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlMachineChassis) MqlName() string {
+	return "machine.chassis"
+}
+
+func (c *mqlMachineChassis) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlMachineChassis) GetManufacturer() *plugin.TValue[string] {
+	return &c.Manufacturer
+}
+
+func (c *mqlMachineChassis) GetVersion() *plugin.TValue[string] {
+	return &c.Version
+}
+
+func (c *mqlMachineChassis) GetSerial() *plugin.TValue[string] {
+	return &c.Serial
+}
+
+func (c *mqlMachineChassis) GetAssetTag() *plugin.TValue[string] {
+	return &c.AssetTag
+}
+
+// mqlOs for the os resource
+type mqlOs struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlOsInternal it will be used here
+	Name plugin.TValue[string]
+	Env plugin.TValue[map[string]interface{}]
+	Path plugin.TValue[[]interface{}]
+	Uptime plugin.TValue[*time.Time]
+	Updates plugin.TValue[[]interface{}]
+	Rebootpending plugin.TValue[bool]
+	Hostname plugin.TValue[string]
+	Machineid plugin.TValue[string]
+}
+
+// createOs creates a new instance of this resource
+func createOs(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlOs{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("os", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlOs) MqlName() string {
+	return "os"
+}
+
+func (c *mqlOs) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlOs) GetName() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Name, func() (string, error) {
+		return c.name()
+	})
+}
+
+func (c *mqlOs) GetEnv() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Env, func() (map[string]interface{}, error) {
+		return c.env()
+	})
+}
+
+func (c *mqlOs) GetPath() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Path, func() ([]interface{}, error) {
+		vargEnv := c.GetEnv()
+		if vargEnv.Error != nil {
+			return nil, vargEnv.Error
+		}
+
+		return c.path(vargEnv.Data)
+	})
+}
+
+func (c *mqlOs) GetUptime() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.Uptime, func() (*time.Time, error) {
+		return c.uptime()
+	})
+}
+
+func (c *mqlOs) GetUpdates() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Updates, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("os", c.__id, "updates")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.updates()
+	})
+}
+
+func (c *mqlOs) GetRebootpending() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Rebootpending, func() (bool, error) {
+		return c.rebootpending()
+	})
+}
+
+func (c *mqlOs) GetHostname() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Hostname, func() (string, error) {
+		return c.hostname()
+	})
+}
+
+func (c *mqlOs) GetMachineid() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Machineid, func() (string, error) {
+		return c.machineid()
+	})
+}
+
+// mqlOsUpdate for the os.update resource
+type mqlOsUpdate struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlOsUpdateInternal it will be used here
+	Name plugin.TValue[string]
+	Category plugin.TValue[string]
+	Severity plugin.TValue[string]
+	Restart plugin.TValue[bool]
+	Format plugin.TValue[string]
+}
+
+// createOsUpdate creates a new instance of this resource
+func createOsUpdate(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlOsUpdate{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("os.update", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlOsUpdate) MqlName() string {
+	return "os.update"
+}
+
+func (c *mqlOsUpdate) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlOsUpdate) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlOsUpdate) GetCategory() *plugin.TValue[string] {
+	return &c.Category
+}
+
+func (c *mqlOsUpdate) GetSeverity() *plugin.TValue[string] {
+	return &c.Severity
+}
+
+func (c *mqlOsUpdate) GetRestart() *plugin.TValue[bool] {
+	return &c.Restart
+}
+
+func (c *mqlOsUpdate) GetFormat() *plugin.TValue[string] {
+	return &c.Format
+}
+
+// mqlOsBase for the os.base resource
+type mqlOsBase struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlOsBaseInternal it will be used here
+	Machine plugin.TValue[*mqlMachine]
+	Name plugin.TValue[string]
+	Env plugin.TValue[map[string]interface{}]
+	Path plugin.TValue[[]interface{}]
+	Uptime plugin.TValue[*time.Time]
+	Updates plugin.TValue[[]interface{}]
+	Rebootpending plugin.TValue[bool]
+	Hostname plugin.TValue[string]
+	Groups plugin.TValue[*mqlGroups]
+	Users plugin.TValue[*mqlUsers]
+}
+
+// createOsBase creates a new instance of this resource
+func createOsBase(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlOsBase{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("os.base", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlOsBase) MqlName() string {
+	return "os.base"
+}
+
+func (c *mqlOsBase) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlOsBase) GetMachine() *plugin.TValue[*mqlMachine] {
+	return plugin.GetOrCompute[*mqlMachine](&c.Machine, func() (*mqlMachine, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("os.base", c.__id, "machine")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlMachine), nil
+			}
+		}
+
+		return c.machine()
+	})
+}
+
+func (c *mqlOsBase) GetName() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Name, func() (string, error) {
+		return c.name()
+	})
+}
+
+func (c *mqlOsBase) GetEnv() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Env, func() (map[string]interface{}, error) {
+		return c.env()
+	})
+}
+
+func (c *mqlOsBase) GetPath() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Path, func() ([]interface{}, error) {
+		vargEnv := c.GetEnv()
+		if vargEnv.Error != nil {
+			return nil, vargEnv.Error
+		}
+
+		return c.path(vargEnv.Data)
+	})
+}
+
+func (c *mqlOsBase) GetUptime() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.Uptime, func() (*time.Time, error) {
+		return c.uptime()
+	})
+}
+
+func (c *mqlOsBase) GetUpdates() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Updates, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("os.base", c.__id, "updates")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.updates()
+	})
+}
+
+func (c *mqlOsBase) GetRebootpending() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Rebootpending, func() (bool, error) {
+		return c.rebootpending()
+	})
+}
+
+func (c *mqlOsBase) GetHostname() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Hostname, func() (string, error) {
+		return c.hostname()
+	})
 }
 
-func SetData(resource plugin.Resource, field string, val *llx.RawData) error {
-	f, ok := setDataFields[resource.MqlName() + "." + field]
-	if !ok {
-		return errors.New("[os] cannot set '"+field+"' in resource '"+resource.MqlName()+"', field not found")
-	}
+func (c *mqlOsBase) GetGroups() *plugin.TValue[*mqlGroups] {
+	return plugin.GetOrCompute[*mqlGroups](&c.Groups, func() (*mqlGroups, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("os.base", c.__id, "groups")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlGroups), nil
+			}
+		}
 
-	if ok := f(resource, val); !ok {
-		return errors.New("[os] cannot set '"+field+"' in resource '"+resource.MqlName()+"', type does not match")
-	}
-	return nil
+		return c.groups()
+	})
 }
 
-func SetAllData(resource plugin.Resource, args map[string]*llx.RawData) error {
-	var err error
-	for k, v := range args {
-		if err = SetData(resource, k, v); err != nil {
-			return err
+func (c *mqlOsBase) GetUsers() *plugin.TValue[*mqlUsers] {
+	return plugin.GetOrCompute[*mqlUsers](&c.Users, func() (*mqlUsers, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("os.base", c.__id, "users")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlUsers), nil
+			}
 		}
-	}
-	return nil
+
+		return c.users()
+	})
 }
 
-// mqlAsset for the asset resource
-type mqlAsset struct {
+// mqlOsUnix for the os.unix resource
+type mqlOsUnix struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlAssetInternal it will be used here
-	VulnerabilityReport plugin.TValue[interface{}]
+	// optional: if you define mqlOsUnixInternal it will be used here
+	Base plugin.TValue[*mqlOsBase]
 }
 
-// createAsset creates a new instance of this resource
-func createAsset(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlAsset{
+// createOsUnix creates a new instance of this resource
+func createOsUnix(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlOsUnix{
 		MqlRuntime: runtime,
 	}
 
@@ -3110,10 +5379,15 @@ func createAsset(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("asset", res.__id)
+		args, err = runtime.ResourceFromRecording("os.unix", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3123,33 +5397,43 @@ func createAsset(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 	return res, nil
 }
 
-func (c *mqlAsset) MqlName() string {
-	return "asset"
+func (c *mqlOsUnix) MqlName() string {
+	return "os.unix"
 }
 
-func (c *mqlAsset) MqlID() string {
+func (c *mqlOsUnix) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlAsset) GetVulnerabilityReport() *plugin.TValue[interface{}] {
-	return plugin.GetOrCompute[interface{}](&c.VulnerabilityReport, func() (interface{}, error) {
-		return c.vulnerabilityReport()
+func (c *mqlOsUnix) GetBase() *plugin.TValue[*mqlOsBase] {
+	return plugin.GetOrCompute[*mqlOsBase](&c.Base, func() (*mqlOsBase, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("os.unix", c.__id, "base")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlOsBase), nil
+			}
+		}
+
+		return c.base()
 	})
 }
 
-// mqlAssetEol for the asset.eol resource
-type mqlAssetEol struct {
+// mqlOsLinux for the os.linux resource
+type mqlOsLinux struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlAssetEolInternal it will be used here
-	DocsUrl plugin.TValue[string]
-	ProductUrl plugin.TValue[string]
-	Date plugin.TValue[*time.Time]
+	// optional: if you define mqlOsLinuxInternal it will be used here
+	Unix plugin.TValue[*mqlOsUnix]
+	Iptables plugin.TValue[*mqlIptables]
+	Ip6tables plugin.TValue[*mqlIp6tables]
 }
 
-// createAssetEol creates a new instance of this resource
-func createAssetEol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlAssetEol{
+// createOsLinux creates a new instance of this resource
+func createOsLinux(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlOsLinux{
 		MqlRuntime: runtime,
 	}
 
@@ -3158,10 +5442,15 @@ func createAssetEol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("asset.eol", res.__id)
+		args, err = runtime.ResourceFromRecording("os.linux", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3171,39 +5460,75 @@ func createAssetEol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	return res, nil
 }
 
-func (c *mqlAssetEol) MqlName() string {
-	return "asset.eol"
+func (c *mqlOsLinux) MqlName() string {
+	return "os.linux"
 }
 
-func (c *mqlAssetEol) MqlID() string {
+func (c *mqlOsLinux) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlAssetEol) GetDocsUrl() *plugin.TValue[string] {
-	return &c.DocsUrl
+func (c *mqlOsLinux) GetUnix() *plugin.TValue[*mqlOsUnix] {
+	return plugin.GetOrCompute[*mqlOsUnix](&c.Unix, func() (*mqlOsUnix, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("os.linux", c.__id, "unix")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlOsUnix), nil
+			}
+		}
+
+		return c.unix()
+	})
 }
 
-func (c *mqlAssetEol) GetProductUrl() *plugin.TValue[string] {
-	return &c.ProductUrl
+func (c *mqlOsLinux) GetIptables() *plugin.TValue[*mqlIptables] {
+	return plugin.GetOrCompute[*mqlIptables](&c.Iptables, func() (*mqlIptables, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("os.linux", c.__id, "iptables")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlIptables), nil
+			}
+		}
+
+		return c.iptables()
+	})
 }
 
-func (c *mqlAssetEol) GetDate() *plugin.TValue[*time.Time] {
-	return &c.Date
+func (c *mqlOsLinux) GetIp6tables() *plugin.TValue[*mqlIp6tables] {
+	return plugin.GetOrCompute[*mqlIp6tables](&c.Ip6tables, func() (*mqlIp6tables, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("os.linux", c.__id, "ip6tables")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlIp6tables), nil
+			}
+		}
+
+		return c.ip6tables()
+	})
 }
 
-// mqlMondooEol for the mondoo.eol resource
-type mqlMondooEol struct {
+// mqlOsRootCertificates for the os.rootCertificates resource
+type mqlOsRootCertificates struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlMondooEolInternal it will be used here
-	Product plugin.TValue[string]
-	Version plugin.TValue[string]
-	Date plugin.TValue[*time.Time]
+	// optional: if you define mqlOsRootCertificatesInternal it will be used here
+	Files plugin.TValue[[]interface{}]
+	Content plugin.TValue[[]interface{}]
+	List plugin.TValue[[]interface{}]
 }
 
-// createMondooEol creates a new instance of this resource
-func createMondooEol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlMondooEol{
+// createOsRootCertificates creates a new instance of this resource
+func createOsRootCertificates(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlOsRootCertificates{
 		MqlRuntime: runtime,
 	}
 
@@ -3212,13 +5537,15 @@ func createMondooEol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("mondoo.eol", res.__id)
+		args, err = runtime.ResourceFromRecording("os.rootCertificates", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3228,41 +5555,64 @@ func createMondooEol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	return res, nil
 }
 
-func (c *mqlMondooEol) MqlName() string {
-	return "mondoo.eol"
+func (c *mqlOsRootCertificates) MqlName() string {
+	return "os.rootCertificates"
 }
 
-func (c *mqlMondooEol) MqlID() string {
+func (c *mqlOsRootCertificates) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlMondooEol) GetProduct() *plugin.TValue[string] {
-	return &c.Product
+func (c *mqlOsRootCertificates) GetFiles() *plugin.TValue[[]interface{}] {
+	return &c.Files
 }
 
-func (c *mqlMondooEol) GetVersion() *plugin.TValue[string] {
-	return &c.Version
+func (c *mqlOsRootCertificates) GetContent() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Content, func() ([]interface{}, error) {
+		vargFiles := c.GetFiles()
+		if vargFiles.Error != nil {
+			return nil, vargFiles.Error
+		}
+
+		return c.content(vargFiles.Data)
+	})
 }
 
-func (c *mqlMondooEol) GetDate() *plugin.TValue[*time.Time] {
-	return plugin.GetOrCompute[*time.Time](&c.Date, func() (*time.Time, error) {
-		return c.date()
+func (c *mqlOsRootCertificates) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("os.rootCertificates", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
+		}
+
+		return c.list(vargContent.Data)
 	})
 }
 
-// mqlPlatformEol for the platform.eol resource
-type mqlPlatformEol struct {
+// mqlCommand for the command resource
+type mqlCommand struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlPlatformEolInternal it will be used here
-	DocsUrl plugin.TValue[string]
-	ProductUrl plugin.TValue[string]
-	Date plugin.TValue[*time.Time]
+	mqlCommandInternal
+	Command plugin.TValue[string]
+	Stdout plugin.TValue[string]
+	Stderr plugin.TValue[string]
+	Exitcode plugin.TValue[int64]
 }
 
-// createPlatformEol creates a new instance of this resource
-func createPlatformEol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPlatformEol{
+// createCommand creates a new instance of this resource
+func createCommand(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlCommand{
 		MqlRuntime: runtime,
 	}
 
@@ -3271,10 +5621,15 @@ func createPlatformEol(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("platform.eol", res.__id)
+		args, err = runtime.ResourceFromRecording("command", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3284,39 +5639,65 @@ func createPlatformEol(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	return res, nil
 }
 
-func (c *mqlPlatformEol) MqlName() string {
-	return "platform.eol"
+func (c *mqlCommand) MqlName() string {
+	return "command"
 }
 
-func (c *mqlPlatformEol) MqlID() string {
+func (c *mqlCommand) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPlatformEol) GetDocsUrl() *plugin.TValue[string] {
-	return &c.DocsUrl
+func (c *mqlCommand) GetCommand() *plugin.TValue[string] {
+	return &c.Command
 }
 
-func (c *mqlPlatformEol) GetProductUrl() *plugin.TValue[string] {
-	return &c.ProductUrl
+func (c *mqlCommand) GetStdout() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Stdout, func() (string, error) {
+		vargCommand := c.GetCommand()
+		if vargCommand.Error != nil {
+			return "", vargCommand.Error
+		}
+
+		return c.stdout(vargCommand.Data)
+	})
 }
 
-func (c *mqlPlatformEol) GetDate() *plugin.TValue[*time.Time] {
-	return &c.Date
+func (c *mqlCommand) GetStderr() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Stderr, func() (string, error) {
+		vargCommand := c.GetCommand()
+		if vargCommand.Error != nil {
+			return "", vargCommand.Error
+		}
+
+		return c.stderr(vargCommand.Data)
+	})
 }
 
-// mqlPlatformAdvisories for the platform.advisories resource
-type mqlPlatformAdvisories struct {
+func (c *mqlCommand) GetExitcode() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.Exitcode, func() (int64, error) {
+		vargCommand := c.GetCommand()
+		if vargCommand.Error != nil {
+			return 0, vargCommand.Error
+		}
+
+		return c.exitcode(vargCommand.Data)
+	})
+}
+
+// mqlPowershell for the powershell resource
+type mqlPowershell struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlPlatformAdvisoriesInternal it will be used here
-	Cvss plugin.TValue[*mqlAuditCvss]
-	Stats plugin.TValue[interface{}]
-	List plugin.TValue[[]interface{}]
+	mqlPowershellInternal
+	Script plugin.TValue[string]
+	Stdout plugin.TValue[string]
+	Stderr plugin.TValue[string]
+	Exitcode plugin.TValue[int64]
 }
 
-// createPlatformAdvisories creates a new instance of this resource
-func createPlatformAdvisories(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPlatformAdvisories{
+// createPowershell creates a new instance of this resource
+func createPowershell(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPowershell{
 		MqlRuntime: runtime,
 	}
 
@@ -3325,13 +5706,15 @@ func createPlatformAdvisories(runtime *plugin.Runtime, args map[string]*llx.RawD
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("platform.advisories", res.__id)
+		args, err = runtime.ResourceFromRecording("powershell", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3341,65 +5724,56 @@ func createPlatformAdvisories(runtime *plugin.Runtime, args map[string]*llx.RawD
 	return res, nil
 }
 
-func (c *mqlPlatformAdvisories) MqlName() string {
-	return "platform.advisories"
+func (c *mqlPowershell) MqlName() string {
+	return "powershell"
 }
 
-func (c *mqlPlatformAdvisories) MqlID() string {
+func (c *mqlPowershell) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPlatformAdvisories) GetCvss() *plugin.TValue[*mqlAuditCvss] {
-	return plugin.GetOrCompute[*mqlAuditCvss](&c.Cvss, func() (*mqlAuditCvss, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("platform.advisories", c.__id, "cvss")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlAuditCvss), nil
-			}
-		}
+func (c *mqlPowershell) GetScript() *plugin.TValue[string] {
+	return &c.Script
+}
 
-		return c.cvss()
+func (c *mqlPowershell) GetStdout() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Stdout, func() (string, error) {
+		return c.stdout()
 	})
 }
 
-func (c *mqlPlatformAdvisories) GetStats() *plugin.TValue[interface{}] {
-	return plugin.GetOrCompute[interface{}](&c.Stats, func() (interface{}, error) {
-		return c.stats()
+func (c *mqlPowershell) GetStderr() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Stderr, func() (string, error) {
+		return c.stderr()
 	})
 }
 
-func (c *mqlPlatformAdvisories) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("platform.advisories", c.__id, "list")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		return c.list()
+func (c *mqlPowershell) GetExitcode() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.Exitcode, func() (int64, error) {
+		return c.exitcode()
 	})
 }
 
-// mqlPlatformCves for the platform.cves resource
-type mqlPlatformCves struct {
+// mqlFile for the file resource
+type mqlFile struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlPlatformCvesInternal it will be used here
-	Cvss plugin.TValue[*mqlAuditCvss]
-	Stats plugin.TValue[interface{}]
-	List plugin.TValue[[]interface{}]
+	// optional: if you define mqlFileInternal it will be used here
+	Path plugin.TValue[string]
+	Basename plugin.TValue[string]
+	Dirname plugin.TValue[string]
+	Content plugin.TValue[string]
+	Exists plugin.TValue[bool]
+	Permissions plugin.TValue[*mqlFilePermissions]
+	Size plugin.TValue[int64]
+	User plugin.TValue[*mqlUser]
+	Group plugin.TValue[*mqlGroup]
+	Empty plugin.TValue[bool]
 }
 
-// createPlatformCves creates a new instance of this resource
-func createPlatformCves(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPlatformCves{
+// createFile creates a new instance of this resource
+func createFile(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlFile{
 		MqlRuntime: runtime,
 	}
 
@@ -3408,13 +5782,15 @@ func createPlatformCves(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("platform.cves", res.__id)
+		args, err = runtime.ResourceFromRecording("file", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3424,64 +5800,169 @@ func createPlatformCves(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	return res, nil
 }
 
-func (c *mqlPlatformCves) MqlName() string {
-	return "platform.cves"
+func (c *mqlFile) MqlName() string {
+	return "file"
 }
 
-func (c *mqlPlatformCves) MqlID() string {
+func (c *mqlFile) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPlatformCves) GetCvss() *plugin.TValue[*mqlAuditCvss] {
-	return plugin.GetOrCompute[*mqlAuditCvss](&c.Cvss, func() (*mqlAuditCvss, error) {
+func (c *mqlFile) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlFile) GetBasename() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Basename, func() (string, error) {
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return "", vargPath.Error
+		}
+
+		return c.basename(vargPath.Data)
+	})
+}
+
+func (c *mqlFile) GetDirname() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Dirname, func() (string, error) {
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return "", vargPath.Error
+		}
+
+		return c.dirname(vargPath.Data)
+	})
+}
+
+func (c *mqlFile) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return "", vargPath.Error
+		}
+
+		vargExists := c.GetExists()
+		if vargExists.Error != nil {
+			return "", vargExists.Error
+		}
+
+		return c.content(vargPath.Data, vargExists.Data)
+	})
+}
+
+func (c *mqlFile) GetExists() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Exists, func() (bool, error) {
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return false, vargPath.Error
+		}
+
+		return c.exists(vargPath.Data)
+	})
+}
+
+func (c *mqlFile) GetPermissions() *plugin.TValue[*mqlFilePermissions] {
+	return plugin.GetOrCompute[*mqlFilePermissions](&c.Permissions, func() (*mqlFilePermissions, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("platform.cves", c.__id, "cvss")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("file", c.__id, "permissions")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlFilePermissions), nil
+			}
+		}
+
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return nil, vargPath.Error
+		}
+
+		return c.permissions(vargPath.Data)
+	})
+}
+
+func (c *mqlFile) GetSize() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.Size, func() (int64, error) {
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return 0, vargPath.Error
+		}
+
+		return c.size(vargPath.Data)
+	})
+}
+
+func (c *mqlFile) GetUser() *plugin.TValue[*mqlUser] {
+	return plugin.GetOrCompute[*mqlUser](&c.User, func() (*mqlUser, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("file", c.__id, "user")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(*mqlAuditCvss), nil
+				return d.Value.(*mqlUser), nil
 			}
 		}
 
-		return c.cvss()
-	})
-}
-
-func (c *mqlPlatformCves) GetStats() *plugin.TValue[interface{}] {
-	return plugin.GetOrCompute[interface{}](&c.Stats, func() (interface{}, error) {
-		return c.stats()
+		return c.user()
 	})
 }
 
-func (c *mqlPlatformCves) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+func (c *mqlFile) GetGroup() *plugin.TValue[*mqlGroup] {
+	return plugin.GetOrCompute[*mqlGroup](&c.Group, func() (*mqlGroup, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("platform.cves", c.__id, "list")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("file", c.__id, "group")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.([]interface{}), nil
+				return d.Value.(*mqlGroup), nil
 			}
 		}
 
-		return c.list()
+		return c.group()
 	})
 }
 
-// mqlAuditCvss for the audit.cvss resource
-type mqlAuditCvss struct {
+func (c *mqlFile) GetEmpty() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Empty, func() (bool, error) {
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return false, vargPath.Error
+		}
+
+		return c.empty(vargPath.Data)
+	})
+}
+
+// mqlFilePermissions for the file.permissions resource
+type mqlFilePermissions struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlAuditCvssInternal it will be used here
-	Score plugin.TValue[float64]
-	Vector plugin.TValue[string]
+	// optional: if you define mqlFilePermissionsInternal it will be used here
+	Mode plugin.TValue[int64]
+	User_readable plugin.TValue[bool]
+	User_writeable plugin.TValue[bool]
+	User_executable plugin.TValue[bool]
+	Group_readable plugin.TValue[bool]
+	Group_writeable plugin.TValue[bool]
+	Group_executable plugin.TValue[bool]
+	Other_readable plugin.TValue[bool]
+	Other_writeable plugin.TValue[bool]
+	Other_executable plugin.TValue[bool]
+	Suid plugin.TValue[bool]
+	Sgid plugin.TValue[bool]
+	Sticky plugin.TValue[bool]
+	IsDirectory plugin.TValue[bool]
+	IsFile plugin.TValue[bool]
+	IsSymlink plugin.TValue[bool]
+	String plugin.TValue[string]
 }
 
-// createAuditCvss creates a new instance of this resource
-func createAuditCvss(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlAuditCvss{
+// createFilePermissions creates a new instance of this resource
+func createFilePermissions(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlFilePermissions{
 		MqlRuntime: runtime,
 	}
 
@@ -3490,10 +5971,15 @@ func createAuditCvss(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("audit.cvss", res.__id)
+		args, err = runtime.ResourceFromRecording("file.permissions", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3503,114 +5989,94 @@ func createAuditCvss(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	return res, nil
 }
 
-func (c *mqlAuditCvss) MqlName() string {
-	return "audit.cvss"
+func (c *mqlFilePermissions) MqlName() string {
+	return "file.permissions"
 }
 
-func (c *mqlAuditCvss) MqlID() string {
+func (c *mqlFilePermissions) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlAuditCvss) GetScore() *plugin.TValue[float64] {
-	return &c.Score
+func (c *mqlFilePermissions) GetMode() *plugin.TValue[int64] {
+	return &c.Mode
 }
 
-func (c *mqlAuditCvss) GetVector() *plugin.TValue[string] {
-	return &c.Vector
+func (c *mqlFilePermissions) GetUser_readable() *plugin.TValue[bool] {
+	return &c.User_readable
 }
 
-// mqlAuditAdvisory for the audit.advisory resource
-type mqlAuditAdvisory struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	// optional: if you define mqlAuditAdvisoryInternal it will be used here
-	Id plugin.TValue[string]
-	Mrn plugin.TValue[string]
-	Title plugin.TValue[string]
-	Description plugin.TValue[string]
-	Published plugin.TValue[*time.Time]
-	Modified plugin.TValue[*time.Time]
-	WorstScore plugin.TValue[*mqlAuditCvss]
+func (c *mqlFilePermissions) GetUser_writeable() *plugin.TValue[bool] {
+	return &c.User_writeable
 }
 
-// createAuditAdvisory creates a new instance of this resource
-func createAuditAdvisory(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlAuditAdvisory{
-		MqlRuntime: runtime,
-	}
+func (c *mqlFilePermissions) GetUser_executable() *plugin.TValue[bool] {
+	return &c.User_executable
+}
 
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
-	}
+func (c *mqlFilePermissions) GetGroup_readable() *plugin.TValue[bool] {
+	return &c.Group_readable
+}
 
-	// to override __id implement: id() (string, error)
+func (c *mqlFilePermissions) GetGroup_writeable() *plugin.TValue[bool] {
+	return &c.Group_writeable
+}
 
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("audit.advisory", res.__id)
-		if err != nil || args == nil {
-			return res, err
-		}
-		return res, SetAllData(res, args)
-	}
+func (c *mqlFilePermissions) GetGroup_executable() *plugin.TValue[bool] {
+	return &c.Group_executable
+}
 
-	return res, nil
+func (c *mqlFilePermissions) GetOther_readable() *plugin.TValue[bool] {
+	return &c.Other_readable
 }
 
-func (c *mqlAuditAdvisory) MqlName() string {
-	return "audit.advisory"
+func (c *mqlFilePermissions) GetOther_writeable() *plugin.TValue[bool] {
+	return &c.Other_writeable
 }
 
-func (c *mqlAuditAdvisory) MqlID() string {
-	return c.__id
+func (c *mqlFilePermissions) GetOther_executable() *plugin.TValue[bool] {
+	return &c.Other_executable
 }
 
-func (c *mqlAuditAdvisory) GetId() *plugin.TValue[string] {
-	return &c.Id
+func (c *mqlFilePermissions) GetSuid() *plugin.TValue[bool] {
+	return &c.Suid
 }
 
-func (c *mqlAuditAdvisory) GetMrn() *plugin.TValue[string] {
-	return &c.Mrn
+func (c *mqlFilePermissions) GetSgid() *plugin.TValue[bool] {
+	return &c.Sgid
 }
 
-func (c *mqlAuditAdvisory) GetTitle() *plugin.TValue[string] {
-	return &c.Title
+func (c *mqlFilePermissions) GetSticky() *plugin.TValue[bool] {
+	return &c.Sticky
 }
 
-func (c *mqlAuditAdvisory) GetDescription() *plugin.TValue[string] {
-	return &c.Description
+func (c *mqlFilePermissions) GetIsDirectory() *plugin.TValue[bool] {
+	return &c.IsDirectory
 }
 
-func (c *mqlAuditAdvisory) GetPublished() *plugin.TValue[*time.Time] {
-	return &c.Published
+func (c *mqlFilePermissions) GetIsFile() *plugin.TValue[bool] {
+	return &c.IsFile
 }
 
-func (c *mqlAuditAdvisory) GetModified() *plugin.TValue[*time.Time] {
-	return &c.Modified
+func (c *mqlFilePermissions) GetIsSymlink() *plugin.TValue[bool] {
+	return &c.IsSymlink
 }
 
-func (c *mqlAuditAdvisory) GetWorstScore() *plugin.TValue[*mqlAuditCvss] {
-	return &c.WorstScore
+func (c *mqlFilePermissions) GetString() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.String, func() (string, error) {
+		return c.string()
+	})
 }
 
-// mqlAuditCve for the audit.cve resource
-type mqlAuditCve struct {
+// mqlFiles for the files resource
+type mqlFiles struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlAuditCveInternal it will be used here
-	Id plugin.TValue[string]
-	Mrn plugin.TValue[string]
-	State plugin.TValue[string]
-	Summary plugin.TValue[string]
-	Unscored plugin.TValue[bool]
-	Published plugin.TValue[*time.Time]
-	Modified plugin.TValue[*time.Time]
-	WorstScore plugin.TValue[*mqlAuditCvss]
+	// optional: if you define mqlFilesInternal it will be used here
 }
 
-// createAuditCve creates a new instance of this resource
-func createAuditCve(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlAuditCve{
+// createFiles creates a new instance of this resource
+func createFiles(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlFiles{
 		MqlRuntime: runtime,
 	}
 
@@ -3622,7 +6088,7 @@ func createAuditCve(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("audit.cve", res.__id)
+		args, err = runtime.ResourceFromRecording("files", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3632,56 +6098,31 @@ func createAuditCve(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	return res, nil
 }
 
-func (c *mqlAuditCve) MqlName() string {
-	return "audit.cve"
+func (c *mqlFiles) MqlName() string {
+	return "files"
 }
 
-func (c *mqlAuditCve) MqlID() string {
+func (c *mqlFiles) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlAuditCve) GetId() *plugin.TValue[string] {
-	return &c.Id
-}
-
-func (c *mqlAuditCve) GetMrn() *plugin.TValue[string] {
-	return &c.Mrn
-}
-
-func (c *mqlAuditCve) GetState() *plugin.TValue[string] {
-	return &c.State
-}
-
-func (c *mqlAuditCve) GetSummary() *plugin.TValue[string] {
-	return &c.Summary
-}
-
-func (c *mqlAuditCve) GetUnscored() *plugin.TValue[bool] {
-	return &c.Unscored
-}
-
-func (c *mqlAuditCve) GetPublished() *plugin.TValue[*time.Time] {
-	return &c.Published
-}
-
-func (c *mqlAuditCve) GetModified() *plugin.TValue[*time.Time] {
-	return &c.Modified
-}
-
-func (c *mqlAuditCve) GetWorstScore() *plugin.TValue[*mqlAuditCvss] {
-	return &c.WorstScore
-}
-
-// mqlMachine for the machine resource
-type mqlMachine struct {
+// mqlFilesFind for the files.find resource
+type mqlFilesFind struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlMachineInternal it will be used here
+	// optional: if you define mqlFilesFindInternal it will be used here
+	From plugin.TValue[string]
+	Xdev plugin.TValue[bool]
+	Type plugin.TValue[string]
+	Regex plugin.TValue[string]
+	Permissions plugin.TValue[int64]
+	Name plugin.TValue[string]
+	List plugin.TValue[[]interface{}]
 }
 
-// createMachine creates a new instance of this resource
-func createMachine(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlMachine{
+// createFilesFind creates a new instance of this resource
+func createFilesFind(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlFilesFind{
 		MqlRuntime: runtime,
 	}
 
@@ -3690,10 +6131,15 @@ func createMachine(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("machine", res.__id)
+		args, err = runtime.ResourceFromRecording("files.find", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3703,27 +6149,69 @@ func createMachine(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	return res, nil
 }
 
-func (c *mqlMachine) MqlName() string {
-	return "machine"
+func (c *mqlFilesFind) MqlName() string {
+	return "files.find"
 }
 
-func (c *mqlMachine) MqlID() string {
+func (c *mqlFilesFind) MqlID() string {
 	return c.__id
 }
 
-// mqlMachineBios for the machine.bios resource
-type mqlMachineBios struct {
+func (c *mqlFilesFind) GetFrom() *plugin.TValue[string] {
+	return &c.From
+}
+
+func (c *mqlFilesFind) GetXdev() *plugin.TValue[bool] {
+	return &c.Xdev
+}
+
+func (c *mqlFilesFind) GetType() *plugin.TValue[string] {
+	return &c.Type
+}
+
+func (c *mqlFilesFind) GetRegex() *plugin.TValue[string] {
+	return &c.Regex
+}
+
+func (c *mqlFilesFind) GetPermissions() *plugin.TValue[int64] {
+	return &c.Permissions
+}
+
+func (c *mqlFilesFind) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlFilesFind) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("files.find", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.list()
+	})
+}
+
+// mqlParseIni for the parse.ini resource
+type mqlParseIni struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlMachineBiosInternal it will be used here
-	Vendor plugin.TValue[string]
-	Version plugin.TValue[string]
-	ReleaseDate plugin.TValue[string]
+	// optional: if you define mqlParseIniInternal it will be used here
+	Delimiter plugin.TValue[string]
+	File plugin.TValue[*mqlFile]
+	Content plugin.TValue[string]
+	Sections plugin.TValue[map[string]interface{}]
+	Params plugin.TValue[map[string]interface{}]
 }
 
-// createMachineBios creates a new instance of this resource
-func createMachineBios(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlMachineBios{
+// createParseIni creates a new instance of this resource
+func createParseIni(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlParseIni{
 		MqlRuntime: runtime,
 	}
 
@@ -3732,10 +6220,15 @@ func createMachineBios(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("machine.bios", res.__id)
+		args, err = runtime.ResourceFromRecording("parse.ini", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3745,43 +6238,73 @@ func createMachineBios(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	return res, nil
 }
 
-func (c *mqlMachineBios) MqlName() string {
-	return "machine.bios"
+func (c *mqlParseIni) MqlName() string {
+	return "parse.ini"
 }
 
-func (c *mqlMachineBios) MqlID() string {
+func (c *mqlParseIni) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlMachineBios) GetVendor() *plugin.TValue[string] {
-	return &c.Vendor
+func (c *mqlParseIni) GetDelimiter() *plugin.TValue[string] {
+	return &c.Delimiter
 }
 
-func (c *mqlMachineBios) GetVersion() *plugin.TValue[string] {
-	return &c.Version
+func (c *mqlParseIni) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
 }
 
-func (c *mqlMachineBios) GetReleaseDate() *plugin.TValue[string] {
-	return &c.ReleaseDate
+func (c *mqlParseIni) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return "", vargFile.Error
+		}
+
+		return c.content(vargFile.Data)
+	})
 }
 
-// mqlMachineSystem for the machine.system resource
-type mqlMachineSystem struct {
+func (c *mqlParseIni) GetSections() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Sections, func() (map[string]interface{}, error) {
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
+		}
+
+		vargDelimiter := c.GetDelimiter()
+		if vargDelimiter.Error != nil {
+			return nil, vargDelimiter.Error
+		}
+
+		return c.sections(vargContent.Data, vargDelimiter.Data)
+	})
+}
+
+func (c *mqlParseIni) GetParams() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Params, func() (map[string]interface{}, error) {
+		vargSections := c.GetSections()
+		if vargSections.Error != nil {
+			return nil, vargSections.Error
+		}
+
+		return c.params(vargSections.Data)
+	})
+}
+
+// mqlParseJson for the parse.json resource
+type mqlParseJson struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlMachineSystemInternal it will be used here
-	Manufacturer plugin.TValue[string]
-	Product plugin.TValue[string]
-	Version plugin.TValue[string]
-	Serial plugin.TValue[string]
-	Uuid plugin.TValue[string]
-	Sku plugin.TValue[string]
-	Family plugin.TValue[string]
+	// optional: if you define mqlParseJsonInternal it will be used here
+	File plugin.TValue[*mqlFile]
+	Content plugin.TValue[string]
+	Params plugin.TValue[interface{}]
 }
 
-// createMachineSystem creates a new instance of this resource
-func createMachineSystem(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlMachineSystem{
+// createParseJson creates a new instance of this resource
+func createParseJson(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlParseJson{
 		MqlRuntime: runtime,
 	}
 
@@ -3790,10 +6313,15 @@ func createMachineSystem(runtime *plugin.Runtime, args map[string]*llx.RawData)
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("machine.system", res.__id)
+		args, err = runtime.ResourceFromRecording("parse.json", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3803,57 +6331,53 @@ func createMachineSystem(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlMachineSystem) MqlName() string {
-	return "machine.system"
+func (c *mqlParseJson) MqlName() string {
+	return "parse.json"
 }
 
-func (c *mqlMachineSystem) MqlID() string {
+func (c *mqlParseJson) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlMachineSystem) GetManufacturer() *plugin.TValue[string] {
-	return &c.Manufacturer
-}
-
-func (c *mqlMachineSystem) GetProduct() *plugin.TValue[string] {
-	return &c.Product
-}
-
-func (c *mqlMachineSystem) GetVersion() *plugin.TValue[string] {
-	return &c.Version
+func (c *mqlParseJson) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
 }
 
-func (c *mqlMachineSystem) GetSerial() *plugin.TValue[string] {
-	return &c.Serial
-}
+func (c *mqlParseJson) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return "", vargFile.Error
+		}
 
-func (c *mqlMachineSystem) GetUuid() *plugin.TValue[string] {
-	return &c.Uuid
+		return c.content(vargFile.Data)
+	})
 }
 
-func (c *mqlMachineSystem) GetSku() *plugin.TValue[string] {
-	return &c.Sku
-}
+func (c *mqlParseJson) GetParams() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Params, func() (interface{}, error) {
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
+		}
 
-func (c *mqlMachineSystem) GetFamily() *plugin.TValue[string] {
-	return &c.Family
+		return c.params(vargContent.Data)
+	})
 }
 
-// mqlMachineBaseboard for the machine.baseboard resource
-type mqlMachineBaseboard struct {
+// mqlParsePlist for the parse.plist resource
+type mqlParsePlist struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlMachineBaseboardInternal it will be used here
-	Manufacturer plugin.TValue[string]
-	Product plugin.TValue[string]
-	Version plugin.TValue[string]
-	Serial plugin.TValue[string]
-	AssetTag plugin.TValue[string]
+	// optional: if you define mqlParsePlistInternal it will be used here
+	File plugin.TValue[*mqlFile]
+	Content plugin.TValue[string]
+	Params plugin.TValue[interface{}]
 }
 
-// createMachineBaseboard creates a new instance of this resource
-func createMachineBaseboard(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlMachineBaseboard{
+// createParsePlist creates a new instance of this resource
+func createParsePlist(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlParsePlist{
 		MqlRuntime: runtime,
 	}
 
@@ -3862,10 +6386,15 @@ func createMachineBaseboard(runtime *plugin.Runtime, args map[string]*llx.RawDat
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("machine.baseboard", res.__id)
+		args, err = runtime.ResourceFromRecording("parse.plist", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3875,48 +6404,53 @@ func createMachineBaseboard(runtime *plugin.Runtime, args map[string]*llx.RawDat
 	return res, nil
 }
 
-func (c *mqlMachineBaseboard) MqlName() string {
-	return "machine.baseboard"
+func (c *mqlParsePlist) MqlName() string {
+	return "parse.plist"
 }
 
-func (c *mqlMachineBaseboard) MqlID() string {
+func (c *mqlParsePlist) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlMachineBaseboard) GetManufacturer() *plugin.TValue[string] {
-	return &c.Manufacturer
-}
-
-func (c *mqlMachineBaseboard) GetProduct() *plugin.TValue[string] {
-	return &c.Product
+func (c *mqlParsePlist) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
 }
 
-func (c *mqlMachineBaseboard) GetVersion() *plugin.TValue[string] {
-	return &c.Version
-}
+func (c *mqlParsePlist) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return "", vargFile.Error
+		}
 
-func (c *mqlMachineBaseboard) GetSerial() *plugin.TValue[string] {
-	return &c.Serial
+		return c.content(vargFile.Data)
+	})
 }
 
-func (c *mqlMachineBaseboard) GetAssetTag() *plugin.TValue[string] {
-	return &c.AssetTag
+func (c *mqlParsePlist) GetParams() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Params, func() (interface{}, error) {
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
+		}
+
+		return c.params(vargContent.Data)
+	})
 }
 
-// mqlMachineChassis for the machine.chassis resource
-type mqlMachineChassis struct {
+// mqlParseYaml for the parse.yaml resource
+type mqlParseYaml struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlMachineChassisInternal it will be used here
-	Manufacturer plugin.TValue[string]
-	Version plugin.TValue[string]
-	Serial plugin.TValue[string]
-	AssetTag plugin.TValue[string]
+	// optional: if you define mqlParseYamlInternal it will be used here
+	File plugin.TValue[*mqlFile]
+	Content plugin.TValue[string]
+	Params plugin.TValue[interface{}]
 }
 
-// createMachineChassis creates a new instance of this resource
-func createMachineChassis(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlMachineChassis{
+// createParseYaml creates a new instance of this resource
+func createParseYaml(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlParseYaml{
 		MqlRuntime: runtime,
 	}
 
@@ -3925,10 +6459,15 @@ func createMachineChassis(runtime *plugin.Runtime, args map[string]*llx.RawData)
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("machine.chassis", res.__id)
+		args, err = runtime.ResourceFromRecording("parse.yaml", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3938,48 +6477,54 @@ func createMachineChassis(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlMachineChassis) MqlName() string {
-	return "machine.chassis"
+func (c *mqlParseYaml) MqlName() string {
+	return "parse.yaml"
 }
 
-func (c *mqlMachineChassis) MqlID() string {
+func (c *mqlParseYaml) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlMachineChassis) GetManufacturer() *plugin.TValue[string] {
-	return &c.Manufacturer
+func (c *mqlParseYaml) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
 }
 
-func (c *mqlMachineChassis) GetVersion() *plugin.TValue[string] {
-	return &c.Version
-}
+func (c *mqlParseYaml) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return "", vargFile.Error
+		}
 
-func (c *mqlMachineChassis) GetSerial() *plugin.TValue[string] {
-	return &c.Serial
+		return c.content(vargFile.Data)
+	})
 }
 
-func (c *mqlMachineChassis) GetAssetTag() *plugin.TValue[string] {
-	return &c.AssetTag
+func (c *mqlParseYaml) GetParams() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Params, func() (interface{}, error) {
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
+		}
+
+		return c.params(vargContent.Data)
+	})
 }
 
-// mqlOs for the os resource
-type mqlOs struct {
+// mqlParseCertificates for the parse.certificates resource
+type mqlParseCertificates struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlOsInternal it will be used here
-	Name plugin.TValue[string]
-	Env plugin.TValue[map[string]interface{}]
-	Path plugin.TValue[[]interface{}]
-	Uptime plugin.TValue[*time.Time]
-	Updates plugin.TValue[[]interface{}]
-	Rebootpending plugin.TValue[bool]
-	Hostname plugin.TValue[string]
-	Machineid plugin.TValue[string]
+	// optional: if you define mqlParseCertificatesInternal it will be used here
+	Path plugin.TValue[string]
+	File plugin.TValue[*mqlFile]
+	Content plugin.TValue[string]
+	List plugin.TValue[[]interface{}]
 }
 
-// createOs creates a new instance of this resource
-func createOs(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlOs{
+// createParseCertificates creates a new instance of this resource
+func createParseCertificates(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlParseCertificates{
 		MqlRuntime: runtime,
 	}
 
@@ -3988,10 +6533,15 @@ func createOs(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Res
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("os", res.__id)
+		args, err = runtime.ResourceFromRecording("parse.certificates", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -4001,47 +6551,49 @@ func createOs(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Res
 	return res, nil
 }
 
-func (c *mqlOs) MqlName() string {
-	return "os"
+func (c *mqlParseCertificates) MqlName() string {
+	return "parse.certificates"
 }
 
-func (c *mqlOs) MqlID() string {
+func (c *mqlParseCertificates) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlOs) GetName() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Name, func() (string, error) {
-		return c.name()
-	})
-}
-
-func (c *mqlOs) GetEnv() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Env, func() (map[string]interface{}, error) {
-		return c.env()
-	})
+func (c *mqlParseCertificates) GetPath() *plugin.TValue[string] {
+	return &c.Path
 }
 
-func (c *mqlOs) GetPath() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Path, func() ([]interface{}, error) {
-		vargEnv := c.GetEnv()
-		if vargEnv.Error != nil {
-			return nil, vargEnv.Error
+func (c *mqlParseCertificates) GetFile() *plugin.TValue[*mqlFile] {
+	return plugin.GetOrCompute[*mqlFile](&c.File, func() (*mqlFile, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("parse.certificates", c.__id, "file")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlFile), nil
+			}
 		}
 
-		return c.path(vargEnv.Data)
+		return c.file()
 	})
 }
 
-func (c *mqlOs) GetUptime() *plugin.TValue[*time.Time] {
-	return plugin.GetOrCompute[*time.Time](&c.Uptime, func() (*time.Time, error) {
-		return c.uptime()
+func (c *mqlParseCertificates) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return "", vargFile.Error
+		}
+
+		return c.content(vargFile.Data)
 	})
 }
 
-func (c *mqlOs) GetUpdates() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Updates, func() ([]interface{}, error) {
+func (c *mqlParseCertificates) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("os", c.__id, "updates")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("parse.certificates", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
@@ -4050,43 +6602,34 @@ func (c *mqlOs) GetUpdates() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.updates()
-	})
-}
-
-func (c *mqlOs) GetRebootpending() *plugin.TValue[bool] {
-	return plugin.GetOrCompute[bool](&c.Rebootpending, func() (bool, error) {
-		return c.rebootpending()
-	})
-}
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
+		}
 
-func (c *mqlOs) GetHostname() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Hostname, func() (string, error) {
-		return c.hostname()
-	})
-}
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return nil, vargPath.Error
+		}
 
-func (c *mqlOs) GetMachineid() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Machineid, func() (string, error) {
-		return c.machineid()
+		return c.list(vargContent.Data, vargPath.Data)
 	})
 }
 
-// mqlOsUpdate for the os.update resource
-type mqlOsUpdate struct {
+// mqlParseOpenpgp for the parse.openpgp resource
+type mqlParseOpenpgp struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlOsUpdateInternal it will be used here
-	Name plugin.TValue[string]
-	Category plugin.TValue[string]
-	Severity plugin.TValue[string]
-	Restart plugin.TValue[bool]
-	Format plugin.TValue[string]
+	// optional: if you define mqlParseOpenpgpInternal it will be used here
+	Path plugin.TValue[string]
+	File plugin.TValue[*mqlFile]
+	Content plugin.TValue[string]
+	List plugin.TValue[[]interface{}]
 }
 
-// createOsUpdate creates a new instance of this resource
-func createOsUpdate(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlOsUpdate{
+// createParseOpenpgp creates a new instance of this resource
+func createParseOpenpgp(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlParseOpenpgp{
 		MqlRuntime: runtime,
 	}
 
@@ -4095,13 +6638,15 @@ func createOsUpdate(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("os.update", res.__id)
+		args, err = runtime.ResourceFromRecording("parse.openpgp", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -4111,54 +6656,74 @@ func createOsUpdate(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	return res, nil
 }
 
-func (c *mqlOsUpdate) MqlName() string {
-	return "os.update"
+func (c *mqlParseOpenpgp) MqlName() string {
+	return "parse.openpgp"
 }
 
-func (c *mqlOsUpdate) MqlID() string {
+func (c *mqlParseOpenpgp) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlOsUpdate) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlParseOpenpgp) GetPath() *plugin.TValue[string] {
+	return &c.Path
 }
 
-func (c *mqlOsUpdate) GetCategory() *plugin.TValue[string] {
-	return &c.Category
+func (c *mqlParseOpenpgp) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
 }
 
-func (c *mqlOsUpdate) GetSeverity() *plugin.TValue[string] {
-	return &c.Severity
-}
+func (c *mqlParseOpenpgp) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return "", vargFile.Error
+		}
 
-func (c *mqlOsUpdate) GetRestart() *plugin.TValue[bool] {
-	return &c.Restart
+		return c.content(vargFile.Data)
+	})
 }
 
-func (c *mqlOsUpdate) GetFormat() *plugin.TValue[string] {
-	return &c.Format
+func (c *mqlParseOpenpgp) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("parse.openpgp", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
+		}
+
+		return c.list(vargContent.Data)
+	})
 }
 
-// mqlOsBase for the os.base resource
-type mqlOsBase struct {
+// mqlUser for the user resource
+type mqlUser struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlOsBaseInternal it will be used here
-	Machine plugin.TValue[*mqlMachine]
+	// optional: if you define mqlUserInternal it will be used here
+	Uid plugin.TValue[int64]
+	Gid plugin.TValue[int64]
+	Sid plugin.TValue[string]
 	Name plugin.TValue[string]
-	Env plugin.TValue[map[string]interface{}]
-	Path plugin.TValue[[]interface{}]
-	Uptime plugin.TValue[*time.Time]
-	Updates plugin.TValue[[]interface{}]
-	Rebootpending plugin.TValue[bool]
-	Hostname plugin.TValue[string]
-	Groups plugin.TValue[*mqlGroups]
-	Users plugin.TValue[*mqlUsers]
+	Home plugin.TValue[string]
+	Shell plugin.TValue[string]
+	Enabled plugin.TValue[bool]
+	Authorizedkeys plugin.TValue[*mqlAuthorizedkeys]
+	Sshkeys plugin.TValue[[]interface{}]
+	Group plugin.TValue[*mqlGroup]
 }
 
-// createOsBase creates a new instance of this resource
-func createOsBase(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlOsBase{
+// createUser creates a new instance of this resource
+func createUser(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlUser{
 		MqlRuntime: runtime,
 	}
 
@@ -4167,13 +6732,15 @@ func createOsBase(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("os.base", res.__id)
+		args, err = runtime.ResourceFromRecording("user", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -4183,191 +6750,114 @@ func createOsBase(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	return res, nil
 }
 
-func (c *mqlOsBase) MqlName() string {
-	return "os.base"
+func (c *mqlUser) MqlName() string {
+	return "user"
 }
 
-func (c *mqlOsBase) MqlID() string {
+func (c *mqlUser) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlOsBase) GetMachine() *plugin.TValue[*mqlMachine] {
-	return plugin.GetOrCompute[*mqlMachine](&c.Machine, func() (*mqlMachine, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("os.base", c.__id, "machine")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlMachine), nil
-			}
-		}
+func (c *mqlUser) GetUid() *plugin.TValue[int64] {
+	return &c.Uid
+}
 
-		return c.machine()
-	})
+func (c *mqlUser) GetGid() *plugin.TValue[int64] {
+	return &c.Gid
 }
 
-func (c *mqlOsBase) GetName() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Name, func() (string, error) {
-		return c.name()
-	})
+func (c *mqlUser) GetSid() *plugin.TValue[string] {
+	return &c.Sid
 }
 
-func (c *mqlOsBase) GetEnv() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Env, func() (map[string]interface{}, error) {
-		return c.env()
-	})
+func (c *mqlUser) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlOsBase) GetPath() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Path, func() ([]interface{}, error) {
-		vargEnv := c.GetEnv()
-		if vargEnv.Error != nil {
-			return nil, vargEnv.Error
-		}
+func (c *mqlUser) GetHome() *plugin.TValue[string] {
+	return &c.Home
+}
 
-		return c.path(vargEnv.Data)
-	})
+func (c *mqlUser) GetShell() *plugin.TValue[string] {
+	return &c.Shell
 }
 
-func (c *mqlOsBase) GetUptime() *plugin.TValue[*time.Time] {
-	return plugin.GetOrCompute[*time.Time](&c.Uptime, func() (*time.Time, error) {
-		return c.uptime()
-	})
+func (c *mqlUser) GetEnabled() *plugin.TValue[bool] {
+	return &c.Enabled
 }
 
-func (c *mqlOsBase) GetUpdates() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Updates, func() ([]interface{}, error) {
+func (c *mqlUser) GetAuthorizedkeys() *plugin.TValue[*mqlAuthorizedkeys] {
+	return plugin.GetOrCompute[*mqlAuthorizedkeys](&c.Authorizedkeys, func() (*mqlAuthorizedkeys, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("os.base", c.__id, "updates")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("user", c.__id, "authorizedkeys")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.([]interface{}), nil
+				return d.Value.(*mqlAuthorizedkeys), nil
 			}
 		}
 
-		return c.updates()
-	})
-}
-
-func (c *mqlOsBase) GetRebootpending() *plugin.TValue[bool] {
-	return plugin.GetOrCompute[bool](&c.Rebootpending, func() (bool, error) {
-		return c.rebootpending()
-	})
-}
+		vargHome := c.GetHome()
+		if vargHome.Error != nil {
+			return nil, vargHome.Error
+		}
 
-func (c *mqlOsBase) GetHostname() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Hostname, func() (string, error) {
-		return c.hostname()
+		return c.authorizedkeys(vargHome.Data)
 	})
 }
 
-func (c *mqlOsBase) GetGroups() *plugin.TValue[*mqlGroups] {
-	return plugin.GetOrCompute[*mqlGroups](&c.Groups, func() (*mqlGroups, error) {
+func (c *mqlUser) GetSshkeys() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Sshkeys, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("os.base", c.__id, "groups")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("user", c.__id, "sshkeys")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(*mqlGroups), nil
+				return d.Value.([]interface{}), nil
 			}
 		}
 
-		return c.groups()
+		return c.sshkeys()
 	})
 }
 
-func (c *mqlOsBase) GetUsers() *plugin.TValue[*mqlUsers] {
-	return plugin.GetOrCompute[*mqlUsers](&c.Users, func() (*mqlUsers, error) {
+func (c *mqlUser) GetGroup() *plugin.TValue[*mqlGroup] {
+	return plugin.GetOrCompute[*mqlGroup](&c.Group, func() (*mqlGroup, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("os.base", c.__id, "users")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("user", c.__id, "group")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(*mqlUsers), nil
+				return d.Value.(*mqlGroup), nil
 			}
 		}
 
-		return c.users()
-	})
-}
-
-// mqlOsUnix for the os.unix resource
-type mqlOsUnix struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	// optional: if you define mqlOsUnixInternal it will be used here
-	Base plugin.TValue[*mqlOsBase]
-}
-
-// createOsUnix creates a new instance of this resource
-func createOsUnix(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlOsUnix{
-		MqlRuntime: runtime,
-	}
-
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
-	}
-
-	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
-	}
-
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("os.unix", res.__id)
-		if err != nil || args == nil {
-			return res, err
-		}
-		return res, SetAllData(res, args)
-	}
-
-	return res, nil
-}
-
-func (c *mqlOsUnix) MqlName() string {
-	return "os.unix"
-}
-
-func (c *mqlOsUnix) MqlID() string {
-	return c.__id
-}
-
-func (c *mqlOsUnix) GetBase() *plugin.TValue[*mqlOsBase] {
-	return plugin.GetOrCompute[*mqlOsBase](&c.Base, func() (*mqlOsBase, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("os.unix", c.__id, "base")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlOsBase), nil
-			}
+		vargGid := c.GetGid()
+		if vargGid.Error != nil {
+			return nil, vargGid.Error
 		}
 
-		return c.base()
+		return c.group(vargGid.Data)
 	})
 }
 
-// mqlOsLinux for the os.linux resource
-type mqlOsLinux struct {
+// mqlPrivatekey for the privatekey resource
+type mqlPrivatekey struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlOsLinuxInternal it will be used here
-	Unix plugin.TValue[*mqlOsUnix]
-	Iptables plugin.TValue[*mqlIptables]
-	Ip6tables plugin.TValue[*mqlIp6tables]
+	// optional: if you define mqlPrivatekeyInternal it will be used here
+	Pem plugin.TValue[string]
+	Path plugin.TValue[string]
+	File plugin.TValue[*mqlFile]
+	Encrypted plugin.TValue[bool]
 }
 
-// createOsLinux creates a new instance of this resource
-func createOsLinux(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlOsLinux{
+// createPrivatekey creates a new instance of this resource
+func createPrivatekey(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPrivatekey{
 		MqlRuntime: runtime,
 	}
 
@@ -4376,13 +6866,15 @@ func createOsLinux(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("os.linux", res.__id)
+		args, err = runtime.ResourceFromRecording("privatekey", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -4392,75 +6884,41 @@ func createOsLinux(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	return res, nil
 }
 
-func (c *mqlOsLinux) MqlName() string {
-	return "os.linux"
+func (c *mqlPrivatekey) MqlName() string {
+	return "privatekey"
 }
 
-func (c *mqlOsLinux) MqlID() string {
+func (c *mqlPrivatekey) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlOsLinux) GetUnix() *plugin.TValue[*mqlOsUnix] {
-	return plugin.GetOrCompute[*mqlOsUnix](&c.Unix, func() (*mqlOsUnix, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("os.linux", c.__id, "unix")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlOsUnix), nil
-			}
-		}
-
-		return c.unix()
-	})
+func (c *mqlPrivatekey) GetPem() *plugin.TValue[string] {
+	return &c.Pem
 }
 
-func (c *mqlOsLinux) GetIptables() *plugin.TValue[*mqlIptables] {
-	return plugin.GetOrCompute[*mqlIptables](&c.Iptables, func() (*mqlIptables, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("os.linux", c.__id, "iptables")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlIptables), nil
-			}
-		}
-
-		return c.iptables()
-	})
+func (c *mqlPrivatekey) GetPath() *plugin.TValue[string] {
+	return &c.Path
 }
 
-func (c *mqlOsLinux) GetIp6tables() *plugin.TValue[*mqlIp6tables] {
-	return plugin.GetOrCompute[*mqlIp6tables](&c.Ip6tables, func() (*mqlIp6tables, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("os.linux", c.__id, "ip6tables")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlIp6tables), nil
-			}
-		}
+func (c *mqlPrivatekey) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
+}
 
-		return c.ip6tables()
-	})
+func (c *mqlPrivatekey) GetEncrypted() *plugin.TValue[bool] {
+	return &c.Encrypted
 }
 
-// mqlOsRootCertificates for the os.rootCertificates resource
-type mqlOsRootCertificates struct {
+// mqlUsers for the users resource
+type mqlUsers struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlOsRootCertificatesInternal it will be used here
-	Files plugin.TValue[[]interface{}]
-	Content plugin.TValue[[]interface{}]
+	mqlUsersInternal
 	List plugin.TValue[[]interface{}]
 }
 
-// createOsRootCertificates creates a new instance of this resource
-func createOsRootCertificates(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlOsRootCertificates{
+// createUsers creates a new instance of this resource
+func createUsers(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlUsers{
 		MqlRuntime: runtime,
 	}
 
@@ -4469,13 +6927,10 @@ func createOsRootCertificates(runtime *plugin.Runtime, args map[string]*llx.RawD
 		return res, err
 	}
 
-	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("os.rootCertificates", res.__id)
+		args, err = runtime.ResourceFromRecording("users", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -4485,33 +6940,18 @@ func createOsRootCertificates(runtime *plugin.Runtime, args map[string]*llx.RawD
 	return res, nil
 }
 
-func (c *mqlOsRootCertificates) MqlName() string {
-	return "os.rootCertificates"
+func (c *mqlUsers) MqlName() string {
+	return "users"
 }
 
-func (c *mqlOsRootCertificates) MqlID() string {
+func (c *mqlUsers) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlOsRootCertificates) GetFiles() *plugin.TValue[[]interface{}] {
-	return &c.Files
-}
-
-func (c *mqlOsRootCertificates) GetContent() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Content, func() ([]interface{}, error) {
-		vargFiles := c.GetFiles()
-		if vargFiles.Error != nil {
-			return nil, vargFiles.Error
-		}
-
-		return c.content(vargFiles.Data)
-	})
-}
-
-func (c *mqlOsRootCertificates) GetList() *plugin.TValue[[]interface{}] {
+func (c *mqlUsers) GetList() *plugin.TValue[[]interface{}] {
 	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("os.rootCertificates", c.__id, "list")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("users", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
@@ -4520,29 +6960,24 @@ func (c *mqlOsRootCertificates) GetList() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		vargContent := c.GetContent()
-		if vargContent.Error != nil {
-			return nil, vargContent.Error
-		}
-
-		return c.list(vargContent.Data)
+		return c.list()
 	})
 }
 
-// mqlCommand for the command resource
-type mqlCommand struct {
+// mqlAuthorizedkeys for the authorizedkeys resource
+type mqlAuthorizedkeys struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlCommandInternal
-	Command plugin.TValue[string]
-	Stdout plugin.TValue[string]
-	Stderr plugin.TValue[string]
-	Exitcode plugin.TValue[int64]
+	// optional: if you define mqlAuthorizedkeysInternal it will be used here
+	Path plugin.TValue[string]
+	File plugin.TValue[*mqlFile]
+	Content plugin.TValue[string]
+	List plugin.TValue[[]interface{}]
 }
 
-// createCommand creates a new instance of this resource
-func createCommand(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlCommand{
+// createAuthorizedkeys creates a new instance of this resource
+func createAuthorizedkeys(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAuthorizedkeys{
 		MqlRuntime: runtime,
 	}
 
@@ -4551,13 +6986,15 @@ func createCommand(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("command", res.__id)
+		args, err = runtime.ResourceFromRecording("authorizedkeys", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -4567,65 +7004,75 @@ func createCommand(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	return res, nil
 }
 
-func (c *mqlCommand) MqlName() string {
-	return "command"
+func (c *mqlAuthorizedkeys) MqlName() string {
+	return "authorizedkeys"
 }
 
-func (c *mqlCommand) MqlID() string {
+func (c *mqlAuthorizedkeys) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlCommand) GetCommand() *plugin.TValue[string] {
-	return &c.Command
+func (c *mqlAuthorizedkeys) GetPath() *plugin.TValue[string] {
+	return &c.Path
 }
 
-func (c *mqlCommand) GetStdout() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Stdout, func() (string, error) {
-		vargCommand := c.GetCommand()
-		if vargCommand.Error != nil {
-			return "", vargCommand.Error
+func (c *mqlAuthorizedkeys) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
+}
+
+func (c *mqlAuthorizedkeys) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return "", vargFile.Error
 		}
 
-		return c.stdout(vargCommand.Data)
+		return c.content(vargFile.Data)
 	})
 }
 
-func (c *mqlCommand) GetStderr() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Stderr, func() (string, error) {
-		vargCommand := c.GetCommand()
-		if vargCommand.Error != nil {
-			return "", vargCommand.Error
+func (c *mqlAuthorizedkeys) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("authorizedkeys", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
 		}
 
-		return c.stderr(vargCommand.Data)
-	})
-}
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return nil, vargFile.Error
+		}
 
-func (c *mqlCommand) GetExitcode() *plugin.TValue[int64] {
-	return plugin.GetOrCompute[int64](&c.Exitcode, func() (int64, error) {
-		vargCommand := c.GetCommand()
-		if vargCommand.Error != nil {
-			return 0, vargCommand.Error
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
 		}
 
-		return c.exitcode(vargCommand.Data)
+		return c.list(vargFile.Data, vargContent.Data)
 	})
 }
 
-// mqlPowershell for the powershell resource
-type mqlPowershell struct {
+// mqlAuthorizedkeysEntry for the authorizedkeys.entry resource
+type mqlAuthorizedkeysEntry struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlPowershellInternal
-	Script plugin.TValue[string]
-	Stdout plugin.TValue[string]
-	Stderr plugin.TValue[string]
-	Exitcode plugin.TValue[int64]
+	// optional: if you define mqlAuthorizedkeysEntryInternal it will be used here
+	Line plugin.TValue[int64]
+	Type plugin.TValue[string]
+	Key plugin.TValue[string]
+	Label plugin.TValue[string]
+	Options plugin.TValue[[]interface{}]
+	File plugin.TValue[*mqlFile]
 }
 
-// createPowershell creates a new instance of this resource
-func createPowershell(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPowershell{
+// createAuthorizedkeysEntry creates a new instance of this resource
+func createAuthorizedkeysEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAuthorizedkeysEntry{
 		MqlRuntime: runtime,
 	}
 
@@ -4634,13 +7081,15 @@ func createPowershell(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("powershell", res.__id)
+		args, err = runtime.ResourceFromRecording("authorizedkeys.entry", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -4650,56 +7099,52 @@ func createPowershell(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	return res, nil
 }
 
-func (c *mqlPowershell) MqlName() string {
-	return "powershell"
+func (c *mqlAuthorizedkeysEntry) MqlName() string {
+	return "authorizedkeys.entry"
 }
 
-func (c *mqlPowershell) MqlID() string {
+func (c *mqlAuthorizedkeysEntry) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPowershell) GetScript() *plugin.TValue[string] {
-	return &c.Script
+func (c *mqlAuthorizedkeysEntry) GetLine() *plugin.TValue[int64] {
+	return &c.Line
 }
 
-func (c *mqlPowershell) GetStdout() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Stdout, func() (string, error) {
-		return c.stdout()
-	})
+func (c *mqlAuthorizedkeysEntry) GetType() *plugin.TValue[string] {
+	return &c.Type
 }
 
-func (c *mqlPowershell) GetStderr() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Stderr, func() (string, error) {
-		return c.stderr()
-	})
+func (c *mqlAuthorizedkeysEntry) GetKey() *plugin.TValue[string] {
+	return &c.Key
 }
 
-func (c *mqlPowershell) GetExitcode() *plugin.TValue[int64] {
-	return plugin.GetOrCompute[int64](&c.Exitcode, func() (int64, error) {
-		return c.exitcode()
-	})
+func (c *mqlAuthorizedkeysEntry) GetLabel() *plugin.TValue[string] {
+	return &c.Label
 }
 
-// mqlFile for the file resource
-type mqlFile struct {
+func (c *mqlAuthorizedkeysEntry) GetOptions() *plugin.TValue[[]interface{}] {
+	return &c.Options
+}
+
+func (c *mqlAuthorizedkeysEntry) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
+}
+
+// mqlGroup for the group resource
+type mqlGroup struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlFileInternal it will be used here
-	Path plugin.TValue[string]
-	Basename plugin.TValue[string]
-	Dirname plugin.TValue[string]
-	Content plugin.TValue[string]
-	Exists plugin.TValue[bool]
-	Permissions plugin.TValue[*mqlFilePermissions]
-	Size plugin.TValue[int64]
-	User plugin.TValue[*mqlUser]
-	Group plugin.TValue[*mqlGroup]
-	Empty plugin.TValue[bool]
+	mqlGroupInternal
+	Gid plugin.TValue[int64]
+	Sid plugin.TValue[string]
+	Name plugin.TValue[string]
+	Members plugin.TValue[[]interface{}]
 }
 
-// createFile creates a new instance of this resource
-func createFile(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlFile{
+// createGroup creates a new instance of this resource
+func createGroup(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlGroup{
 		MqlRuntime: runtime,
 	}
 
@@ -4708,13 +7153,15 @@ func createFile(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.R
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("file", res.__id)
+		args, err = runtime.ResourceFromRecording("group", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -4724,169 +7171,119 @@ func createFile(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.R
 	return res, nil
 }
 
-func (c *mqlFile) MqlName() string {
-	return "file"
+func (c *mqlGroup) MqlName() string {
+	return "group"
 }
 
-func (c *mqlFile) MqlID() string {
+func (c *mqlGroup) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlFile) GetPath() *plugin.TValue[string] {
-	return &c.Path
-}
-
-func (c *mqlFile) GetBasename() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Basename, func() (string, error) {
-		vargPath := c.GetPath()
-		if vargPath.Error != nil {
-			return "", vargPath.Error
-		}
-
-		return c.basename(vargPath.Data)
-	})
-}
-
-func (c *mqlFile) GetDirname() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Dirname, func() (string, error) {
-		vargPath := c.GetPath()
-		if vargPath.Error != nil {
-			return "", vargPath.Error
-		}
-
-		return c.dirname(vargPath.Data)
-	})
+func (c *mqlGroup) GetGid() *plugin.TValue[int64] {
+	return &c.Gid
 }
 
-func (c *mqlFile) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargPath := c.GetPath()
-		if vargPath.Error != nil {
-			return "", vargPath.Error
-		}
-
-		vargExists := c.GetExists()
-		if vargExists.Error != nil {
-			return "", vargExists.Error
-		}
-
-		return c.content(vargPath.Data, vargExists.Data)
-	})
+func (c *mqlGroup) GetSid() *plugin.TValue[string] {
+	return &c.Sid
 }
 
-func (c *mqlFile) GetExists() *plugin.TValue[bool] {
-	return plugin.GetOrCompute[bool](&c.Exists, func() (bool, error) {
-		vargPath := c.GetPath()
-		if vargPath.Error != nil {
-			return false, vargPath.Error
-		}
-
-		return c.exists(vargPath.Data)
-	})
+func (c *mqlGroup) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlFile) GetPermissions() *plugin.TValue[*mqlFilePermissions] {
-	return plugin.GetOrCompute[*mqlFilePermissions](&c.Permissions, func() (*mqlFilePermissions, error) {
+func (c *mqlGroup) GetMembers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Members, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("file", c.__id, "permissions")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("group", c.__id, "members")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(*mqlFilePermissions), nil
+				return d.Value.([]interface{}), nil
 			}
 		}
 
-		vargPath := c.GetPath()
-		if vargPath.Error != nil {
-			return nil, vargPath.Error
-		}
-
-		return c.permissions(vargPath.Data)
+		return c.members()
 	})
 }
 
-func (c *mqlFile) GetSize() *plugin.TValue[int64] {
-	return plugin.GetOrCompute[int64](&c.Size, func() (int64, error) {
-		vargPath := c.GetPath()
-		if vargPath.Error != nil {
-			return 0, vargPath.Error
+// mqlGroups for the groups resource
+type mqlGroups struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlGroupsInternal
+	List plugin.TValue[[]interface{}]
+}
+
+// createGroups creates a new instance of this resource
+func createGroups(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlGroups{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("groups", res.__id)
+		if err != nil || args == nil {
+			return res, err
 		}
+		return res, SetAllData(res, args)
+	}
 
-		return c.size(vargPath.Data)
-	})
+	return res, nil
 }
 
-func (c *mqlFile) GetUser() *plugin.TValue[*mqlUser] {
-	return plugin.GetOrCompute[*mqlUser](&c.User, func() (*mqlUser, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("file", c.__id, "user")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlUser), nil
-			}
-		}
+func (c *mqlGroups) MqlName() string {
+	return "groups"
+}
 
-		return c.user()
-	})
+func (c *mqlGroups) MqlID() string {
+	return c.__id
 }
 
-func (c *mqlFile) GetGroup() *plugin.TValue[*mqlGroup] {
-	return plugin.GetOrCompute[*mqlGroup](&c.Group, func() (*mqlGroup, error) {
+func (c *mqlGroups) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("file", c.__id, "group")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("groups", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(*mqlGroup), nil
+				return d.Value.([]interface{}), nil
 			}
 		}
 
-		return c.group()
-	})
-}
-
-func (c *mqlFile) GetEmpty() *plugin.TValue[bool] {
-	return plugin.GetOrCompute[bool](&c.Empty, func() (bool, error) {
-		vargPath := c.GetPath()
-		if vargPath.Error != nil {
-			return false, vargPath.Error
-		}
-
-		return c.empty(vargPath.Data)
+		return c.list()
 	})
 }
 
-// mqlFilePermissions for the file.permissions resource
-type mqlFilePermissions struct {
+// mqlPackage for the package resource
+type mqlPackage struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlFilePermissionsInternal it will be used here
-	Mode plugin.TValue[int64]
-	User_readable plugin.TValue[bool]
-	User_writeable plugin.TValue[bool]
-	User_executable plugin.TValue[bool]
-	Group_readable plugin.TValue[bool]
-	Group_writeable plugin.TValue[bool]
-	Group_executable plugin.TValue[bool]
-	Other_readable plugin.TValue[bool]
-	Other_writeable plugin.TValue[bool]
-	Other_executable plugin.TValue[bool]
-	Suid plugin.TValue[bool]
-	Sgid plugin.TValue[bool]
-	Sticky plugin.TValue[bool]
-	IsDirectory plugin.TValue[bool]
-	IsFile plugin.TValue[bool]
-	IsSymlink plugin.TValue[bool]
-	String plugin.TValue[string]
+	// optional: if you define mqlPackageInternal it will be used here
+	Name plugin.TValue[string]
+	Version plugin.TValue[string]
+	Arch plugin.TValue[string]
+	Epoch plugin.TValue[string]
+	Format plugin.TValue[string]
+	Status plugin.TValue[string]
+	Description plugin.TValue[string]
+	Origin plugin.TValue[string]
+	Available plugin.TValue[string]
+	Installed plugin.TValue[bool]
+	Outdated plugin.TValue[bool]
 }
 
-// createFilePermissions creates a new instance of this resource
-func createFilePermissions(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlFilePermissions{
+// createPackage creates a new instance of this resource
+func createPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPackage{
 		MqlRuntime: runtime,
 	}
 
@@ -4895,156 +7292,93 @@ func createFilePermissions(runtime *plugin.Runtime, args map[string]*llx.RawData
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("file.permissions", res.__id)
+		args, err = runtime.ResourceFromRecording("package", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
 		return res, SetAllData(res, args)
 	}
 
-	return res, nil
-}
-
-func (c *mqlFilePermissions) MqlName() string {
-	return "file.permissions"
-}
-
-func (c *mqlFilePermissions) MqlID() string {
-	return c.__id
-}
-
-func (c *mqlFilePermissions) GetMode() *plugin.TValue[int64] {
-	return &c.Mode
-}
-
-func (c *mqlFilePermissions) GetUser_readable() *plugin.TValue[bool] {
-	return &c.User_readable
-}
-
-func (c *mqlFilePermissions) GetUser_writeable() *plugin.TValue[bool] {
-	return &c.User_writeable
-}
-
-func (c *mqlFilePermissions) GetUser_executable() *plugin.TValue[bool] {
-	return &c.User_executable
-}
-
-func (c *mqlFilePermissions) GetGroup_readable() *plugin.TValue[bool] {
-	return &c.Group_readable
-}
-
-func (c *mqlFilePermissions) GetGroup_writeable() *plugin.TValue[bool] {
-	return &c.Group_writeable
-}
-
-func (c *mqlFilePermissions) GetGroup_executable() *plugin.TValue[bool] {
-	return &c.Group_executable
-}
-
-func (c *mqlFilePermissions) GetOther_readable() *plugin.TValue[bool] {
-	return &c.Other_readable
-}
-
-func (c *mqlFilePermissions) GetOther_writeable() *plugin.TValue[bool] {
-	return &c.Other_writeable
+	return res, nil
 }
 
-func (c *mqlFilePermissions) GetOther_executable() *plugin.TValue[bool] {
-	return &c.Other_executable
+func (c *mqlPackage) MqlName() string {
+	return "package"
 }
 
-func (c *mqlFilePermissions) GetSuid() *plugin.TValue[bool] {
-	return &c.Suid
+func (c *mqlPackage) MqlID() string {
+	return c.__id
 }
 
-func (c *mqlFilePermissions) GetSgid() *plugin.TValue[bool] {
-	return &c.Sgid
+func (c *mqlPackage) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlFilePermissions) GetSticky() *plugin.TValue[bool] {
-	return &c.Sticky
+func (c *mqlPackage) GetVersion() *plugin.TValue[string] {
+	return &c.Version
 }
 
-func (c *mqlFilePermissions) GetIsDirectory() *plugin.TValue[bool] {
-	return &c.IsDirectory
+func (c *mqlPackage) GetArch() *plugin.TValue[string] {
+	return &c.Arch
 }
 
-func (c *mqlFilePermissions) GetIsFile() *plugin.TValue[bool] {
-	return &c.IsFile
+func (c *mqlPackage) GetEpoch() *plugin.TValue[string] {
+	return &c.Epoch
 }
 
-func (c *mqlFilePermissions) GetIsSymlink() *plugin.TValue[bool] {
-	return &c.IsSymlink
+func (c *mqlPackage) GetFormat() *plugin.TValue[string] {
+	return &c.Format
 }
 
-func (c *mqlFilePermissions) GetString() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.String, func() (string, error) {
-		return c.string()
+func (c *mqlPackage) GetStatus() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Status, func() (string, error) {
+		return c.status()
 	})
 }
 
-// mqlFiles for the files resource
-type mqlFiles struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	// optional: if you define mqlFilesInternal it will be used here
+func (c *mqlPackage) GetDescription() *plugin.TValue[string] {
+	return &c.Description
 }
 
-// createFiles creates a new instance of this resource
-func createFiles(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlFiles{
-		MqlRuntime: runtime,
-	}
-
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
-	}
-
-	// to override __id implement: id() (string, error)
-
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("files", res.__id)
-		if err != nil || args == nil {
-			return res, err
-		}
-		return res, SetAllData(res, args)
-	}
+func (c *mqlPackage) GetOrigin() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Origin, func() (string, error) {
+		return c.origin()
+	})
+}
 
-	return res, nil
+func (c *mqlPackage) GetAvailable() *plugin.TValue[string] {
+	return &c.Available
 }
 
-func (c *mqlFiles) MqlName() string {
-	return "files"
+func (c *mqlPackage) GetInstalled() *plugin.TValue[bool] {
+	return &c.Installed
 }
 
-func (c *mqlFiles) MqlID() string {
-	return c.__id
+func (c *mqlPackage) GetOutdated() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Outdated, func() (bool, error) {
+		return c.outdated()
+	})
 }
 
-// mqlFilesFind for the files.find resource
-type mqlFilesFind struct {
+// mqlPackages for the packages resource
+type mqlPackages struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlFilesFindInternal it will be used here
-	From plugin.TValue[string]
-	Xdev plugin.TValue[bool]
-	Type plugin.TValue[string]
-	Regex plugin.TValue[string]
-	Permissions plugin.TValue[int64]
-	Name plugin.TValue[string]
+	mqlPackagesInternal
 	List plugin.TValue[[]interface{}]
 }
 
-// createFilesFind creates a new instance of this resource
-func createFilesFind(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlFilesFind{
+// createPackages creates a new instance of this resource
+func createPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPackages{
 		MqlRuntime: runtime,
 	}
 
@@ -5053,13 +7387,10 @@ func createFilesFind(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 		return res, err
 	}
 
-	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("files.find", res.__id)
+		args, err = runtime.ResourceFromRecording("packages", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5069,42 +7400,18 @@ func createFilesFind(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	return res, nil
 }
 
-func (c *mqlFilesFind) MqlName() string {
-	return "files.find"
+func (c *mqlPackages) MqlName() string {
+	return "packages"
 }
 
-func (c *mqlFilesFind) MqlID() string {
+func (c *mqlPackages) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlFilesFind) GetFrom() *plugin.TValue[string] {
-	return &c.From
-}
-
-func (c *mqlFilesFind) GetXdev() *plugin.TValue[bool] {
-	return &c.Xdev
-}
-
-func (c *mqlFilesFind) GetType() *plugin.TValue[string] {
-	return &c.Type
-}
-
-func (c *mqlFilesFind) GetRegex() *plugin.TValue[string] {
-	return &c.Regex
-}
-
-func (c *mqlFilesFind) GetPermissions() *plugin.TValue[int64] {
-	return &c.Permissions
-}
-
-func (c *mqlFilesFind) GetName() *plugin.TValue[string] {
-	return &c.Name
-}
-
-func (c *mqlFilesFind) GetList() *plugin.TValue[[]interface{}] {
+func (c *mqlPackages) GetList() *plugin.TValue[[]interface{}] {
 	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("files.find", c.__id, "list")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("packages", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
@@ -5117,21 +7424,20 @@ func (c *mqlFilesFind) GetList() *plugin.TValue[[]interface{}] {
 	})
 }
 
-// mqlParseIni for the parse.ini resource
-type mqlParseIni struct {
+// mqlPamConf for the pam.conf resource
+type mqlPamConf struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlParseIniInternal it will be used here
-	Delimiter plugin.TValue[string]
-	File plugin.TValue[*mqlFile]
+	// optional: if you define mqlPamConfInternal it will be used here
+	Files plugin.TValue[[]interface{}]
 	Content plugin.TValue[string]
-	Sections plugin.TValue[map[string]interface{}]
-	Params plugin.TValue[map[string]interface{}]
+	Services plugin.TValue[map[string]interface{}]
+	Entries plugin.TValue[map[string]interface{}]
 }
 
-// createParseIni creates a new instance of this resource
-func createParseIni(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlParseIni{
+// createPamConf creates a new instance of this resource
+func createPamConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPamConf{
 		MqlRuntime: runtime,
 	}
 
@@ -5140,13 +7446,15 @@ func createParseIni(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("parse.ini", res.__id)
+		args, err = runtime.ResourceFromRecording("pam.conf", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5156,73 +7464,89 @@ func createParseIni(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	return res, nil
 }
 
-func (c *mqlParseIni) MqlName() string {
-	return "parse.ini"
+func (c *mqlPamConf) MqlName() string {
+	return "pam.conf"
 }
 
-func (c *mqlParseIni) MqlID() string {
+func (c *mqlPamConf) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlParseIni) GetDelimiter() *plugin.TValue[string] {
-	return &c.Delimiter
-}
+func (c *mqlPamConf) GetFiles() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Files, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("pam.conf", c.__id, "files")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlParseIni) GetFile() *plugin.TValue[*mqlFile] {
-	return &c.File
+		return c.files()
+	})
 }
 
-func (c *mqlParseIni) GetContent() *plugin.TValue[string] {
+func (c *mqlPamConf) GetContent() *plugin.TValue[string] {
 	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return "", vargFile.Error
+		vargFiles := c.GetFiles()
+		if vargFiles.Error != nil {
+			return "", vargFiles.Error
 		}
 
-		return c.content(vargFile.Data)
+		return c.content(vargFiles.Data)
 	})
 }
 
-func (c *mqlParseIni) GetSections() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Sections, func() (map[string]interface{}, error) {
-		vargContent := c.GetContent()
-		if vargContent.Error != nil {
-			return nil, vargContent.Error
-		}
-
-		vargDelimiter := c.GetDelimiter()
-		if vargDelimiter.Error != nil {
-			return nil, vargDelimiter.Error
+func (c *mqlPamConf) GetServices() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Services, func() (map[string]interface{}, error) {
+		vargFiles := c.GetFiles()
+		if vargFiles.Error != nil {
+			return nil, vargFiles.Error
 		}
 
-		return c.sections(vargContent.Data, vargDelimiter.Data)
+		return c.services(vargFiles.Data)
 	})
 }
 
-func (c *mqlParseIni) GetParams() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Params, func() (map[string]interface{}, error) {
-		vargSections := c.GetSections()
-		if vargSections.Error != nil {
-			return nil, vargSections.Error
+func (c *mqlPamConf) GetEntries() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Entries, func() (map[string]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("pam.conf", c.__id, "entries")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(map[string]interface{}), nil
+			}
 		}
 
-		return c.params(vargSections.Data)
+		vargFiles := c.GetFiles()
+		if vargFiles.Error != nil {
+			return nil, vargFiles.Error
+		}
+
+		return c.entries(vargFiles.Data)
 	})
 }
 
-// mqlParseJson for the parse.json resource
-type mqlParseJson struct {
+// mqlPamConfServiceEntry for the pam.conf.serviceEntry resource
+type mqlPamConfServiceEntry struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlParseJsonInternal it will be used here
-	File plugin.TValue[*mqlFile]
-	Content plugin.TValue[string]
-	Params plugin.TValue[interface{}]
+	// optional: if you define mqlPamConfServiceEntryInternal it will be used here
+	Service plugin.TValue[string]
+	LineNumber plugin.TValue[int64]
+	PamType plugin.TValue[string]
+	Control plugin.TValue[string]
+	Module plugin.TValue[string]
+	Options plugin.TValue[[]interface{}]
 }
 
-// createParseJson creates a new instance of this resource
-func createParseJson(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlParseJson{
+// createPamConfServiceEntry creates a new instance of this resource
+func createPamConfServiceEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPamConfServiceEntry{
 		MqlRuntime: runtime,
 	}
 
@@ -5231,13 +7555,15 @@ func createParseJson(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("parse.json", res.__id)
+		args, err = runtime.ResourceFromRecording("pam.conf.serviceEntry", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5247,53 +7573,48 @@ func createParseJson(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	return res, nil
 }
 
-func (c *mqlParseJson) MqlName() string {
-	return "parse.json"
+func (c *mqlPamConfServiceEntry) MqlName() string {
+	return "pam.conf.serviceEntry"
 }
 
-func (c *mqlParseJson) MqlID() string {
+func (c *mqlPamConfServiceEntry) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlParseJson) GetFile() *plugin.TValue[*mqlFile] {
-	return &c.File
+func (c *mqlPamConfServiceEntry) GetService() *plugin.TValue[string] {
+	return &c.Service
 }
 
-func (c *mqlParseJson) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return "", vargFile.Error
-		}
+func (c *mqlPamConfServiceEntry) GetLineNumber() *plugin.TValue[int64] {
+	return &c.LineNumber
+}
 
-		return c.content(vargFile.Data)
-	})
+func (c *mqlPamConfServiceEntry) GetPamType() *plugin.TValue[string] {
+	return &c.PamType
 }
 
-func (c *mqlParseJson) GetParams() *plugin.TValue[interface{}] {
-	return plugin.GetOrCompute[interface{}](&c.Params, func() (interface{}, error) {
-		vargContent := c.GetContent()
-		if vargContent.Error != nil {
-			return nil, vargContent.Error
-		}
+func (c *mqlPamConfServiceEntry) GetControl() *plugin.TValue[string] {
+	return &c.Control
+}
 
-		return c.params(vargContent.Data)
-	})
+func (c *mqlPamConfServiceEntry) GetModule() *plugin.TValue[string] {
+	return &c.Module
 }
 
-// mqlParsePlist for the parse.plist resource
-type mqlParsePlist struct {
+func (c *mqlPamConfServiceEntry) GetOptions() *plugin.TValue[[]interface{}] {
+	return &c.Options
+}
+
+// mqlSshd for the sshd resource
+type mqlSshd struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlParsePlistInternal it will be used here
-	File plugin.TValue[*mqlFile]
-	Content plugin.TValue[string]
-	Params plugin.TValue[interface{}]
+	// optional: if you define mqlSshdInternal it will be used here
 }
 
-// createParsePlist creates a new instance of this resource
-func createParsePlist(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlParsePlist{
+// createSshd creates a new instance of this resource
+func createSshd(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSshd{
 		MqlRuntime: runtime,
 	}
 
@@ -5302,13 +7623,10 @@ func createParsePlist(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 		return res, err
 	}
 
-	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("parse.plist", res.__id)
+		args, err = runtime.ResourceFromRecording("sshd", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5318,53 +7636,32 @@ func createParsePlist(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	return res, nil
 }
 
-func (c *mqlParsePlist) MqlName() string {
-	return "parse.plist"
+func (c *mqlSshd) MqlName() string {
+	return "sshd"
 }
 
-func (c *mqlParsePlist) MqlID() string {
+func (c *mqlSshd) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlParsePlist) GetFile() *plugin.TValue[*mqlFile] {
-	return &c.File
-}
-
-func (c *mqlParsePlist) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return "", vargFile.Error
-		}
-
-		return c.content(vargFile.Data)
-	})
-}
-
-func (c *mqlParsePlist) GetParams() *plugin.TValue[interface{}] {
-	return plugin.GetOrCompute[interface{}](&c.Params, func() (interface{}, error) {
-		vargContent := c.GetContent()
-		if vargContent.Error != nil {
-			return nil, vargContent.Error
-		}
-
-		return c.params(vargContent.Data)
-	})
-}
-
-// mqlParseYaml for the parse.yaml resource
-type mqlParseYaml struct {
+// mqlSshdConfig for the sshd.config resource
+type mqlSshdConfig struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlParseYamlInternal it will be used here
+	// optional: if you define mqlSshdConfigInternal it will be used here
 	File plugin.TValue[*mqlFile]
+	Files plugin.TValue[[]interface{}]
 	Content plugin.TValue[string]
-	Params plugin.TValue[interface{}]
+	Params plugin.TValue[map[string]interface{}]
+	Ciphers plugin.TValue[[]interface{}]
+	Macs plugin.TValue[[]interface{}]
+	Kexs plugin.TValue[[]interface{}]
+	Hostkeys plugin.TValue[[]interface{}]
 }
 
-// createParseYaml creates a new instance of this resource
-func createParseYaml(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlParseYaml{
+// createSshdConfig creates a new instance of this resource
+func createSshdConfig(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSshdConfig{
 		MqlRuntime: runtime,
 	}
 
@@ -5373,13 +7670,15 @@ func createParseYaml(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("parse.yaml", res.__id)
+		args, err = runtime.ResourceFromRecording("sshd.config", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5389,31 +7688,64 @@ func createParseYaml(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	return res, nil
 }
 
-func (c *mqlParseYaml) MqlName() string {
-	return "parse.yaml"
+func (c *mqlSshdConfig) MqlName() string {
+	return "sshd.config"
 }
 
-func (c *mqlParseYaml) MqlID() string {
+func (c *mqlSshdConfig) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlParseYaml) GetFile() *plugin.TValue[*mqlFile] {
-	return &c.File
+func (c *mqlSshdConfig) GetFile() *plugin.TValue[*mqlFile] {
+	return plugin.GetOrCompute[*mqlFile](&c.File, func() (*mqlFile, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("sshd.config", c.__id, "file")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlFile), nil
+			}
+		}
+
+		return c.file()
+	})
 }
 
-func (c *mqlParseYaml) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+func (c *mqlSshdConfig) GetFiles() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Files, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("sshd.config", c.__id, "files")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
 		vargFile := c.GetFile()
 		if vargFile.Error != nil {
-			return "", vargFile.Error
+			return nil, vargFile.Error
 		}
 
-		return c.content(vargFile.Data)
+		return c.files(vargFile.Data)
 	})
 }
 
-func (c *mqlParseYaml) GetParams() *plugin.TValue[interface{}] {
-	return plugin.GetOrCompute[interface{}](&c.Params, func() (interface{}, error) {
+func (c *mqlSshdConfig) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargFiles := c.GetFiles()
+		if vargFiles.Error != nil {
+			return "", vargFiles.Error
+		}
+
+		return c.content(vargFiles.Data)
+	})
+}
+
+func (c *mqlSshdConfig) GetParams() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Params, func() (map[string]interface{}, error) {
 		vargContent := c.GetContent()
 		if vargContent.Error != nil {
 			return nil, vargContent.Error
@@ -5423,20 +7755,67 @@ func (c *mqlParseYaml) GetParams() *plugin.TValue[interface{}] {
 	})
 }
 
-// mqlParseCertificates for the parse.certificates resource
-type mqlParseCertificates struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	// optional: if you define mqlParseCertificatesInternal it will be used here
-	Path plugin.TValue[string]
-	File plugin.TValue[*mqlFile]
-	Content plugin.TValue[string]
-	List plugin.TValue[[]interface{}]
+func (c *mqlSshdConfig) GetCiphers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Ciphers, func() ([]interface{}, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.ciphers(vargParams.Data)
+	})
 }
 
-// createParseCertificates creates a new instance of this resource
-func createParseCertificates(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlParseCertificates{
+func (c *mqlSshdConfig) GetMacs() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Macs, func() ([]interface{}, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.macs(vargParams.Data)
+	})
+}
+
+func (c *mqlSshdConfig) GetKexs() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Kexs, func() ([]interface{}, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.kexs(vargParams.Data)
+	})
+}
+
+func (c *mqlSshdConfig) GetHostkeys() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Hostkeys, func() ([]interface{}, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.hostkeys(vargParams.Data)
+	})
+}
+
+// mqlService for the service resource
+type mqlService struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlServiceInternal it will be used here
+	Name plugin.TValue[string]
+	Description plugin.TValue[string]
+	Installed plugin.TValue[bool]
+	Running plugin.TValue[bool]
+	Enabled plugin.TValue[bool]
+	Type plugin.TValue[string]
+	Masked plugin.TValue[bool]
+}
+
+// createService creates a new instance of this resource
+func createService(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlService{
 		MqlRuntime: runtime,
 	}
 
@@ -5445,13 +7824,15 @@ func createParseCertificates(runtime *plugin.Runtime, args map[string]*llx.RawDa
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("parse.certificates", res.__id)
+		args, err = runtime.ResourceFromRecording("service", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5461,85 +7842,53 @@ func createParseCertificates(runtime *plugin.Runtime, args map[string]*llx.RawDa
 	return res, nil
 }
 
-func (c *mqlParseCertificates) MqlName() string {
-	return "parse.certificates"
+func (c *mqlService) MqlName() string {
+	return "service"
 }
 
-func (c *mqlParseCertificates) MqlID() string {
+func (c *mqlService) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlParseCertificates) GetPath() *plugin.TValue[string] {
-	return &c.Path
+func (c *mqlService) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlParseCertificates) GetFile() *plugin.TValue[*mqlFile] {
-	return plugin.GetOrCompute[*mqlFile](&c.File, func() (*mqlFile, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("parse.certificates", c.__id, "file")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlFile), nil
-			}
-		}
-
-		return c.file()
-	})
+func (c *mqlService) GetDescription() *plugin.TValue[string] {
+	return &c.Description
 }
 
-func (c *mqlParseCertificates) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return "", vargFile.Error
-		}
-
-		return c.content(vargFile.Data)
-	})
+func (c *mqlService) GetInstalled() *plugin.TValue[bool] {
+	return &c.Installed
 }
 
-func (c *mqlParseCertificates) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("parse.certificates", c.__id, "list")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlService) GetRunning() *plugin.TValue[bool] {
+	return &c.Running
+}
 
-		vargContent := c.GetContent()
-		if vargContent.Error != nil {
-			return nil, vargContent.Error
-		}
+func (c *mqlService) GetEnabled() *plugin.TValue[bool] {
+	return &c.Enabled
+}
 
-		vargPath := c.GetPath()
-		if vargPath.Error != nil {
-			return nil, vargPath.Error
-		}
+func (c *mqlService) GetType() *plugin.TValue[string] {
+	return &c.Type
+}
 
-		return c.list(vargContent.Data, vargPath.Data)
-	})
+func (c *mqlService) GetMasked() *plugin.TValue[bool] {
+	return &c.Masked
 }
 
-// mqlParseOpenpgp for the parse.openpgp resource
-type mqlParseOpenpgp struct {
+// mqlServices for the services resource
+type mqlServices struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlParseOpenpgpInternal it will be used here
-	Path plugin.TValue[string]
-	File plugin.TValue[*mqlFile]
-	Content plugin.TValue[string]
+	mqlServicesInternal
 	List plugin.TValue[[]interface{}]
 }
 
-// createParseOpenpgp creates a new instance of this resource
-func createParseOpenpgp(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlParseOpenpgp{
+// createServices creates a new instance of this resource
+func createServices(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlServices{
 		MqlRuntime: runtime,
 	}
 
@@ -5548,13 +7897,10 @@ func createParseOpenpgp(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 		return res, err
 	}
 
-	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("parse.openpgp", res.__id)
+		args, err = runtime.ResourceFromRecording("services", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5564,37 +7910,18 @@ func createParseOpenpgp(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	return res, nil
 }
 
-func (c *mqlParseOpenpgp) MqlName() string {
-	return "parse.openpgp"
+func (c *mqlServices) MqlName() string {
+	return "services"
 }
 
-func (c *mqlParseOpenpgp) MqlID() string {
+func (c *mqlServices) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlParseOpenpgp) GetPath() *plugin.TValue[string] {
-	return &c.Path
-}
-
-func (c *mqlParseOpenpgp) GetFile() *plugin.TValue[*mqlFile] {
-	return &c.File
-}
-
-func (c *mqlParseOpenpgp) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return "", vargFile.Error
-		}
-
-		return c.content(vargFile.Data)
-	})
-}
-
-func (c *mqlParseOpenpgp) GetList() *plugin.TValue[[]interface{}] {
+func (c *mqlServices) GetList() *plugin.TValue[[]interface{}] {
 	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("parse.openpgp", c.__id, "list")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("services", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
@@ -5603,35 +7930,24 @@ func (c *mqlParseOpenpgp) GetList() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		vargContent := c.GetContent()
-		if vargContent.Error != nil {
-			return nil, vargContent.Error
-		}
-
-		return c.list(vargContent.Data)
+		return c.list()
 	})
 }
 
-// mqlUser for the user resource
-type mqlUser struct {
+// mqlKernel for the kernel resource
+type mqlKernel struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlUserInternal it will be used here
-	Uid plugin.TValue[int64]
-	Gid plugin.TValue[int64]
-	Sid plugin.TValue[string]
-	Name plugin.TValue[string]
-	Home plugin.TValue[string]
-	Shell plugin.TValue[string]
-	Enabled plugin.TValue[bool]
-	Authorizedkeys plugin.TValue[*mqlAuthorizedkeys]
-	Sshkeys plugin.TValue[[]interface{}]
-	Group plugin.TValue[*mqlGroup]
+	mqlKernelInternal
+	Info plugin.TValue[interface{}]
+	Parameters plugin.TValue[map[string]interface{}]
+	Modules plugin.TValue[[]interface{}]
+	Installed plugin.TValue[[]interface{}]
 }
 
-// createUser creates a new instance of this resource
-func createUser(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlUser{
+// createKernel creates a new instance of this resource
+func createKernel(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlKernel{
 		MqlRuntime: runtime,
 	}
 
@@ -5640,13 +7956,10 @@ func createUser(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.R
 		return res, err
 	}
 
-	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("user", res.__id)
+		args, err = runtime.ResourceFromRecording("kernel", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5656,67 +7969,30 @@ func createUser(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.R
 	return res, nil
 }
 
-func (c *mqlUser) MqlName() string {
-	return "user"
+func (c *mqlKernel) MqlName() string {
+	return "kernel"
 }
 
-func (c *mqlUser) MqlID() string {
+func (c *mqlKernel) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlUser) GetUid() *plugin.TValue[int64] {
-	return &c.Uid
-}
-
-func (c *mqlUser) GetGid() *plugin.TValue[int64] {
-	return &c.Gid
-}
-
-func (c *mqlUser) GetSid() *plugin.TValue[string] {
-	return &c.Sid
-}
-
-func (c *mqlUser) GetName() *plugin.TValue[string] {
-	return &c.Name
-}
-
-func (c *mqlUser) GetHome() *plugin.TValue[string] {
-	return &c.Home
-}
-
-func (c *mqlUser) GetShell() *plugin.TValue[string] {
-	return &c.Shell
-}
-
-func (c *mqlUser) GetEnabled() *plugin.TValue[bool] {
-	return &c.Enabled
+func (c *mqlKernel) GetInfo() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Info, func() (interface{}, error) {
+		return c.info()
+	})
 }
 
-func (c *mqlUser) GetAuthorizedkeys() *plugin.TValue[*mqlAuthorizedkeys] {
-	return plugin.GetOrCompute[*mqlAuthorizedkeys](&c.Authorizedkeys, func() (*mqlAuthorizedkeys, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("user", c.__id, "authorizedkeys")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlAuthorizedkeys), nil
-			}
-		}
-
-		vargHome := c.GetHome()
-		if vargHome.Error != nil {
-			return nil, vargHome.Error
-		}
-
-		return c.authorizedkeys(vargHome.Data)
+func (c *mqlKernel) GetParameters() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Parameters, func() (map[string]interface{}, error) {
+		return c.parameters()
 	})
 }
 
-func (c *mqlUser) GetSshkeys() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Sshkeys, func() ([]interface{}, error) {
+func (c *mqlKernel) GetModules() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Modules, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("user", c.__id, "sshkeys")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("kernel", c.__id, "modules")
 			if err != nil {
 				return nil, err
 			}
@@ -5725,45 +8001,29 @@ func (c *mqlUser) GetSshkeys() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.sshkeys()
+		return c.modules()
 	})
 }
 
-func (c *mqlUser) GetGroup() *plugin.TValue[*mqlGroup] {
-	return plugin.GetOrCompute[*mqlGroup](&c.Group, func() (*mqlGroup, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("user", c.__id, "group")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlGroup), nil
-			}
-		}
-
-		vargGid := c.GetGid()
-		if vargGid.Error != nil {
-			return nil, vargGid.Error
-		}
-
-		return c.group(vargGid.Data)
+func (c *mqlKernel) GetInstalled() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Installed, func() ([]interface{}, error) {
+		return c.installed()
 	})
 }
 
-// mqlPrivatekey for the privatekey resource
-type mqlPrivatekey struct {
+// mqlKernelModule for the kernel.module resource
+type mqlKernelModule struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlPrivatekeyInternal it will be used here
-	Pem plugin.TValue[string]
-	Path plugin.TValue[string]
-	File plugin.TValue[*mqlFile]
-	Encrypted plugin.TValue[bool]
+	// optional: if you define mqlKernelModuleInternal it will be used here
+	Name plugin.TValue[string]
+	Size plugin.TValue[string]
+	Loaded plugin.TValue[bool]
 }
 
-// createPrivatekey creates a new instance of this resource
-func createPrivatekey(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPrivatekey{
+// createKernelModule creates a new instance of this resource
+func createKernelModule(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlKernelModule{
 		MqlRuntime: runtime,
 	}
 
@@ -5772,13 +8032,15 @@ func createPrivatekey(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("privatekey", res.__id)
+		args, err = runtime.ResourceFromRecording("kernel.module", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5788,41 +8050,38 @@ func createPrivatekey(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	return res, nil
 }
 
-func (c *mqlPrivatekey) MqlName() string {
-	return "privatekey"
+func (c *mqlKernelModule) MqlName() string {
+	return "kernel.module"
 }
 
-func (c *mqlPrivatekey) MqlID() string {
+func (c *mqlKernelModule) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPrivatekey) GetPem() *plugin.TValue[string] {
-	return &c.Pem
-}
-
-func (c *mqlPrivatekey) GetPath() *plugin.TValue[string] {
-	return &c.Path
+func (c *mqlKernelModule) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlPrivatekey) GetFile() *plugin.TValue[*mqlFile] {
-	return &c.File
+func (c *mqlKernelModule) GetSize() *plugin.TValue[string] {
+	return &c.Size
 }
 
-func (c *mqlPrivatekey) GetEncrypted() *plugin.TValue[bool] {
-	return &c.Encrypted
+func (c *mqlKernelModule) GetLoaded() *plugin.TValue[bool] {
+	return &c.Loaded
 }
 
-// mqlUsers for the users resource
-type mqlUsers struct {
+// mqlDocker for the docker resource
+type mqlDocker struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlUsersInternal
-	List plugin.TValue[[]interface{}]
+	// optional: if you define mqlDockerInternal it will be used here
+	Images plugin.TValue[[]interface{}]
+	Containers plugin.TValue[[]interface{}]
 }
 
-// createUsers creates a new instance of this resource
-func createUsers(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlUsers{
+// createDocker creates a new instance of this resource
+func createDocker(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDocker{
 		MqlRuntime: runtime,
 	}
 
@@ -5834,7 +8093,7 @@ func createUsers(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("users", res.__id)
+		args, err = runtime.ResourceFromRecording("docker", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5844,18 +8103,18 @@ func createUsers(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 	return res, nil
 }
 
-func (c *mqlUsers) MqlName() string {
-	return "users"
+func (c *mqlDocker) MqlName() string {
+	return "docker"
 }
 
-func (c *mqlUsers) MqlID() string {
+func (c *mqlDocker) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlUsers) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+func (c *mqlDocker) GetImages() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Images, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("users", c.__id, "list")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("docker", c.__id, "images")
 			if err != nil {
 				return nil, err
 			}
@@ -5864,24 +8123,41 @@ func (c *mqlUsers) GetList() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.list()
+		return c.images()
 	})
 }
 
-// mqlAuthorizedkeys for the authorizedkeys resource
-type mqlAuthorizedkeys struct {
+func (c *mqlDocker) GetContainers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Containers, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("docker", c.__id, "containers")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.containers()
+	})
+}
+
+// mqlDockerImage for the docker.image resource
+type mqlDockerImage struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlAuthorizedkeysInternal it will be used here
-	Path plugin.TValue[string]
-	File plugin.TValue[*mqlFile]
-	Content plugin.TValue[string]
-	List plugin.TValue[[]interface{}]
+	// optional: if you define mqlDockerImageInternal it will be used here
+	Id plugin.TValue[string]
+	Size plugin.TValue[int64]
+	Virtualsize plugin.TValue[int64]
+	Tags plugin.TValue[[]interface{}]
+	Labels plugin.TValue[map[string]interface{}]
 }
 
-// createAuthorizedkeys creates a new instance of this resource
-func createAuthorizedkeys(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlAuthorizedkeys{
+// createDockerImage creates a new instance of this resource
+func createDockerImage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerImage{
 		MqlRuntime: runtime,
 	}
 
@@ -5890,13 +8166,15 @@ func createAuthorizedkeys(runtime *plugin.Runtime, args map[string]*llx.RawData)
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("authorizedkeys", res.__id)
+		args, err = runtime.ResourceFromRecording("docker.image", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5906,75 +8184,147 @@ func createAuthorizedkeys(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlAuthorizedkeys) MqlName() string {
-	return "authorizedkeys"
+func (c *mqlDockerImage) MqlName() string {
+	return "docker.image"
 }
 
-func (c *mqlAuthorizedkeys) MqlID() string {
+func (c *mqlDockerImage) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlAuthorizedkeys) GetPath() *plugin.TValue[string] {
-	return &c.Path
+func (c *mqlDockerImage) GetId() *plugin.TValue[string] {
+	return &c.Id
 }
 
-func (c *mqlAuthorizedkeys) GetFile() *plugin.TValue[*mqlFile] {
-	return &c.File
+func (c *mqlDockerImage) GetSize() *plugin.TValue[int64] {
+	return &c.Size
 }
 
-func (c *mqlAuthorizedkeys) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return "", vargFile.Error
+func (c *mqlDockerImage) GetVirtualsize() *plugin.TValue[int64] {
+	return &c.Virtualsize
+}
+
+func (c *mqlDockerImage) GetTags() *plugin.TValue[[]interface{}] {
+	return &c.Tags
+}
+
+func (c *mqlDockerImage) GetLabels() *plugin.TValue[map[string]interface{}] {
+	return &c.Labels
+}
+
+// mqlDockerContainer for the docker.container resource
+type mqlDockerContainer struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlDockerContainerInternal it will be used here
+	Os plugin.TValue[*mqlOsLinux]
+	Id plugin.TValue[string]
+	Command plugin.TValue[string]
+	Image plugin.TValue[string]
+	Imageid plugin.TValue[string]
+	Names plugin.TValue[[]interface{}]
+	State plugin.TValue[string]
+	Status plugin.TValue[string]
+	Labels plugin.TValue[map[string]interface{}]
+}
+
+// createDockerContainer creates a new instance of this resource
+func createDockerContainer(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerContainer{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		return c.content(vargFile.Data)
-	})
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("docker.container", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
 }
 
-func (c *mqlAuthorizedkeys) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+func (c *mqlDockerContainer) MqlName() string {
+	return "docker.container"
+}
+
+func (c *mqlDockerContainer) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlDockerContainer) GetOs() *plugin.TValue[*mqlOsLinux] {
+	return plugin.GetOrCompute[*mqlOsLinux](&c.Os, func() (*mqlOsLinux, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("authorizedkeys", c.__id, "list")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("docker.container", c.__id, "os")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.([]interface{}), nil
+				return d.Value.(*mqlOsLinux), nil
 			}
 		}
 
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return nil, vargFile.Error
-		}
+		return c.os()
+	})
+}
 
-		vargContent := c.GetContent()
-		if vargContent.Error != nil {
-			return nil, vargContent.Error
-		}
+func (c *mqlDockerContainer) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlDockerContainer) GetCommand() *plugin.TValue[string] {
+	return &c.Command
+}
+
+func (c *mqlDockerContainer) GetImage() *plugin.TValue[string] {
+	return &c.Image
+}
+
+func (c *mqlDockerContainer) GetImageid() *plugin.TValue[string] {
+	return &c.Imageid
+}
+
+func (c *mqlDockerContainer) GetNames() *plugin.TValue[[]interface{}] {
+	return &c.Names
+}
+
+func (c *mqlDockerContainer) GetState() *plugin.TValue[string] {
+	return &c.State
+}
+
+func (c *mqlDockerContainer) GetStatus() *plugin.TValue[string] {
+	return &c.Status
+}
 
-		return c.list(vargFile.Data, vargContent.Data)
-	})
+func (c *mqlDockerContainer) GetLabels() *plugin.TValue[map[string]interface{}] {
+	return &c.Labels
 }
 
-// mqlAuthorizedkeysEntry for the authorizedkeys.entry resource
-type mqlAuthorizedkeysEntry struct {
+// mqlIptables for the iptables resource
+type mqlIptables struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlAuthorizedkeysEntryInternal it will be used here
-	Line plugin.TValue[int64]
-	Type plugin.TValue[string]
-	Key plugin.TValue[string]
-	Label plugin.TValue[string]
-	Options plugin.TValue[[]interface{}]
-	File plugin.TValue[*mqlFile]
+	// optional: if you define mqlIptablesInternal it will be used here
+	Input plugin.TValue[[]interface{}]
+	Output plugin.TValue[[]interface{}]
 }
 
-// createAuthorizedkeysEntry creates a new instance of this resource
-func createAuthorizedkeysEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlAuthorizedkeysEntry{
+// createIptables creates a new instance of this resource
+func createIptables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlIptables{
 		MqlRuntime: runtime,
 	}
 
@@ -5983,13 +8333,10 @@ func createAuthorizedkeysEntry(runtime *plugin.Runtime, args map[string]*llx.Raw
 		return res, err
 	}
 
-	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("authorizedkeys.entry", res.__id)
+		args, err = runtime.ResourceFromRecording("iptables", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5999,52 +8346,58 @@ func createAuthorizedkeysEntry(runtime *plugin.Runtime, args map[string]*llx.Raw
 	return res, nil
 }
 
-func (c *mqlAuthorizedkeysEntry) MqlName() string {
-	return "authorizedkeys.entry"
+func (c *mqlIptables) MqlName() string {
+	return "iptables"
 }
 
-func (c *mqlAuthorizedkeysEntry) MqlID() string {
+func (c *mqlIptables) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlAuthorizedkeysEntry) GetLine() *plugin.TValue[int64] {
-	return &c.Line
-}
-
-func (c *mqlAuthorizedkeysEntry) GetType() *plugin.TValue[string] {
-	return &c.Type
-}
-
-func (c *mqlAuthorizedkeysEntry) GetKey() *plugin.TValue[string] {
-	return &c.Key
-}
+func (c *mqlIptables) GetInput() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Input, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("iptables", c.__id, "input")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlAuthorizedkeysEntry) GetLabel() *plugin.TValue[string] {
-	return &c.Label
+		return c.input()
+	})
 }
 
-func (c *mqlAuthorizedkeysEntry) GetOptions() *plugin.TValue[[]interface{}] {
-	return &c.Options
-}
+func (c *mqlIptables) GetOutput() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Output, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("iptables", c.__id, "output")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlAuthorizedkeysEntry) GetFile() *plugin.TValue[*mqlFile] {
-	return &c.File
+		return c.output()
+	})
 }
 
-// mqlGroup for the group resource
-type mqlGroup struct {
+// mqlIp6tables for the ip6tables resource
+type mqlIp6tables struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlGroupInternal
-	Gid plugin.TValue[int64]
-	Sid plugin.TValue[string]
-	Name plugin.TValue[string]
-	Members plugin.TValue[[]interface{}]
+	// optional: if you define mqlIp6tablesInternal it will be used here
+	Input plugin.TValue[[]interface{}]
+	Output plugin.TValue[[]interface{}]
 }
 
-// createGroup creates a new instance of this resource
-func createGroup(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlGroup{
+// createIp6tables creates a new instance of this resource
+func createIp6tables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlIp6tables{
 		MqlRuntime: runtime,
 	}
 
@@ -6053,13 +8406,10 @@ func createGroup(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 		return res, err
 	}
 
-	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("group", res.__id)
+		args, err = runtime.ResourceFromRecording("ip6tables", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6069,30 +8419,34 @@ func createGroup(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 	return res, nil
 }
 
-func (c *mqlGroup) MqlName() string {
-	return "group"
+func (c *mqlIp6tables) MqlName() string {
+	return "ip6tables"
 }
 
-func (c *mqlGroup) MqlID() string {
+func (c *mqlIp6tables) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlGroup) GetGid() *plugin.TValue[int64] {
-	return &c.Gid
-}
-
-func (c *mqlGroup) GetSid() *plugin.TValue[string] {
-	return &c.Sid
-}
+func (c *mqlIp6tables) GetInput() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Input, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("ip6tables", c.__id, "input")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlGroup) GetName() *plugin.TValue[string] {
-	return &c.Name
+		return c.input()
+	})
 }
 
-func (c *mqlGroup) GetMembers() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Members, func() ([]interface{}, error) {
+func (c *mqlIp6tables) GetOutput() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Output, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("group", c.__id, "members")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("ip6tables", c.__id, "output")
 			if err != nil {
 				return nil, err
 			}
@@ -6101,21 +8455,32 @@ func (c *mqlGroup) GetMembers() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.members()
+		return c.output()
 	})
 }
 
-// mqlGroups for the groups resource
-type mqlGroups struct {
+// mqlIptablesEntry for the iptables.entry resource
+type mqlIptablesEntry struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlGroupsInternal
-	List plugin.TValue[[]interface{}]
+	// optional: if you define mqlIptablesEntryInternal it will be used here
+	LineNumber plugin.TValue[int64]
+	Packets plugin.TValue[int64]
+	Bytes plugin.TValue[int64]
+	Target plugin.TValue[string]
+	Protocol plugin.TValue[string]
+	Opt plugin.TValue[string]
+	In plugin.TValue[string]
+	Out plugin.TValue[string]
+	Source plugin.TValue[string]
+	Destination plugin.TValue[string]
+	Options plugin.TValue[string]
+	Chain plugin.TValue[string]
 }
 
-// createGroups creates a new instance of this resource
-func createGroups(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlGroups{
+// createIptablesEntry creates a new instance of this resource
+func createIptablesEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlIptablesEntry{
 		MqlRuntime: runtime,
 	}
 
@@ -6124,10 +8489,15 @@ func createGroups(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("groups", res.__id)
+		args, err = runtime.ResourceFromRecording("iptables.entry", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6137,51 +8507,88 @@ func createGroups(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	return res, nil
 }
 
-func (c *mqlGroups) MqlName() string {
-	return "groups"
+func (c *mqlIptablesEntry) MqlName() string {
+	return "iptables.entry"
 }
 
-func (c *mqlGroups) MqlID() string {
+func (c *mqlIptablesEntry) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlGroups) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("groups", c.__id, "list")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlIptablesEntry) GetLineNumber() *plugin.TValue[int64] {
+	return &c.LineNumber
+}
 
-		return c.list()
-	})
+func (c *mqlIptablesEntry) GetPackets() *plugin.TValue[int64] {
+	return &c.Packets
 }
 
-// mqlPackage for the package resource
-type mqlPackage struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	// optional: if you define mqlPackageInternal it will be used here
-	Name plugin.TValue[string]
-	Version plugin.TValue[string]
-	Arch plugin.TValue[string]
-	Epoch plugin.TValue[string]
-	Format plugin.TValue[string]
-	Status plugin.TValue[string]
-	Description plugin.TValue[string]
-	Origin plugin.TValue[string]
-	Available plugin.TValue[string]
-	Installed plugin.TValue[bool]
-	Outdated plugin.TValue[bool]
+func (c *mqlIptablesEntry) GetBytes() *plugin.TValue[int64] {
+	return &c.Bytes
+}
+
+func (c *mqlIptablesEntry) GetTarget() *plugin.TValue[string] {
+	return &c.Target
+}
+
+func (c *mqlIptablesEntry) GetProtocol() *plugin.TValue[string] {
+	return &c.Protocol
+}
+
+func (c *mqlIptablesEntry) GetOpt() *plugin.TValue[string] {
+	return &c.Opt
+}
+
+func (c *mqlIptablesEntry) GetIn() *plugin.TValue[string] {
+	return &c.In
+}
+
+func (c *mqlIptablesEntry) GetOut() *plugin.TValue[string] {
+	return &c.Out
+}
+
+func (c *mqlIptablesEntry) GetSource() *plugin.TValue[string] {
+	return &c.Source
+}
+
+func (c *mqlIptablesEntry) GetDestination() *plugin.TValue[string] {
+	return &c.Destination
+}
+
+func (c *mqlIptablesEntry) GetOptions() *plugin.TValue[string] {
+	return &c.Options
+}
+
+func (c *mqlIptablesEntry) GetChain() *plugin.TValue[string] {
+	return &c.Chain
+}
+
+// mqlProcess for the process resource
+type mqlProcess struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlProcessInternal
+	Pid plugin.TValue[int64]
+	State plugin.TValue[string]
+	Executable plugin.TValue[string]
+	Command plugin.TValue[string]
+	Flags plugin.TValue[map[string]interface{}]
+	User plugin.TValue[*mqlUser]
+	Parent plugin.TValue[*mqlProcess]
+	Children plugin.TValue[[]interface{}]
+	Cwd plugin.TValue[string]
+	Env plugin.TValue[map[string]interface{}]
+	StartTime plugin.TValue[*time.Time]
+	Rss plugin.TValue[int64]
+	Vsize plugin.TValue[int64]
+	OpenFiles plugin.TValue[[]interface{}]
+	Cgroup plugin.TValue[[]interface{}]
+	Namespaces plugin.TValue[map[string]interface{}]
 }
 
-// createPackage creates a new instance of this resource
-func createPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPackage{
+// createProcess creates a new instance of this resource
+func createProcess(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlProcess{
 		MqlRuntime: runtime,
 	}
 
@@ -6190,13 +8597,15 @@ func createPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("package", res.__id)
+		args, err = runtime.ResourceFromRecording("process", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6206,134 +8615,149 @@ func createPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	return res, nil
 }
 
-func (c *mqlPackage) MqlName() string {
-	return "package"
+func (c *mqlProcess) MqlName() string {
+	return "process"
 }
 
-func (c *mqlPackage) MqlID() string {
+func (c *mqlProcess) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPackage) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlProcess) GetPid() *plugin.TValue[int64] {
+	return &c.Pid
 }
 
-func (c *mqlPackage) GetVersion() *plugin.TValue[string] {
-	return &c.Version
+func (c *mqlProcess) GetState() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.State, func() (string, error) {
+		return c.state()
+	})
 }
 
-func (c *mqlPackage) GetArch() *plugin.TValue[string] {
-	return &c.Arch
+func (c *mqlProcess) GetExecutable() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Executable, func() (string, error) {
+		return c.executable()
+	})
 }
 
-func (c *mqlPackage) GetEpoch() *plugin.TValue[string] {
-	return &c.Epoch
+func (c *mqlProcess) GetCommand() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Command, func() (string, error) {
+		return c.command()
+	})
 }
 
-func (c *mqlPackage) GetFormat() *plugin.TValue[string] {
-	return &c.Format
+func (c *mqlProcess) GetFlags() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Flags, func() (map[string]interface{}, error) {
+		return c.flags()
+	})
 }
 
-func (c *mqlPackage) GetStatus() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Status, func() (string, error) {
-		return c.status()
+func (c *mqlProcess) GetUser() *plugin.TValue[*mqlUser] {
+	return plugin.GetOrCompute[*mqlUser](&c.User, func() (*mqlUser, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("process", c.__id, "user")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlUser), nil
+			}
+		}
+
+		return c.user()
 	})
 }
 
-func (c *mqlPackage) GetDescription() *plugin.TValue[string] {
-	return &c.Description
-}
+func (c *mqlProcess) GetParent() *plugin.TValue[*mqlProcess] {
+	return plugin.GetOrCompute[*mqlProcess](&c.Parent, func() (*mqlProcess, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("process", c.__id, "parent")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlProcess), nil
+			}
+		}
 
-func (c *mqlPackage) GetOrigin() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Origin, func() (string, error) {
-		return c.origin()
+		return c.parent()
 	})
 }
 
-func (c *mqlPackage) GetAvailable() *plugin.TValue[string] {
-	return &c.Available
-}
+func (c *mqlProcess) GetChildren() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Children, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("process", c.__id, "children")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlPackage) GetInstalled() *plugin.TValue[bool] {
-	return &c.Installed
+		return c.children()
+	})
 }
 
-func (c *mqlPackage) GetOutdated() *plugin.TValue[bool] {
-	return plugin.GetOrCompute[bool](&c.Outdated, func() (bool, error) {
-		return c.outdated()
+func (c *mqlProcess) GetCwd() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Cwd, func() (string, error) {
+		return c.cwd()
 	})
 }
 
-// mqlPackages for the packages resource
-type mqlPackages struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	mqlPackagesInternal
-	List plugin.TValue[[]interface{}]
+func (c *mqlProcess) GetEnv() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Env, func() (map[string]interface{}, error) {
+		return c.env()
+	})
 }
 
-// createPackages creates a new instance of this resource
-func createPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPackages{
-		MqlRuntime: runtime,
-	}
-
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
-	}
-
-	// to override __id implement: id() (string, error)
-
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("packages", res.__id)
-		if err != nil || args == nil {
-			return res, err
-		}
-		return res, SetAllData(res, args)
-	}
+func (c *mqlProcess) GetStartTime() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.StartTime, func() (*time.Time, error) {
+		return c.startTime()
+	})
+}
 
-	return res, nil
+func (c *mqlProcess) GetRss() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.Rss, func() (int64, error) {
+		return c.rss()
+	})
 }
 
-func (c *mqlPackages) MqlName() string {
-	return "packages"
+func (c *mqlProcess) GetVsize() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.Vsize, func() (int64, error) {
+		return c.vsize()
+	})
 }
 
-func (c *mqlPackages) MqlID() string {
-	return c.__id
+func (c *mqlProcess) GetOpenFiles() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.OpenFiles, func() ([]interface{}, error) {
+		return c.openFiles()
+	})
 }
 
-func (c *mqlPackages) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("packages", c.__id, "list")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlProcess) GetCgroup() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Cgroup, func() ([]interface{}, error) {
+		return c.cgroup()
+	})
+}
 
-		return c.list()
+func (c *mqlProcess) GetNamespaces() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Namespaces, func() (map[string]interface{}, error) {
+		return c.namespaces()
 	})
 }
 
-// mqlPamConf for the pam.conf resource
-type mqlPamConf struct {
+// mqlProcesses for the processes resource
+type mqlProcesses struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlPamConfInternal it will be used here
-	Files plugin.TValue[[]interface{}]
-	Content plugin.TValue[string]
-	Services plugin.TValue[map[string]interface{}]
-	Entries plugin.TValue[map[string]interface{}]
+	mqlProcessesInternal
+	List plugin.TValue[[]interface{}]
 }
 
-// createPamConf creates a new instance of this resource
-func createPamConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPamConf{
+// createProcesses creates a new instance of this resource
+func createProcesses(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlProcesses{
 		MqlRuntime: runtime,
 	}
 
@@ -6342,13 +8766,10 @@ func createPamConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 		return res, err
 	}
 
-	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("pam.conf", res.__id)
+		args, err = runtime.ResourceFromRecording("processes", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6358,18 +8779,18 @@ func createPamConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	return res, nil
 }
 
-func (c *mqlPamConf) MqlName() string {
-	return "pam.conf"
+func (c *mqlProcesses) MqlName() string {
+	return "processes"
 }
 
-func (c *mqlPamConf) MqlID() string {
+func (c *mqlProcesses) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPamConf) GetFiles() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Files, func() ([]interface{}, error) {
+func (c *mqlProcesses) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("pam.conf", c.__id, "files")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("processes", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
@@ -6378,69 +8799,29 @@ func (c *mqlPamConf) GetFiles() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.files()
-	})
-}
-
-func (c *mqlPamConf) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargFiles := c.GetFiles()
-		if vargFiles.Error != nil {
-			return "", vargFiles.Error
-		}
-
-		return c.content(vargFiles.Data)
-	})
-}
-
-func (c *mqlPamConf) GetServices() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Services, func() (map[string]interface{}, error) {
-		vargFiles := c.GetFiles()
-		if vargFiles.Error != nil {
-			return nil, vargFiles.Error
-		}
-
-		return c.services(vargFiles.Data)
-	})
-}
-
-func (c *mqlPamConf) GetEntries() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Entries, func() (map[string]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("pam.conf", c.__id, "entries")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(map[string]interface{}), nil
-			}
-		}
-
-		vargFiles := c.GetFiles()
-		if vargFiles.Error != nil {
-			return nil, vargFiles.Error
-		}
-
-		return c.entries(vargFiles.Data)
+		return c.list()
 	})
 }
 
-// mqlPamConfServiceEntry for the pam.conf.serviceEntry resource
-type mqlPamConfServiceEntry struct {
+// mqlPort for the port resource
+type mqlPort struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlPamConfServiceEntryInternal it will be used here
-	Service plugin.TValue[string]
-	LineNumber plugin.TValue[int64]
-	PamType plugin.TValue[string]
-	Control plugin.TValue[string]
-	Module plugin.TValue[string]
-	Options plugin.TValue[[]interface{}]
+	// optional: if you define mqlPortInternal it will be used here
+	Protocol plugin.TValue[string]
+	Port plugin.TValue[int64]
+	Address plugin.TValue[string]
+	User plugin.TValue[*mqlUser]
+	Process plugin.TValue[*mqlProcess]
+	State plugin.TValue[string]
+	RemoteAddress plugin.TValue[string]
+	RemotePort plugin.TValue[int64]
+	Tls plugin.TValue[plugin.Resource]
 }
 
-// createPamConfServiceEntry creates a new instance of this resource
-func createPamConfServiceEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPamConfServiceEntry{
+// createPort creates a new instance of this resource
+func createPort(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPort{
 		MqlRuntime: runtime,
 	}
 
@@ -6449,13 +8830,15 @@ func createPamConfServiceEntry(runtime *plugin.Runtime, args map[string]*llx.Raw
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("pam.conf.serviceEntry", res.__id)
+		args, err = runtime.ResourceFromRecording("port", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6465,95 +8848,89 @@ func createPamConfServiceEntry(runtime *plugin.Runtime, args map[string]*llx.Raw
 	return res, nil
 }
 
-func (c *mqlPamConfServiceEntry) MqlName() string {
-	return "pam.conf.serviceEntry"
+func (c *mqlPort) MqlName() string {
+	return "port"
 }
 
-func (c *mqlPamConfServiceEntry) MqlID() string {
+func (c *mqlPort) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPamConfServiceEntry) GetService() *plugin.TValue[string] {
-	return &c.Service
+func (c *mqlPort) GetProtocol() *plugin.TValue[string] {
+	return &c.Protocol
 }
 
-func (c *mqlPamConfServiceEntry) GetLineNumber() *plugin.TValue[int64] {
-	return &c.LineNumber
+func (c *mqlPort) GetPort() *plugin.TValue[int64] {
+	return &c.Port
 }
 
-func (c *mqlPamConfServiceEntry) GetPamType() *plugin.TValue[string] {
-	return &c.PamType
+func (c *mqlPort) GetAddress() *plugin.TValue[string] {
+	return &c.Address
 }
 
-func (c *mqlPamConfServiceEntry) GetControl() *plugin.TValue[string] {
-	return &c.Control
+func (c *mqlPort) GetUser() *plugin.TValue[*mqlUser] {
+	return &c.User
 }
 
-func (c *mqlPamConfServiceEntry) GetModule() *plugin.TValue[string] {
-	return &c.Module
+func (c *mqlPort) GetProcess() *plugin.TValue[*mqlProcess] {
+	return &c.Process
 }
 
-func (c *mqlPamConfServiceEntry) GetOptions() *plugin.TValue[[]interface{}] {
-	return &c.Options
+func (c *mqlPort) GetState() *plugin.TValue[string] {
+	return &c.State
 }
 
-// mqlSshd for the sshd resource
-type mqlSshd struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	// optional: if you define mqlSshdInternal it will be used here
+func (c *mqlPort) GetRemoteAddress() *plugin.TValue[string] {
+	return &c.RemoteAddress
 }
 
-// createSshd creates a new instance of this resource
-func createSshd(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlSshd{
-		MqlRuntime: runtime,
-	}
-
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
-	}
+func (c *mqlPort) GetRemotePort() *plugin.TValue[int64] {
+	return &c.RemotePort
+}
 
-	// to override __id implement: id() (string, error)
+func (c *mqlPort) GetTls() *plugin.TValue[plugin.Resource] {
+	return plugin.GetOrCompute[plugin.Resource](&c.Tls, func() (plugin.Resource, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("port", c.__id, "tls")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(plugin.Resource), nil
+			}
+		}
 
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("sshd", res.__id)
-		if err != nil || args == nil {
-			return res, err
+		vargAddress := c.GetAddress()
+		if vargAddress.Error != nil {
+			return nil, vargAddress.Error
 		}
-		return res, SetAllData(res, args)
-	}
 
-	return res, nil
-}
+		vargPort := c.GetPort()
+		if vargPort.Error != nil {
+			return nil, vargPort.Error
+		}
 
-func (c *mqlSshd) MqlName() string {
-	return "sshd"
-}
+		vargProtocol := c.GetProtocol()
+		if vargProtocol.Error != nil {
+			return nil, vargProtocol.Error
+		}
 
-func (c *mqlSshd) MqlID() string {
-	return c.__id
+		return c.tls(vargAddress.Data, vargPort.Data, vargProtocol.Data)
+	})
 }
 
-// mqlSshdConfig for the sshd.config resource
-type mqlSshdConfig struct {
+// mqlPorts for the ports resource
+type mqlPorts struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlSshdConfigInternal it will be used here
-	File plugin.TValue[*mqlFile]
-	Files plugin.TValue[[]interface{}]
-	Content plugin.TValue[string]
-	Params plugin.TValue[map[string]interface{}]
-	Ciphers plugin.TValue[[]interface{}]
-	Macs plugin.TValue[[]interface{}]
-	Kexs plugin.TValue[[]interface{}]
-	Hostkeys plugin.TValue[[]interface{}]
+	// optional: if you define mqlPortsInternal it will be used here
+	Listening plugin.TValue[[]interface{}]
+	List plugin.TValue[[]interface{}]
 }
 
-// createSshdConfig creates a new instance of this resource
-func createSshdConfig(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlSshdConfig{
+// createPorts creates a new instance of this resource
+func createPorts(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPorts{
 		MqlRuntime: runtime,
 	}
 
@@ -6562,13 +8939,15 @@ func createSshdConfig(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("sshd.config", res.__id)
+		args, err = runtime.ResourceFromRecording("ports", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6578,34 +8957,34 @@ func createSshdConfig(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	return res, nil
 }
 
-func (c *mqlSshdConfig) MqlName() string {
-	return "sshd.config"
+func (c *mqlPorts) MqlName() string {
+	return "ports"
 }
 
-func (c *mqlSshdConfig) MqlID() string {
+func (c *mqlPorts) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlSshdConfig) GetFile() *plugin.TValue[*mqlFile] {
-	return plugin.GetOrCompute[*mqlFile](&c.File, func() (*mqlFile, error) {
+func (c *mqlPorts) GetListening() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Listening, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("sshd.config", c.__id, "file")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("ports", c.__id, "listening")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(*mqlFile), nil
+				return d.Value.([]interface{}), nil
 			}
 		}
 
-		return c.file()
+		return c.listening()
 	})
 }
 
-func (c *mqlSshdConfig) GetFiles() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Files, func() ([]interface{}, error) {
+func (c *mqlPorts) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("sshd.config", c.__id, "files")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("ports", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
@@ -6614,98 +8993,82 @@ func (c *mqlSshdConfig) GetFiles() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return nil, vargFile.Error
-		}
-
-		return c.files(vargFile.Data)
+		return c.list()
 	})
 }
 
-func (c *mqlSshdConfig) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargFiles := c.GetFiles()
-		if vargFiles.Error != nil {
-			return "", vargFiles.Error
-		}
-
-		return c.content(vargFiles.Data)
-	})
+// mqlAuditpol for the auditpol resource
+type mqlAuditpol struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlAuditpolInternal it will be used here
+	List plugin.TValue[[]interface{}]
 }
 
-func (c *mqlSshdConfig) GetParams() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Params, func() (map[string]interface{}, error) {
-		vargContent := c.GetContent()
-		if vargContent.Error != nil {
-			return nil, vargContent.Error
-		}
-
-		return c.params(vargContent.Data)
-	})
-}
+// createAuditpol creates a new instance of this resource
+func createAuditpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAuditpol{
+		MqlRuntime: runtime,
+	}
 
-func (c *mqlSshdConfig) GetCiphers() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Ciphers, func() ([]interface{}, error) {
-		vargParams := c.GetParams()
-		if vargParams.Error != nil {
-			return nil, vargParams.Error
-		}
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
 
-		return c.ciphers(vargParams.Data)
-	})
-}
+	// to override __id implement: id() (string, error)
 
-func (c *mqlSshdConfig) GetMacs() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Macs, func() ([]interface{}, error) {
-		vargParams := c.GetParams()
-		if vargParams.Error != nil {
-			return nil, vargParams.Error
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("auditpol", res.__id)
+		if err != nil || args == nil {
+			return res, err
 		}
+		return res, SetAllData(res, args)
+	}
 
-		return c.macs(vargParams.Data)
-	})
+	return res, nil
 }
 
-func (c *mqlSshdConfig) GetKexs() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Kexs, func() ([]interface{}, error) {
-		vargParams := c.GetParams()
-		if vargParams.Error != nil {
-			return nil, vargParams.Error
-		}
+func (c *mqlAuditpol) MqlName() string {
+	return "auditpol"
+}
 
-		return c.kexs(vargParams.Data)
-	})
+func (c *mqlAuditpol) MqlID() string {
+	return c.__id
 }
 
-func (c *mqlSshdConfig) GetHostkeys() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Hostkeys, func() ([]interface{}, error) {
-		vargParams := c.GetParams()
-		if vargParams.Error != nil {
-			return nil, vargParams.Error
+func (c *mqlAuditpol) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("auditpol", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
 		}
 
-		return c.hostkeys(vargParams.Data)
+		return c.list()
 	})
 }
 
-// mqlService for the service resource
-type mqlService struct {
+// mqlAuditpolEntry for the auditpol.entry resource
+type mqlAuditpolEntry struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlServiceInternal it will be used here
-	Name plugin.TValue[string]
-	Description plugin.TValue[string]
-	Installed plugin.TValue[bool]
-	Running plugin.TValue[bool]
-	Enabled plugin.TValue[bool]
-	Type plugin.TValue[string]
-	Masked plugin.TValue[bool]
+	// optional: if you define mqlAuditpolEntryInternal it will be used here
+	Machinename plugin.TValue[string]
+	Policytarget plugin.TValue[string]
+	Subcategory plugin.TValue[string]
+	Subcategoryguid plugin.TValue[string]
+	Inclusionsetting plugin.TValue[string]
+	Exclusionsetting plugin.TValue[string]
 }
 
-// createService creates a new instance of this resource
-func createService(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlService{
+// createAuditpolEntry creates a new instance of this resource
+func createAuditpolEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAuditpolEntry{
 		MqlRuntime: runtime,
 	}
 
@@ -6714,13 +9077,15 @@ func createService(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("service", res.__id)
+		args, err = runtime.ResourceFromRecording("auditpol.entry", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6730,53 +9095,52 @@ func createService(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	return res, nil
 }
 
-func (c *mqlService) MqlName() string {
-	return "service"
+func (c *mqlAuditpolEntry) MqlName() string {
+	return "auditpol.entry"
 }
 
-func (c *mqlService) MqlID() string {
+func (c *mqlAuditpolEntry) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlService) GetName() *plugin.TValue[string] {
-	return &c.Name
-}
-
-func (c *mqlService) GetDescription() *plugin.TValue[string] {
-	return &c.Description
+func (c *mqlAuditpolEntry) GetMachinename() *plugin.TValue[string] {
+	return &c.Machinename
 }
 
-func (c *mqlService) GetInstalled() *plugin.TValue[bool] {
-	return &c.Installed
+func (c *mqlAuditpolEntry) GetPolicytarget() *plugin.TValue[string] {
+	return &c.Policytarget
 }
 
-func (c *mqlService) GetRunning() *plugin.TValue[bool] {
-	return &c.Running
+func (c *mqlAuditpolEntry) GetSubcategory() *plugin.TValue[string] {
+	return &c.Subcategory
 }
 
-func (c *mqlService) GetEnabled() *plugin.TValue[bool] {
-	return &c.Enabled
+func (c *mqlAuditpolEntry) GetSubcategoryguid() *plugin.TValue[string] {
+	return &c.Subcategoryguid
 }
 
-func (c *mqlService) GetType() *plugin.TValue[string] {
-	return &c.Type
+func (c *mqlAuditpolEntry) GetInclusionsetting() *plugin.TValue[string] {
+	return &c.Inclusionsetting
 }
 
-func (c *mqlService) GetMasked() *plugin.TValue[bool] {
-	return &c.Masked
+func (c *mqlAuditpolEntry) GetExclusionsetting() *plugin.TValue[string] {
+	return &c.Exclusionsetting
 }
 
-// mqlServices for the services resource
-type mqlServices struct {
+// mqlSecpol for the secpol resource
+type mqlSecpol struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlServicesInternal
-	List plugin.TValue[[]interface{}]
+	mqlSecpolInternal
+	Systemaccess plugin.TValue[map[string]interface{}]
+	Eventaudit plugin.TValue[map[string]interface{}]
+	Registryvalues plugin.TValue[map[string]interface{}]
+	Privilegerights plugin.TValue[map[string]interface{}]
 }
 
-// createServices creates a new instance of this resource
-func createServices(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlServices{
+// createSecpol creates a new instance of this resource
+func createSecpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSecpol{
 		MqlRuntime: runtime,
 	}
 
@@ -6788,7 +9152,7 @@ func createServices(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("services", res.__id)
+		args, err = runtime.ResourceFromRecording("secpol", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6798,44 +9162,52 @@ func createServices(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	return res, nil
 }
 
-func (c *mqlServices) MqlName() string {
-	return "services"
+func (c *mqlSecpol) MqlName() string {
+	return "secpol"
 }
 
-func (c *mqlServices) MqlID() string {
+func (c *mqlSecpol) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlServices) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("services", c.__id, "list")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlSecpol) GetSystemaccess() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Systemaccess, func() (map[string]interface{}, error) {
+		return c.systemaccess()
+	})
+}
 
-		return c.list()
+func (c *mqlSecpol) GetEventaudit() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Eventaudit, func() (map[string]interface{}, error) {
+		return c.eventaudit()
 	})
 }
 
-// mqlKernel for the kernel resource
-type mqlKernel struct {
+func (c *mqlSecpol) GetRegistryvalues() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Registryvalues, func() (map[string]interface{}, error) {
+		return c.registryvalues()
+	})
+}
+
+func (c *mqlSecpol) GetPrivilegerights() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Privilegerights, func() (map[string]interface{}, error) {
+		return c.privilegerights()
+	})
+}
+
+// mqlRegistrykey for the registrykey resource
+type mqlRegistrykey struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlKernelInternal
-	Info plugin.TValue[interface{}]
-	Parameters plugin.TValue[map[string]interface{}]
-	Modules plugin.TValue[[]interface{}]
-	Installed plugin.TValue[[]interface{}]
+	// optional: if you define mqlRegistrykeyInternal it will be used here
+	Path plugin.TValue[string]
+	Exists plugin.TValue[bool]
+	Properties plugin.TValue[map[string]interface{}]
+	Children plugin.TValue[[]interface{}]
 }
 
-// createKernel creates a new instance of this resource
-func createKernel(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlKernel{
+// createRegistrykey creates a new instance of this resource
+func createRegistrykey(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlRegistrykey{
 		MqlRuntime: runtime,
 	}
 
@@ -6844,10 +9216,15 @@ func createKernel(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("kernel", res.__id)
+		args, err = runtime.ResourceFromRecording("registrykey", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6857,61 +9234,50 @@ func createKernel(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	return res, nil
 }
 
-func (c *mqlKernel) MqlName() string {
-	return "kernel"
+func (c *mqlRegistrykey) MqlName() string {
+	return "registrykey"
 }
 
-func (c *mqlKernel) MqlID() string {
+func (c *mqlRegistrykey) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlKernel) GetInfo() *plugin.TValue[interface{}] {
-	return plugin.GetOrCompute[interface{}](&c.Info, func() (interface{}, error) {
-		return c.info()
-	})
+func (c *mqlRegistrykey) GetPath() *plugin.TValue[string] {
+	return &c.Path
 }
 
-func (c *mqlKernel) GetParameters() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Parameters, func() (map[string]interface{}, error) {
-		return c.parameters()
+func (c *mqlRegistrykey) GetExists() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Exists, func() (bool, error) {
+		return c.exists()
 	})
 }
 
-func (c *mqlKernel) GetModules() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Modules, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("kernel", c.__id, "modules")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		return c.modules()
+func (c *mqlRegistrykey) GetProperties() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Properties, func() (map[string]interface{}, error) {
+		return c.properties()
 	})
 }
 
-func (c *mqlKernel) GetInstalled() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Installed, func() ([]interface{}, error) {
-		return c.installed()
+func (c *mqlRegistrykey) GetChildren() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Children, func() ([]interface{}, error) {
+		return c.children()
 	})
 }
 
-// mqlKernelModule for the kernel.module resource
-type mqlKernelModule struct {
+// mqlRegistrykeyProperty for the registrykey.property resource
+type mqlRegistrykeyProperty struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlKernelModuleInternal it will be used here
+	// optional: if you define mqlRegistrykeyPropertyInternal it will be used here
+	Path plugin.TValue[string]
 	Name plugin.TValue[string]
-	Size plugin.TValue[string]
-	Loaded plugin.TValue[bool]
+	Exists plugin.TValue[bool]
+	Value plugin.TValue[string]
 }
 
-// createKernelModule creates a new instance of this resource
-func createKernelModule(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlKernelModule{
+// createRegistrykeyProperty creates a new instance of this resource
+func createRegistrykeyProperty(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlRegistrykeyProperty{
 		MqlRuntime: runtime,
 	}
 
@@ -6920,13 +9286,15 @@ func createKernelModule(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("kernel.module", res.__id)
+		args, err = runtime.ResourceFromRecording("registrykey.property", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6936,38 +9304,47 @@ func createKernelModule(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	return res, nil
 }
 
-func (c *mqlKernelModule) MqlName() string {
-	return "kernel.module"
+func (c *mqlRegistrykeyProperty) MqlName() string {
+	return "registrykey.property"
 }
 
-func (c *mqlKernelModule) MqlID() string {
+func (c *mqlRegistrykeyProperty) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlKernelModule) GetName() *plugin.TValue[string] {
+func (c *mqlRegistrykeyProperty) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlRegistrykeyProperty) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlKernelModule) GetSize() *plugin.TValue[string] {
-	return &c.Size
+func (c *mqlRegistrykeyProperty) GetExists() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Exists, func() (bool, error) {
+		return c.exists()
+	})
 }
 
-func (c *mqlKernelModule) GetLoaded() *plugin.TValue[bool] {
-	return &c.Loaded
+func (c *mqlRegistrykeyProperty) GetValue() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Value, func() (string, error) {
+		return c.value()
+	})
 }
 
-// mqlDocker for the docker resource
-type mqlDocker struct {
+// mqlWindows for the windows resource
+type mqlWindows struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlDockerInternal it will be used here
-	Images plugin.TValue[[]interface{}]
-	Containers plugin.TValue[[]interface{}]
+	// optional: if you define mqlWindowsInternal it will be used here
+	ComputerInfo plugin.TValue[interface{}]
+	Hotfixes plugin.TValue[[]interface{}]
+	Features plugin.TValue[[]interface{}]
 }
 
-// createDocker creates a new instance of this resource
-func createDocker(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDocker{
+// createWindows creates a new instance of this resource
+func createWindows(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlWindows{
 		MqlRuntime: runtime,
 	}
 
@@ -6979,7 +9356,7 @@ func createDocker(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker", res.__id)
+		args, err = runtime.ResourceFromRecording("windows", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6989,18 +9366,24 @@ func createDocker(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	return res, nil
 }
 
-func (c *mqlDocker) MqlName() string {
-	return "docker"
+func (c *mqlWindows) MqlName() string {
+	return "windows"
 }
 
-func (c *mqlDocker) MqlID() string {
+func (c *mqlWindows) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDocker) GetImages() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Images, func() ([]interface{}, error) {
+func (c *mqlWindows) GetComputerInfo() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.ComputerInfo, func() (interface{}, error) {
+		return c.computerInfo()
+	})
+}
+
+func (c *mqlWindows) GetHotfixes() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Hotfixes, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("docker", c.__id, "images")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("windows", c.__id, "hotfixes")
 			if err != nil {
 				return nil, err
 			}
@@ -7009,14 +9392,14 @@ func (c *mqlDocker) GetImages() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.images()
+		return c.hotfixes()
 	})
 }
 
-func (c *mqlDocker) GetContainers() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Containers, func() ([]interface{}, error) {
+func (c *mqlWindows) GetFeatures() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Features, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("docker", c.__id, "containers")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("windows", c.__id, "features")
 			if err != nil {
 				return nil, err
 			}
@@ -7025,25 +9408,25 @@ func (c *mqlDocker) GetContainers() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.containers()
+		return c.features()
 	})
 }
 
-// mqlDockerImage for the docker.image resource
-type mqlDockerImage struct {
+// mqlWindowsHotfix for the windows.hotfix resource
+type mqlWindowsHotfix struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlDockerImageInternal it will be used here
-	Id plugin.TValue[string]
-	Size plugin.TValue[int64]
-	Virtualsize plugin.TValue[int64]
-	Tags plugin.TValue[[]interface{}]
-	Labels plugin.TValue[map[string]interface{}]
+	// optional: if you define mqlWindowsHotfixInternal it will be used here
+	HotfixId plugin.TValue[string]
+	Description plugin.TValue[string]
+	Caption plugin.TValue[string]
+	InstalledOn plugin.TValue[*time.Time]
+	InstalledBy plugin.TValue[string]
 }
 
-// createDockerImage creates a new instance of this resource
-func createDockerImage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerImage{
+// createWindowsHotfix creates a new instance of this resource
+func createWindowsHotfix(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlWindowsHotfix{
 		MqlRuntime: runtime,
 	}
 
@@ -7052,13 +9435,15 @@ func createDockerImage(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.image", res.__id)
+		args, err = runtime.ResourceFromRecording("windows.hotfix", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -7068,53 +9453,50 @@ func createDockerImage(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	return res, nil
 }
 
-func (c *mqlDockerImage) MqlName() string {
-	return "docker.image"
+func (c *mqlWindowsHotfix) MqlName() string {
+	return "windows.hotfix"
 }
 
-func (c *mqlDockerImage) MqlID() string {
+func (c *mqlWindowsHotfix) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDockerImage) GetId() *plugin.TValue[string] {
-	return &c.Id
+func (c *mqlWindowsHotfix) GetHotfixId() *plugin.TValue[string] {
+	return &c.HotfixId
 }
 
-func (c *mqlDockerImage) GetSize() *plugin.TValue[int64] {
-	return &c.Size
+func (c *mqlWindowsHotfix) GetDescription() *plugin.TValue[string] {
+	return &c.Description
 }
 
-func (c *mqlDockerImage) GetVirtualsize() *plugin.TValue[int64] {
-	return &c.Virtualsize
+func (c *mqlWindowsHotfix) GetCaption() *plugin.TValue[string] {
+	return &c.Caption
 }
 
-func (c *mqlDockerImage) GetTags() *plugin.TValue[[]interface{}] {
-	return &c.Tags
+func (c *mqlWindowsHotfix) GetInstalledOn() *plugin.TValue[*time.Time] {
+	return &c.InstalledOn
 }
-
-func (c *mqlDockerImage) GetLabels() *plugin.TValue[map[string]interface{}] {
-	return &c.Labels
+
+func (c *mqlWindowsHotfix) GetInstalledBy() *plugin.TValue[string] {
+	return &c.InstalledBy
 }
 
-// mqlDockerContainer for the docker.container resource
-type mqlDockerContainer struct {
+// mqlWindowsFeature for the windows.feature resource
+type mqlWindowsFeature struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlDockerContainerInternal it will be used here
-	Os plugin.TValue[*mqlOsLinux]
-	Id plugin.TValue[string]
-	Command plugin.TValue[string]
-	Image plugin.TValue[string]
-	Imageid plugin.TValue[string]
-	Names plugin.TValue[[]interface{}]
-	State plugin.TValue[string]
-	Status plugin.TValue[string]
-	Labels plugin.TValue[map[string]interface{}]
+	// optional: if you define mqlWindowsFeatureInternal it will be used here
+	Path plugin.TValue[string]
+	Name plugin.TValue[string]
+	DisplayName plugin.TValue[string]
+	Description plugin.TValue[string]
+	Installed plugin.TValue[bool]
+	InstallState plugin.TValue[int64]
 }
 
-// createDockerContainer creates a new instance of this resource
-func createDockerContainer(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerContainer{
+// createWindowsFeature creates a new instance of this resource
+func createWindowsFeature(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlWindowsFeature{
 		MqlRuntime: runtime,
 	}
 
@@ -7123,13 +9505,15 @@ func createDockerContainer(runtime *plugin.Runtime, args map[string]*llx.RawData
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.container", res.__id)
+		args, err = runtime.ResourceFromRecording("windows.feature", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -7139,74 +9523,51 @@ func createDockerContainer(runtime *plugin.Runtime, args map[string]*llx.RawData
 	return res, nil
 }
 
-func (c *mqlDockerContainer) MqlName() string {
-	return "docker.container"
+func (c *mqlWindowsFeature) MqlName() string {
+	return "windows.feature"
 }
 
-func (c *mqlDockerContainer) MqlID() string {
+func (c *mqlWindowsFeature) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDockerContainer) GetOs() *plugin.TValue[*mqlOsLinux] {
-	return plugin.GetOrCompute[*mqlOsLinux](&c.Os, func() (*mqlOsLinux, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("docker.container", c.__id, "os")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlOsLinux), nil
-			}
-		}
-
-		return c.os()
-	})
-}
-
-func (c *mqlDockerContainer) GetId() *plugin.TValue[string] {
-	return &c.Id
-}
-
-func (c *mqlDockerContainer) GetCommand() *plugin.TValue[string] {
-	return &c.Command
-}
-
-func (c *mqlDockerContainer) GetImage() *plugin.TValue[string] {
-	return &c.Image
+func (c *mqlWindowsFeature) GetPath() *plugin.TValue[string] {
+	return &c.Path
 }
 
-func (c *mqlDockerContainer) GetImageid() *plugin.TValue[string] {
-	return &c.Imageid
+func (c *mqlWindowsFeature) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlDockerContainer) GetNames() *plugin.TValue[[]interface{}] {
-	return &c.Names
+func (c *mqlWindowsFeature) GetDisplayName() *plugin.TValue[string] {
+	return &c.DisplayName
 }
 
-func (c *mqlDockerContainer) GetState() *plugin.TValue[string] {
-	return &c.State
+func (c *mqlWindowsFeature) GetDescription() *plugin.TValue[string] {
+	return &c.Description
 }
 
-func (c *mqlDockerContainer) GetStatus() *plugin.TValue[string] {
-	return &c.Status
+func (c *mqlWindowsFeature) GetInstalled() *plugin.TValue[bool] {
+	return &c.Installed
 }
 
-func (c *mqlDockerContainer) GetLabels() *plugin.TValue[map[string]interface{}] {
-	return &c.Labels
+func (c *mqlWindowsFeature) GetInstallState() *plugin.TValue[int64] {
+	return &c.InstallState
 }
 
-// mqlIptables for the iptables resource
-type mqlIptables struct {
+// mqlWindowsFirewall for the windows.firewall resource
+type mqlWindowsFirewall struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlIptablesInternal it will be used here
-	Input plugin.TValue[[]interface{}]
-	Output plugin.TValue[[]interface{}]
+	// optional: if you define mqlWindowsFirewallInternal it will be used here
+	Settings plugin.TValue[interface{}]
+	Profiles plugin.TValue[[]interface{}]
+	Rules plugin.TValue[[]interface{}]
 }
 
-// createIptables creates a new instance of this resource
-func createIptables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlIptables{
+// createWindowsFirewall creates a new instance of this resource
+func createWindowsFirewall(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlWindowsFirewall{
 		MqlRuntime: runtime,
 	}
 
@@ -7218,7 +9579,7 @@ func createIptables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("iptables", res.__id)
+		args, err = runtime.ResourceFromRecording("windows.firewall", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -7228,18 +9589,24 @@ func createIptables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	return res, nil
 }
 
-func (c *mqlIptables) MqlName() string {
-	return "iptables"
+func (c *mqlWindowsFirewall) MqlName() string {
+	return "windows.firewall"
 }
 
-func (c *mqlIptables) MqlID() string {
+func (c *mqlWindowsFirewall) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlIptables) GetInput() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Input, func() ([]interface{}, error) {
+func (c *mqlWindowsFirewall) GetSettings() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Settings, func() (interface{}, error) {
+		return c.settings()
+	})
+}
+
+func (c *mqlWindowsFirewall) GetProfiles() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Profiles, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("iptables", c.__id, "input")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("windows.firewall", c.__id, "profiles")
 			if err != nil {
 				return nil, err
 			}
@@ -7248,14 +9615,14 @@ func (c *mqlIptables) GetInput() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.input()
+		return c.profiles()
 	})
 }
 
-func (c *mqlIptables) GetOutput() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Output, func() ([]interface{}, error) {
+func (c *mqlWindowsFirewall) GetRules() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Rules, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("iptables", c.__id, "output")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("windows.firewall", c.__id, "rules")
 			if err != nil {
 				return nil, err
 			}
@@ -7264,22 +9631,38 @@ func (c *mqlIptables) GetOutput() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.output()
+		return c.rules()
 	})
 }
 
-// mqlIp6tables for the ip6tables resource
-type mqlIp6tables struct {
+// mqlWindowsFirewallProfile for the windows.firewall.profile resource
+type mqlWindowsFirewallProfile struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlIp6tablesInternal it will be used here
-	Input plugin.TValue[[]interface{}]
-	Output plugin.TValue[[]interface{}]
-}
-
-// createIp6tables creates a new instance of this resource
-func createIp6tables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlIp6tables{
+	// optional: if you define mqlWindowsFirewallProfileInternal it will be used here
+	InstanceID plugin.TValue[string]
+	Name plugin.TValue[string]
+	Enabled plugin.TValue[int64]
+	DefaultInboundAction plugin.TValue[int64]
+	DefaultOutboundAction plugin.TValue[int64]
+	AllowInboundRules plugin.TValue[int64]
+	AllowLocalFirewallRules plugin.TValue[int64]
+	AllowLocalIPsecRules plugin.TValue[int64]
+	AllowUserApps plugin.TValue[int64]
+	AllowUserPorts plugin.TValue[int64]
+	AllowUnicastResponseToMulticast plugin.TValue[int64]
+	NotifyOnListen plugin.TValue[int64]
+	EnableStealthModeForIPsec plugin.TValue[int64]
+	LogMaxSizeKilobytes plugin.TValue[int64]
+	LogAllowed plugin.TValue[int64]
+	LogBlocked plugin.TValue[int64]
+	LogIgnored plugin.TValue[int64]
+	LogFileName plugin.TValue[string]
+}
+
+// createWindowsFirewallProfile creates a new instance of this resource
+func createWindowsFirewallProfile(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlWindowsFirewallProfile{
 		MqlRuntime: runtime,
 	}
 
@@ -7288,10 +9671,15 @@ func createIp6tables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("ip6tables", res.__id)
+		args, err = runtime.ResourceFromRecording("windows.firewall.profile", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -7301,68 +9689,112 @@ func createIp6tables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	return res, nil
 }
 
-func (c *mqlIp6tables) MqlName() string {
-	return "ip6tables"
+func (c *mqlWindowsFirewallProfile) MqlName() string {
+	return "windows.firewall.profile"
 }
 
-func (c *mqlIp6tables) MqlID() string {
+func (c *mqlWindowsFirewallProfile) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlIp6tables) GetInput() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Input, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("ip6tables", c.__id, "input")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlWindowsFirewallProfile) GetInstanceID() *plugin.TValue[string] {
+	return &c.InstanceID
+}
 
-		return c.input()
-	})
+func (c *mqlWindowsFirewallProfile) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlIp6tables) GetOutput() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Output, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("ip6tables", c.__id, "output")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlWindowsFirewallProfile) GetEnabled() *plugin.TValue[int64] {
+	return &c.Enabled
+}
 
-		return c.output()
-	})
+func (c *mqlWindowsFirewallProfile) GetDefaultInboundAction() *plugin.TValue[int64] {
+	return &c.DefaultInboundAction
 }
 
-// mqlIptablesEntry for the iptables.entry resource
-type mqlIptablesEntry struct {
+func (c *mqlWindowsFirewallProfile) GetDefaultOutboundAction() *plugin.TValue[int64] {
+	return &c.DefaultOutboundAction
+}
+
+func (c *mqlWindowsFirewallProfile) GetAllowInboundRules() *plugin.TValue[int64] {
+	return &c.AllowInboundRules
+}
+
+func (c *mqlWindowsFirewallProfile) GetAllowLocalFirewallRules() *plugin.TValue[int64] {
+	return &c.AllowLocalFirewallRules
+}
+
+func (c *mqlWindowsFirewallProfile) GetAllowLocalIPsecRules() *plugin.TValue[int64] {
+	return &c.AllowLocalIPsecRules
+}
+
+func (c *mqlWindowsFirewallProfile) GetAllowUserApps() *plugin.TValue[int64] {
+	return &c.AllowUserApps
+}
+
+func (c *mqlWindowsFirewallProfile) GetAllowUserPorts() *plugin.TValue[int64] {
+	return &c.AllowUserPorts
+}
+
+func (c *mqlWindowsFirewallProfile) GetAllowUnicastResponseToMulticast() *plugin.TValue[int64] {
+	return &c.AllowUnicastResponseToMulticast
+}
+
+func (c *mqlWindowsFirewallProfile) GetNotifyOnListen() *plugin.TValue[int64] {
+	return &c.NotifyOnListen
+}
+
+func (c *mqlWindowsFirewallProfile) GetEnableStealthModeForIPsec() *plugin.TValue[int64] {
+	return &c.EnableStealthModeForIPsec
+}
+
+func (c *mqlWindowsFirewallProfile) GetLogMaxSizeKilobytes() *plugin.TValue[int64] {
+	return &c.LogMaxSizeKilobytes
+}
+
+func (c *mqlWindowsFirewallProfile) GetLogAllowed() *plugin.TValue[int64] {
+	return &c.LogAllowed
+}
+
+func (c *mqlWindowsFirewallProfile) GetLogBlocked() *plugin.TValue[int64] {
+	return &c.LogBlocked
+}
+
+func (c *mqlWindowsFirewallProfile) GetLogIgnored() *plugin.TValue[int64] {
+	return &c.LogIgnored
+}
+
+func (c *mqlWindowsFirewallProfile) GetLogFileName() *plugin.TValue[string] {
+	return &c.LogFileName
+}
+
+// mqlWindowsFirewallRule for the windows.firewall.rule resource
+type mqlWindowsFirewallRule struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlIptablesEntryInternal it will be used here
-	LineNumber plugin.TValue[int64]
-	Packets plugin.TValue[int64]
-	Bytes plugin.TValue[int64]
-	Target plugin.TValue[string]
-	Protocol plugin.TValue[string]
-	Opt plugin.TValue[string]
-	In plugin.TValue[string]
-	Out plugin.TValue[string]
-	Source plugin.TValue[string]
-	Destination plugin.TValue[string]
-	Options plugin.TValue[string]
-	Chain plugin.TValue[string]
+	// optional: if you define mqlWindowsFirewallRuleInternal it will be used here
+	InstanceID plugin.TValue[string]
+	Name plugin.TValue[string]
+	DisplayName plugin.TValue[string]
+	Description plugin.TValue[string]
+	DisplayGroup plugin.TValue[string]
+	Enabled plugin.TValue[int64]
+	Direction plugin.TValue[int64]
+	Action plugin.TValue[int64]
+	EdgeTraversalPolicy plugin.TValue[int64]
+	LooseSourceMapping plugin.TValue[bool]
+	LocalOnlyMapping plugin.TValue[bool]
+	PrimaryStatus plugin.TValue[int64]
+	Status plugin.TValue[string]
+	EnforcementStatus plugin.TValue[string]
+	PolicyStoreSource plugin.TValue[string]
+	PolicyStoreSourceType plugin.TValue[int64]
 }
 
-// createIptablesEntry creates a new instance of this resource
-func createIptablesEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlIptablesEntry{
+// createWindowsFirewallRule creates a new instance of this resource
+func createWindowsFirewallRule(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlWindowsFirewallRule{
 		MqlRuntime: runtime,
 	}
 
@@ -7371,13 +9803,15 @@ func createIptablesEntry(runtime *plugin.Runtime, args map[string]*llx.RawData)
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("iptables.entry", res.__id)
+		args, err = runtime.ResourceFromRecording("windows.firewall.rule", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -7387,77 +9821,152 @@ func createIptablesEntry(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlIptablesEntry) MqlName() string {
-	return "iptables.entry"
+func (c *mqlWindowsFirewallRule) MqlName() string {
+	return "windows.firewall.rule"
 }
 
-func (c *mqlIptablesEntry) MqlID() string {
+func (c *mqlWindowsFirewallRule) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlIptablesEntry) GetLineNumber() *plugin.TValue[int64] {
-	return &c.LineNumber
+func (c *mqlWindowsFirewallRule) GetInstanceID() *plugin.TValue[string] {
+	return &c.InstanceID
 }
 
-func (c *mqlIptablesEntry) GetPackets() *plugin.TValue[int64] {
-	return &c.Packets
+func (c *mqlWindowsFirewallRule) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlIptablesEntry) GetBytes() *plugin.TValue[int64] {
-	return &c.Bytes
+func (c *mqlWindowsFirewallRule) GetDisplayName() *plugin.TValue[string] {
+	return &c.DisplayName
 }
 
-func (c *mqlIptablesEntry) GetTarget() *plugin.TValue[string] {
-	return &c.Target
+func (c *mqlWindowsFirewallRule) GetDescription() *plugin.TValue[string] {
+	return &c.Description
 }
 
-func (c *mqlIptablesEntry) GetProtocol() *plugin.TValue[string] {
-	return &c.Protocol
+func (c *mqlWindowsFirewallRule) GetDisplayGroup() *plugin.TValue[string] {
+	return &c.DisplayGroup
 }
 
-func (c *mqlIptablesEntry) GetOpt() *plugin.TValue[string] {
-	return &c.Opt
+func (c *mqlWindowsFirewallRule) GetEnabled() *plugin.TValue[int64] {
+	return &c.Enabled
 }
 
-func (c *mqlIptablesEntry) GetIn() *plugin.TValue[string] {
-	return &c.In
+func (c *mqlWindowsFirewallRule) GetDirection() *plugin.TValue[int64] {
+	return &c.Direction
+}
+
+func (c *mqlWindowsFirewallRule) GetAction() *plugin.TValue[int64] {
+	return &c.Action
+}
+
+func (c *mqlWindowsFirewallRule) GetEdgeTraversalPolicy() *plugin.TValue[int64] {
+	return &c.EdgeTraversalPolicy
+}
+
+func (c *mqlWindowsFirewallRule) GetLooseSourceMapping() *plugin.TValue[bool] {
+	return &c.LooseSourceMapping
+}
+
+func (c *mqlWindowsFirewallRule) GetLocalOnlyMapping() *plugin.TValue[bool] {
+	return &c.LocalOnlyMapping
+}
+
+func (c *mqlWindowsFirewallRule) GetPrimaryStatus() *plugin.TValue[int64] {
+	return &c.PrimaryStatus
+}
+
+func (c *mqlWindowsFirewallRule) GetStatus() *plugin.TValue[string] {
+	return &c.Status
+}
+
+func (c *mqlWindowsFirewallRule) GetEnforcementStatus() *plugin.TValue[string] {
+	return &c.EnforcementStatus
+}
+
+func (c *mqlWindowsFirewallRule) GetPolicyStoreSource() *plugin.TValue[string] {
+	return &c.PolicyStoreSource
+}
+
+func (c *mqlWindowsFirewallRule) GetPolicyStoreSourceType() *plugin.TValue[int64] {
+	return &c.PolicyStoreSourceType
 }
 
-func (c *mqlIptablesEntry) GetOut() *plugin.TValue[string] {
-	return &c.Out
+// mqlWindowsBitlocker for the windows.bitlocker resource
+type mqlWindowsBitlocker struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlWindowsBitlockerInternal it will be used here
+	Volumes plugin.TValue[[]interface{}]
+}
+
+// createWindowsBitlocker creates a new instance of this resource
+func createWindowsBitlocker(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlWindowsBitlocker{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("windows.bitlocker", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
 }
 
-func (c *mqlIptablesEntry) GetSource() *plugin.TValue[string] {
-	return &c.Source
+func (c *mqlWindowsBitlocker) MqlName() string {
+	return "windows.bitlocker"
 }
 
-func (c *mqlIptablesEntry) GetDestination() *plugin.TValue[string] {
-	return &c.Destination
+func (c *mqlWindowsBitlocker) MqlID() string {
+	return c.__id
 }
 
-func (c *mqlIptablesEntry) GetOptions() *plugin.TValue[string] {
-	return &c.Options
-}
+func (c *mqlWindowsBitlocker) GetVolumes() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Volumes, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("windows.bitlocker", c.__id, "volumes")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlIptablesEntry) GetChain() *plugin.TValue[string] {
-	return &c.Chain
+		return c.volumes()
+	})
 }
 
-// mqlProcess for the process resource
-type mqlProcess struct {
+// mqlWindowsBitlockerVolume for the windows.bitlocker.volume resource
+type mqlWindowsBitlockerVolume struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlProcessInternal
-	Pid plugin.TValue[int64]
-	State plugin.TValue[string]
-	Executable plugin.TValue[string]
-	Command plugin.TValue[string]
-	Flags plugin.TValue[map[string]interface{}]
-}
-
-// createProcess creates a new instance of this resource
-func createProcess(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlProcess{
+	// optional: if you define mqlWindowsBitlockerVolumeInternal it will be used here
+	DeviceID plugin.TValue[string]
+	DriveLetter plugin.TValue[string]
+	ConversionStatus plugin.TValue[interface{}]
+	EncryptionMethod plugin.TValue[interface{}]
+	LockStatus plugin.TValue[int64]
+	PersistentVolumeID plugin.TValue[string]
+	ProtectionStatus plugin.TValue[interface{}]
+	Version plugin.TValue[interface{}]
+}
+
+// createWindowsBitlockerVolume creates a new instance of this resource
+func createWindowsBitlockerVolume(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlWindowsBitlockerVolume{
 		MqlRuntime: runtime,
 	}
 
@@ -7466,13 +9975,15 @@ func createProcess(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("process", res.__id)
+		args, err = runtime.ResourceFromRecording("windows.bitlocker.volume", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -7482,53 +9993,57 @@ func createProcess(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	return res, nil
 }
 
-func (c *mqlProcess) MqlName() string {
-	return "process"
+func (c *mqlWindowsBitlockerVolume) MqlName() string {
+	return "windows.bitlocker.volume"
 }
 
-func (c *mqlProcess) MqlID() string {
+func (c *mqlWindowsBitlockerVolume) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlProcess) GetPid() *plugin.TValue[int64] {
-	return &c.Pid
+func (c *mqlWindowsBitlockerVolume) GetDeviceID() *plugin.TValue[string] {
+	return &c.DeviceID
 }
 
-func (c *mqlProcess) GetState() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.State, func() (string, error) {
-		return c.state()
-	})
+func (c *mqlWindowsBitlockerVolume) GetDriveLetter() *plugin.TValue[string] {
+	return &c.DriveLetter
 }
 
-func (c *mqlProcess) GetExecutable() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Executable, func() (string, error) {
-		return c.executable()
-	})
+func (c *mqlWindowsBitlockerVolume) GetConversionStatus() *plugin.TValue[interface{}] {
+	return &c.ConversionStatus
 }
 
-func (c *mqlProcess) GetCommand() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Command, func() (string, error) {
-		return c.command()
-	})
+func (c *mqlWindowsBitlockerVolume) GetEncryptionMethod() *plugin.TValue[interface{}] {
+	return &c.EncryptionMethod
 }
 
-func (c *mqlProcess) GetFlags() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Flags, func() (map[string]interface{}, error) {
-		return c.flags()
-	})
+func (c *mqlWindowsBitlockerVolume) GetLockStatus() *plugin.TValue[int64] {
+	return &c.LockStatus
 }
 
-// mqlProcesses for the processes resource
-type mqlProcesses struct {
+func (c *mqlWindowsBitlockerVolume) GetPersistentVolumeID() *plugin.TValue[string] {
+	return &c.PersistentVolumeID
+}
+
+func (c *mqlWindowsBitlockerVolume) GetProtectionStatus() *plugin.TValue[interface{}] {
+	return &c.ProtectionStatus
+}
+
+func (c *mqlWindowsBitlockerVolume) GetVersion() *plugin.TValue[interface{}] {
+	return &c.Version
+}
+
+// mqlWindowsSecurity for the windows.security resource
+type mqlWindowsSecurity struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlProcessesInternal
-	List plugin.TValue[[]interface{}]
+	// optional: if you define mqlWindowsSecurityInternal it will be used here
+	Products plugin.TValue[[]interface{}]
 }
 
-// createProcesses creates a new instance of this resource
-func createProcesses(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlProcesses{
+// createWindowsSecurity creates a new instance of this resource
+func createWindowsSecurity(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlWindowsSecurity{
 		MqlRuntime: runtime,
 	}
 
@@ -7540,7 +10055,7 @@ func createProcesses(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("processes", res.__id)
+		args, err = runtime.ResourceFromRecording("windows.security", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -7550,18 +10065,18 @@ func createProcesses(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	return res, nil
 }
 
-func (c *mqlProcesses) MqlName() string {
-	return "processes"
+func (c *mqlWindowsSecurity) MqlName() string {
+	return "windows.security"
 }
 
-func (c *mqlProcesses) MqlID() string {
+func (c *mqlWindowsSecurity) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlProcesses) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+func (c *mqlWindowsSecurity) GetProducts() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Products, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("processes", c.__id, "list")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("windows.security", c.__id, "products")
 			if err != nil {
 				return nil, err
 			}
@@ -7570,29 +10085,27 @@ func (c *mqlProcesses) GetList() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.list()
+		return c.products()
 	})
 }
 
-// mqlPort for the port resource
-type mqlPort struct {
+// mqlWindowsSecurityProduct for the windows.security.product resource
+type mqlWindowsSecurityProduct struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlPortInternal it will be used here
-	Protocol plugin.TValue[string]
-	Port plugin.TValue[int64]
-	Address plugin.TValue[string]
-	User plugin.TValue[*mqlUser]
-	Process plugin.TValue[*mqlProcess]
-	State plugin.TValue[string]
-	RemoteAddress plugin.TValue[string]
-	RemotePort plugin.TValue[int64]
-	Tls plugin.TValue[plugin.Resource]
+	// optional: if you define mqlWindowsSecurityProductInternal it will be used here
+	Type plugin.TValue[string]
+	Guid plugin.TValue[string]
+	Name plugin.TValue[string]
+	State plugin.TValue[int64]
+	ProductState plugin.TValue[string]
+	SignatureState plugin.TValue[string]
+	Timestamp plugin.TValue[*time.Time]
 }
 
-// createPort creates a new instance of this resource
-func createPort(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPort{
+// createWindowsSecurityProduct creates a new instance of this resource
+func createWindowsSecurityProduct(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlWindowsSecurityProduct{
 		MqlRuntime: runtime,
 	}
 
@@ -7601,13 +10114,15 @@ func createPort(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.R
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("port", res.__id)
+		args, err = runtime.ResourceFromRecording("windows.security.product", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -7617,89 +10132,134 @@ func createPort(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.R
 	return res, nil
 }
 
-func (c *mqlPort) MqlName() string {
-	return "port"
+func (c *mqlWindowsSecurityProduct) MqlName() string {
+	return "windows.security.product"
 }
 
-func (c *mqlPort) MqlID() string {
+func (c *mqlWindowsSecurityProduct) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPort) GetProtocol() *plugin.TValue[string] {
-	return &c.Protocol
+func (c *mqlWindowsSecurityProduct) GetType() *plugin.TValue[string] {
+	return &c.Type
 }
 
-func (c *mqlPort) GetPort() *plugin.TValue[int64] {
-	return &c.Port
+func (c *mqlWindowsSecurityProduct) GetGuid() *plugin.TValue[string] {
+	return &c.Guid
 }
 
-func (c *mqlPort) GetAddress() *plugin.TValue[string] {
-	return &c.Address
+func (c *mqlWindowsSecurityProduct) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlPort) GetUser() *plugin.TValue[*mqlUser] {
-	return &c.User
+func (c *mqlWindowsSecurityProduct) GetState() *plugin.TValue[int64] {
+	return &c.State
 }
 
-func (c *mqlPort) GetProcess() *plugin.TValue[*mqlProcess] {
-	return &c.Process
+func (c *mqlWindowsSecurityProduct) GetProductState() *plugin.TValue[string] {
+	return &c.ProductState
 }
 
-func (c *mqlPort) GetState() *plugin.TValue[string] {
-	return &c.State
+func (c *mqlWindowsSecurityProduct) GetSignatureState() *plugin.TValue[string] {
+	return &c.SignatureState
 }
 
-func (c *mqlPort) GetRemoteAddress() *plugin.TValue[string] {
-	return &c.RemoteAddress
+func (c *mqlWindowsSecurityProduct) GetTimestamp() *plugin.TValue[*time.Time] {
+	return &c.Timestamp
 }
 
-func (c *mqlPort) GetRemotePort() *plugin.TValue[int64] {
-	return &c.RemotePort
-}
+// mqlWindowsSecurityHealth for the windows.security.health resource
+type mqlWindowsSecurityHealth struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlWindowsSecurityHealthInternal it will be used here
+	Firewall plugin.TValue[interface{}]
+	AutoUpdate plugin.TValue[interface{}]
+	AntiVirus plugin.TValue[interface{}]
+	AntiSpyware plugin.TValue[interface{}]
+	InternetSettings plugin.TValue[interface{}]
+	Uac plugin.TValue[interface{}]
+	SecurityCenterService plugin.TValue[interface{}]
+}
+
+// createWindowsSecurityHealth creates a new instance of this resource
+func createWindowsSecurityHealth(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlWindowsSecurityHealth{
+		MqlRuntime: runtime,
+	}
 
-func (c *mqlPort) GetTls() *plugin.TValue[plugin.Resource] {
-	return plugin.GetOrCompute[plugin.Resource](&c.Tls, func() (plugin.Resource, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("port", c.__id, "tls")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(plugin.Resource), nil
-			}
-		}
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
 
-		vargAddress := c.GetAddress()
-		if vargAddress.Error != nil {
-			return nil, vargAddress.Error
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		vargPort := c.GetPort()
-		if vargPort.Error != nil {
-			return nil, vargPort.Error
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("windows.security.health", res.__id)
+		if err != nil || args == nil {
+			return res, err
 		}
+		return res, SetAllData(res, args)
+	}
 
-		vargProtocol := c.GetProtocol()
-		if vargProtocol.Error != nil {
-			return nil, vargProtocol.Error
-		}
+	return res, nil
+}
 
-		return c.tls(vargAddress.Data, vargPort.Data, vargProtocol.Data)
-	})
+func (c *mqlWindowsSecurityHealth) MqlName() string {
+	return "windows.security.health"
 }
 
-// mqlPorts for the ports resource
-type mqlPorts struct {
+func (c *mqlWindowsSecurityHealth) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlWindowsSecurityHealth) GetFirewall() *plugin.TValue[interface{}] {
+	return &c.Firewall
+}
+
+func (c *mqlWindowsSecurityHealth) GetAutoUpdate() *plugin.TValue[interface{}] {
+	return &c.AutoUpdate
+}
+
+func (c *mqlWindowsSecurityHealth) GetAntiVirus() *plugin.TValue[interface{}] {
+	return &c.AntiVirus
+}
+
+func (c *mqlWindowsSecurityHealth) GetAntiSpyware() *plugin.TValue[interface{}] {
+	return &c.AntiSpyware
+}
+
+func (c *mqlWindowsSecurityHealth) GetInternetSettings() *plugin.TValue[interface{}] {
+	return &c.InternetSettings
+}
+
+func (c *mqlWindowsSecurityHealth) GetUac() *plugin.TValue[interface{}] {
+	return &c.Uac
+}
+
+func (c *mqlWindowsSecurityHealth) GetSecurityCenterService() *plugin.TValue[interface{}] {
+	return &c.SecurityCenterService
+}
+
+// mqlMacos for the macos resource
+type mqlMacos struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlPortsInternal it will be used here
-	Listening plugin.TValue[[]interface{}]
-	List plugin.TValue[[]interface{}]
+	// optional: if you define mqlMacosInternal it will be used here
+	UserPreferences plugin.TValue[map[string]interface{}]
+	UserHostPreferences plugin.TValue[map[string]interface{}]
+	GlobalAccountPolicies plugin.TValue[interface{}]
 }
 
-// createPorts creates a new instance of this resource
-func createPorts(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPorts{
+// createMacos creates a new instance of this resource
+func createMacos(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlMacos{
 		MqlRuntime: runtime,
 	}
 
@@ -7708,13 +10268,15 @@ func createPorts(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("ports", res.__id)
+		args, err = runtime.ResourceFromRecording("macos", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -7724,57 +10286,53 @@ func createPorts(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 	return res, nil
 }
 
-func (c *mqlPorts) MqlName() string {
-	return "ports"
+func (c *mqlMacos) MqlName() string {
+	return "macos"
 }
 
-func (c *mqlPorts) MqlID() string {
+func (c *mqlMacos) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPorts) GetListening() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Listening, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("ports", c.__id, "listening")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		return c.listening()
+func (c *mqlMacos) GetUserPreferences() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.UserPreferences, func() (map[string]interface{}, error) {
+		return c.userPreferences()
 	})
 }
 
-func (c *mqlPorts) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("ports", c.__id, "list")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlMacos) GetUserHostPreferences() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.UserHostPreferences, func() (map[string]interface{}, error) {
+		return c.userHostPreferences()
+	})
+}
 
-		return c.list()
+func (c *mqlMacos) GetGlobalAccountPolicies() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.GlobalAccountPolicies, func() (interface{}, error) {
+		return c.globalAccountPolicies()
 	})
 }
 
-// mqlAuditpol for the auditpol resource
-type mqlAuditpol struct {
+// mqlMacosAlf for the macos.alf resource
+type mqlMacosAlf struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlAuditpolInternal it will be used here
-	List plugin.TValue[[]interface{}]
+	// optional: if you define mqlMacosAlfInternal it will be used here
+	AllowDownloadSignedEnabled plugin.TValue[int64]
+	AllowSignedEnabled plugin.TValue[int64]
+	FirewallUnload plugin.TValue[int64]
+	GlobalState plugin.TValue[int64]
+	LoggingEnabled plugin.TValue[int64]
+	LoggingOption plugin.TValue[int64]
+	StealthEnabled plugin.TValue[int64]
+	Version plugin.TValue[string]
+	Exceptions plugin.TValue[[]interface{}]
+	ExplicitAuths plugin.TValue[[]interface{}]
+	Applications plugin.TValue[[]interface{}]
 }
 
-// createAuditpol creates a new instance of this resource
-func createAuditpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlAuditpol{
+// createMacosAlf creates a new instance of this resource
+func createMacosAlf(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlMacosAlf{
 		MqlRuntime: runtime,
 	}
 
@@ -7783,10 +10341,15 @@ func createAuditpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
-
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("auditpol", res.__id)
+		args, err = runtime.ResourceFromRecording("macos.alf", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -7796,46 +10359,88 @@ func createAuditpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	return res, nil
 }
 
-func (c *mqlAuditpol) MqlName() string {
-	return "auditpol"
+func (c *mqlMacosAlf) MqlName() string {
+	return "macos.alf"
 }
 
-func (c *mqlAuditpol) MqlID() string {
+func (c *mqlMacosAlf) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlAuditpol) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("auditpol", c.__id, "list")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlMacosAlf) GetAllowDownloadSignedEnabled() *plugin.TValue[int64] {
+	return &c.AllowDownloadSignedEnabled
+}
 
-		return c.list()
-	})
+func (c *mqlMacosAlf) GetAllowSignedEnabled() *plugin.TValue[int64] {
+	return &c.AllowSignedEnabled
 }
 
-// mqlAuditpolEntry for the auditpol.entry resource
-type mqlAuditpolEntry struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	// optional: if you define mqlAuditpolEntryInternal it will be used here
-	Machinename plugin.TValue[string]
-	Policytarget plugin.TValue[string]
-	Subcategory plugin.TValue[string]
-	Subcategoryguid plugin.TValue[string]
-	Inclusionsetting plugin.TValue[string]
-	Exclusionsetting plugin.TValue[string]
+func (c *mqlMacosAlf) GetFirewallUnload() *plugin.TValue[int64] {
+	return &c.FirewallUnload
 }
 
-// createAuditpolEntry creates a new instance of this resource
-func createAuditpolEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlAuditpolEntry{
+func (c *mqlMacosAlf) GetGlobalState() *plugin.TValue[int64] {
+	return &c.GlobalState
+}
+
+func (c *mqlMacosAlf) GetLoggingEnabled() *plugin.TValue[int64] {
+	return &c.LoggingEnabled
+}
+
+func (c *mqlMacosAlf) GetLoggingOption() *plugin.TValue[int64] {
+	return &c.LoggingOption
+}
+
+func (c *mqlMacosAlf) GetStealthEnabled() *plugin.TValue[int64] {
+	return &c.StealthEnabled
+}
+
+func (c *mqlMacosAlf) GetVersion() *plugin.TValue[string] {
+	return &c.Version
+}
+
+func (c *mqlMacosAlf) GetExceptions() *plugin.TValue[[]interface{}] {
+	return &c.Exceptions
+}
+
+func (c *mqlMacosAlf) GetExplicitAuths() *plugin.TValue[[]interface{}] {
+	return &c.ExplicitAuths
+}
+
+func (c *mqlMacosAlf) GetApplications() *plugin.TValue[[]interface{}] {
+	return &c.Applications
+}
+
+// mqlMacosSystemsetup for the macos.systemsetup resource
+type mqlMacosSystemsetup struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlMacosSystemsetupInternal it will be used here
+	Date plugin.TValue[string]
+	Time plugin.TValue[string]
+	TimeZone plugin.TValue[string]
+	UsingNetworkTime plugin.TValue[string]
+	NetworkTimeServer plugin.TValue[string]
+	Sleep plugin.TValue[[]interface{}]
+	DisplaySleep plugin.TValue[string]
+	HarddiskSleep plugin.TValue[string]
+	WakeOnModem plugin.TValue[string]
+	WakeOnNetworkAccess plugin.TValue[string]
+	RestartPowerFailure plugin.TValue[string]
+	RestartFreeze plugin.TValue[string]
+	AllowPowerButtonToSleepComputer plugin.TValue[string]
+	RemoteLogin plugin.TValue[string]
+	RemoteAppleEvents plugin.TValue[string]
+	ComputerName plugin.TValue[string]
+	LocalSubnetName plugin.TValue[string]
+	StartupDisk plugin.TValue[string]
+	WaitForStartupAfterPowerFailure plugin.TValue[string]
+	DisableKeyboardWhenEnclosureLockIsEngaged plugin.TValue[string]
+}
+
+// createMacosSystemsetup creates a new instance of this resource
+func createMacosSystemsetup(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlMacosSystemsetup{
 		MqlRuntime: runtime,
 	}
 
@@ -7844,13 +10449,15 @@ func createAuditpolEntry(runtime *plugin.Runtime, args map[string]*llx.RawData)
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("auditpol.entry", res.__id)
+		args, err = runtime.ResourceFromRecording("macos.systemsetup", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -7860,52 +10467,145 @@ func createAuditpolEntry(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlAuditpolEntry) MqlName() string {
-	return "auditpol.entry"
+func (c *mqlMacosSystemsetup) MqlName() string {
+	return "macos.systemsetup"
 }
 
-func (c *mqlAuditpolEntry) MqlID() string {
+func (c *mqlMacosSystemsetup) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlAuditpolEntry) GetMachinename() *plugin.TValue[string] {
-	return &c.Machinename
+func (c *mqlMacosSystemsetup) GetDate() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Date, func() (string, error) {
+		return c.date()
+	})
 }
 
-func (c *mqlAuditpolEntry) GetPolicytarget() *plugin.TValue[string] {
-	return &c.Policytarget
+func (c *mqlMacosSystemsetup) GetTime() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Time, func() (string, error) {
+		return c.time()
+	})
 }
 
-func (c *mqlAuditpolEntry) GetSubcategory() *plugin.TValue[string] {
-	return &c.Subcategory
+func (c *mqlMacosSystemsetup) GetTimeZone() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.TimeZone, func() (string, error) {
+		return c.timeZone()
+	})
 }
 
-func (c *mqlAuditpolEntry) GetSubcategoryguid() *plugin.TValue[string] {
-	return &c.Subcategoryguid
+func (c *mqlMacosSystemsetup) GetUsingNetworkTime() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.UsingNetworkTime, func() (string, error) {
+		return c.usingNetworkTime()
+	})
 }
 
-func (c *mqlAuditpolEntry) GetInclusionsetting() *plugin.TValue[string] {
-	return &c.Inclusionsetting
+func (c *mqlMacosSystemsetup) GetNetworkTimeServer() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.NetworkTimeServer, func() (string, error) {
+		return c.networkTimeServer()
+	})
 }
 
-func (c *mqlAuditpolEntry) GetExclusionsetting() *plugin.TValue[string] {
-	return &c.Exclusionsetting
+func (c *mqlMacosSystemsetup) GetSleep() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Sleep, func() ([]interface{}, error) {
+		return c.sleep()
+	})
 }
 
-// mqlSecpol for the secpol resource
-type mqlSecpol struct {
+func (c *mqlMacosSystemsetup) GetDisplaySleep() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.DisplaySleep, func() (string, error) {
+		return c.displaySleep()
+	})
+}
+
+func (c *mqlMacosSystemsetup) GetHarddiskSleep() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.HarddiskSleep, func() (string, error) {
+		return c.harddiskSleep()
+	})
+}
+
+func (c *mqlMacosSystemsetup) GetWakeOnModem() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.WakeOnModem, func() (string, error) {
+		return c.wakeOnModem()
+	})
+}
+
+func (c *mqlMacosSystemsetup) GetWakeOnNetworkAccess() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.WakeOnNetworkAccess, func() (string, error) {
+		return c.wakeOnNetworkAccess()
+	})
+}
+
+func (c *mqlMacosSystemsetup) GetRestartPowerFailure() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.RestartPowerFailure, func() (string, error) {
+		return c.restartPowerFailure()
+	})
+}
+
+func (c *mqlMacosSystemsetup) GetRestartFreeze() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.RestartFreeze, func() (string, error) {
+		return c.restartFreeze()
+	})
+}
+
+func (c *mqlMacosSystemsetup) GetAllowPowerButtonToSleepComputer() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.AllowPowerButtonToSleepComputer, func() (string, error) {
+		return c.allowPowerButtonToSleepComputer()
+	})
+}
+
+func (c *mqlMacosSystemsetup) GetRemoteLogin() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.RemoteLogin, func() (string, error) {
+		return c.remoteLogin()
+	})
+}
+
+func (c *mqlMacosSystemsetup) GetRemoteAppleEvents() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.RemoteAppleEvents, func() (string, error) {
+		return c.remoteAppleEvents()
+	})
+}
+
+func (c *mqlMacosSystemsetup) GetComputerName() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.ComputerName, func() (string, error) {
+		return c.computerName()
+	})
+}
+
+func (c *mqlMacosSystemsetup) GetLocalSubnetName() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.LocalSubnetName, func() (string, error) {
+		return c.localSubnetName()
+	})
+}
+
+func (c *mqlMacosSystemsetup) GetStartupDisk() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.StartupDisk, func() (string, error) {
+		return c.startupDisk()
+	})
+}
+
+func (c *mqlMacosSystemsetup) GetWaitForStartupAfterPowerFailure() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.WaitForStartupAfterPowerFailure, func() (string, error) {
+		return c.waitForStartupAfterPowerFailure()
+	})
+}
+
+func (c *mqlMacosSystemsetup) GetDisableKeyboardWhenEnclosureLockIsEngaged() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.DisableKeyboardWhenEnclosureLockIsEngaged, func() (string, error) {
+		return c.disableKeyboardWhenEnclosureLockIsEngaged()
+	})
+}
+
+// mqlMacosTimemachine for the macos.timemachine resource
+type mqlMacosTimemachine struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlSecpolInternal
-	Systemaccess plugin.TValue[map[string]interface{}]
-	Eventaudit plugin.TValue[map[string]interface{}]
-	Registryvalues plugin.TValue[map[string]interface{}]
-	Privilegerights plugin.TValue[map[string]interface{}]
+	// optional: if you define mqlMacosTimemachineInternal it will be used here
+	Preferences plugin.TValue[interface{}]
 }
 
-// createSecpol creates a new instance of this resource
-func createSecpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlSecpol{
+// createMacosTimemachine creates a new instance of this resource
+func createMacosTimemachine(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlMacosTimemachine{
 		MqlRuntime: runtime,
 	}
 
@@ -7914,10 +10614,15 @@ func createSecpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("secpol", res.__id)
+		args, err = runtime.ResourceFromRecording("macos.timemachine", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -7927,35 +10632,68 @@ func createSecpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	return res, nil
 }
 
-func (c *mqlSecpol) MqlName() string {
-	return "secpol"
+func (c *mqlMacosTimemachine) MqlName() string {
+	return "macos.timemachine"
 }
 
-func (c *mqlSecpol) MqlID() string {
+func (c *mqlMacosTimemachine) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlSecpol) GetSystemaccess() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Systemaccess, func() (map[string]interface{}, error) {
-		return c.systemaccess()
+func (c *mqlMacosTimemachine) GetPreferences() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Preferences, func() (interface{}, error) {
+		return c.preferences()
 	})
 }
 
-func (c *mqlSecpol) GetEventaudit() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Eventaudit, func() (map[string]interface{}, error) {
-		return c.eventaudit()
-	})
+// mqlMacosSecurity for the macos.security resource
+type mqlMacosSecurity struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlMacosSecurityInternal it will be used here
+	AuthorizationDB plugin.TValue[interface{}]
 }
 
-func (c *mqlSecpol) GetRegistryvalues() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Registryvalues, func() (map[string]interface{}, error) {
-		return c.registryvalues()
-	})
+// createMacosSecurity creates a new instance of this resource
+func createMacosSecurity(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlMacosSecurity{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("macos.security", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
 }
 
-func (c *mqlSecpol) GetPrivilegerights() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Privilegerights, func() (map[string]interface{}, error) {
-		return c.privilegerights()
+func (c *mqlMacosSecurity) MqlName() string {
+	return "macos.security"
+}
+
+func (c *mqlMacosSecurity) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlMacosSecurity) GetAuthorizationDB() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.AuthorizationDB, func() (interface{}, error) {
+		return c.authorizationDB()
 	})
 }
 
@@ -7983,9 +10721,11 @@ func createNtpConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
@@ -8100,9 +10840,11 @@ func createRsyslogConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
@@ -8194,9 +10936,11 @@ func createLogindefs(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
@@ -8275,9 +11019,11 @@ func createLsblk(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
@@ -8338,9 +11084,11 @@ func createLsblkEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
@@ -8401,9 +11149,11 @@ func createMount(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
@@ -8464,9 +11214,11 @@ func createMountPoint(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
@@ -8527,9 +11279,11 @@ func createShadow(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
@@ -8594,9 +11348,11 @@ func createShadowEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
@@ -8674,9 +11430,11 @@ func createYum(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Re
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
@@ -8750,9 +11508,11 @@ func createYumRepo(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 		return res, err
 	}
 
+	if res.__id == "" {
 	res.__id, err = res.id()
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if runtime.HasRecording {
@@ -0,0 +1,68 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/providers/os/connection/mock"
+)
+
+func TestResource_ProcessDetails(t *testing.T) {
+	conn, err := mock.New("./processes/testdata/debian.toml", &inventory.Asset{
+		Platform: &inventory.Platform{
+			Name:   "debian",
+			Family: []string{"debian", "linux", "unix", "os"},
+		},
+	})
+	require.NoError(t, err)
+	runtime := &plugin.Runtime{
+		Connection: conn,
+		Resources:  map[string]plugin.Resource{},
+	}
+
+	o, err := CreateResource(runtime, "processes", map[string]*llx.RawData{})
+	require.NoError(t, err)
+	list := o.(*mqlProcesses).GetList()
+	require.NoError(t, list.Error)
+	require.Len(t, list.Data, 3)
+
+	p := list.Data[0].(*mqlProcess)
+	assert.Equal(t, int64(1), p.Pid.Data)
+	assert.Equal(t, int64(3232*1024), p.GetRss().Data)
+	assert.Equal(t, int64(12124*1024), p.GetVsize().Data)
+
+	user := p.GetUser()
+	require.NoError(t, user.Error)
+	require.NotNil(t, user.Data)
+	assert.Equal(t, "root", user.Data.Name.Data)
+
+	parent := p.GetParent()
+	require.NoError(t, parent.Error)
+	assert.NotZero(t, parent.State&plugin.StateIsNull, "init has no parent")
+
+	startTime := p.GetStartTime()
+	require.NoError(t, startTime.Error)
+	require.NotNil(t, startTime.Data)
+	assert.WithinDuration(t, time.Now().Add(-(12*24*time.Hour + 3*time.Hour + 4*time.Minute + 5*time.Second)), *startTime.Data, 2*time.Second)
+
+	// ps does not expose the working directory of a process
+	cwd := p.GetCwd()
+	require.NoError(t, cwd.Error)
+	assert.NotZero(t, cwd.State&plugin.StateIsNull)
+
+	children := p.GetChildren()
+	require.NoError(t, children.Error)
+	require.Len(t, children.Data, 2)
+	assert.Equal(t, int64(46), children.Data[0].(*mqlProcess).Pid.Data)
+	assert.Equal(t, int64(3987), children.Data[1].(*mqlProcess).Pid.Data)
+
+	parent = children.Data[0].(*mqlProcess).GetParent()
+	require.NoError(t, parent.Error)
+	require.NotNil(t, parent.Data)
+	assert.Equal(t, int64(1), parent.Data.Pid.Data)
+}
@@ -3,8 +3,11 @@ package resources
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"go.mondoo.com/cnquery/llx"
@@ -16,6 +19,7 @@ import (
 type mqlProcessInternal struct {
 	SocketInodesError error
 	SocketInodes      plugin.TValue[[]int64]
+	details           *processes.OSProcess
 	lock              sync.Mutex
 }
 
@@ -85,8 +89,169 @@ func (p *mqlProcess) flags() (map[string]interface{}, error) {
 	return res, nil
 }
 
+func (p *mqlProcess) user() (*mqlUser, error) {
+	details, err := p.processDetails()
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := CreateResource(p.MqlRuntime, "users", map[string]*llx.RawData{})
+	if err != nil {
+		return nil, err
+	}
+	users := obj.(*mqlUsers)
+	if err := users.refreshCache(nil); err != nil {
+		return nil, err
+	}
+
+	var user *mqlUser
+	if details.Uid >= 0 {
+		user = users.usersByID[details.Uid]
+	} else if details.User != "" {
+		user = users.usersByName[details.User]
+		// windows reports users as DOMAIN\user
+		if i := strings.LastIndex(details.User, "\\"); user == nil && i >= 0 {
+			user = users.usersByName[details.User[i+1:]]
+		}
+	}
+
+	if user == nil {
+		p.User.State = plugin.StateIsSet | plugin.StateIsNull
+		return nil, nil
+	}
+	return user, nil
+}
+
+func (p *mqlProcess) parent() (*mqlProcess, error) {
+	details, err := p.processDetails()
+	if err != nil {
+		return nil, err
+	}
+
+	if details.PPid <= 0 {
+		p.Parent.State = plugin.StateIsSet | plugin.StateIsNull
+		return nil, nil
+	}
+
+	o, err := CreateResource(p.MqlRuntime, "process", map[string]*llx.RawData{
+		"pid": llx.IntData(details.PPid),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return o.(*mqlProcess), nil
+}
+
+func (p *mqlProcess) children() ([]interface{}, error) {
+	obj, err := CreateResource(p.MqlRuntime, "processes", map[string]*llx.RawData{})
+	if err != nil {
+		return nil, err
+	}
+	procs := obj.(*mqlProcesses)
+	if err := procs.refreshCache(nil); err != nil {
+		return nil, err
+	}
+
+	children := []*mqlProcess{}
+	for _, process := range procs.ByPID {
+		if process.details != nil && process.details.PPid == p.Pid.Data {
+			children = append(children, process)
+		}
+	}
+	sort.Slice(children, func(i, j int) bool {
+		return children[i].Pid.Data < children[j].Pid.Data
+	})
+
+	res := make([]interface{}, len(children))
+	for i := range children {
+		res[i] = children[i]
+	}
+	return res, nil
+}
+
+func (p *mqlProcess) cwd() (string, error) {
+	return processDetail(p, &p.Cwd, func(dm processes.OSProcessDetailsManager) (string, bool, error) {
+		cwd, err := dm.Cwd(p.Pid.Data)
+		return cwd, cwd != "", err
+	})
+}
+
+func (p *mqlProcess) env() (map[string]interface{}, error) {
+	return processDetail(p, &p.Env, func(dm processes.OSProcessDetailsManager) (map[string]interface{}, bool, error) {
+		env, err := dm.Environment(p.Pid.Data)
+		return llx.TMap2Raw(env), env != nil, err
+	})
+}
+
+func (p *mqlProcess) startTime() (*time.Time, error) {
+	return nil, p.gatherProcessInfo()
+}
+
+func (p *mqlProcess) rss() (int64, error) {
+	return 0, p.gatherProcessInfo()
+}
+
+func (p *mqlProcess) vsize() (int64, error) {
+	return 0, p.gatherProcessInfo()
+}
+
+func (p *mqlProcess) openFiles() ([]interface{}, error) {
+	return processDetail(p, &p.OpenFiles, func(dm processes.OSProcessDetailsManager) ([]interface{}, bool, error) {
+		files, err := dm.OpenFiles(p.Pid.Data)
+		return llx.TArr2Raw(files), files != nil, err
+	})
+}
+
+func (p *mqlProcess) cgroup() ([]interface{}, error) {
+	return nil, p.gatherProcessInfo()
+}
+
+func (p *mqlProcess) namespaces() (map[string]interface{}, error) {
+	return processDetail(p, &p.Namespaces, func(dm processes.OSProcessDetailsManager) (map[string]interface{}, bool, error) {
+		namespaces, err := dm.Namespaces(p.Pid.Data)
+		return llx.TMap2Raw(namespaces), namespaces != nil, err
+	})
+}
+
+// processDetail gathers a detail of the process, which is only collected on
+// demand. It is null if the process manager can't collect it or if it is
+// not accessible, e.g. for processes of other users. Errors are kept with
+// the field, so that they are not requested again.
+func processDetail[T any](p *mqlProcess, field *plugin.TValue[T], get func(dm processes.OSProcessDetailsManager) (T, bool, error)) (T, error) {
+	var res T
+	conn := p.MqlRuntime.Connection.(shared.Connection)
+	opm, err := processes.ResolveManager(conn)
+	if err != nil {
+		return res, errors.New("cannot find process manager")
+	}
+
+	dm, ok := opm.(processes.OSProcessDetailsManager)
+	if !ok {
+		*field = plugin.TValue[T]{State: plugin.StateIsSet | plugin.StateIsNull}
+		return res, nil
+	}
+
+	res, found, err := get(dm)
+	if err != nil || !found {
+		*field = plugin.TValue[T]{Error: err, State: plugin.StateIsSet | plugin.StateIsNull}
+	}
+	return res, nil
+}
+
 type ProcessCallbackTrigger func()
 
+// processDetails returns the details of this process and gathers them if
+// they were not set when the process was listed
+func (p *mqlProcess) processDetails() (*processes.OSProcess, error) {
+	if p.details != nil {
+		return p.details, nil
+	}
+	if err := p.gatherProcessInfo(); err != nil {
+		return nil, err
+	}
+	return p.details, nil
+}
+
 func (p *mqlProcess) gatherProcessInfo() error {
 	p.lock.Lock()
 	defer p.lock.Unlock()
@@ -98,16 +263,36 @@ func (p *mqlProcess) gatherProcessInfo() error {
 	}
 
 	process, err := opm.Process(p.Pid.Data)
-	if err != nil {
+	if err != nil || process == nil {
 		return errors.New("cannot gather process details")
 	}
 
+	p.setProcessDetails(process)
+	return nil
+}
+
+func (p *mqlProcess) setProcessDetails(process *processes.OSProcess) {
+	p.details = process
 	p.State = plugin.TValue[string]{Data: process.State, State: plugin.StateIsSet}
 	p.Executable = plugin.TValue[string]{Data: process.Executable, State: plugin.StateIsSet}
 	p.Command = plugin.TValue[string]{Data: process.Command, State: plugin.StateIsSet}
-	p.SocketInodes = plugin.TValue[[]int64]{Data: process.SocketInodes, State: plugin.StateIsSet}
+	p.SocketInodes = plugin.TValue[[]int64]{Data: process.SocketInodes, Error: process.SocketInodesError, State: plugin.StateIsSet}
+	p.Rss = plugin.TValue[int64]{Data: process.Rss, State: plugin.StateIsSet}
+	p.Vsize = plugin.TValue[int64]{Data: process.Vsize, State: plugin.StateIsSet}
+
+	// not all process managers are able to collect the following values,
+	// in which case they are null
+	if process.StartTime == nil {
+		p.StartTime = plugin.TValue[*time.Time]{State: plugin.StateIsSet | plugin.StateIsNull}
+	} else {
+		p.StartTime = plugin.TValue[*time.Time]{Data: process.StartTime, State: plugin.StateIsSet}
+	}
 
-	return nil
+	if process.Cgroup == nil {
+		p.Cgroup = plugin.TValue[[]interface{}]{State: plugin.StateIsSet | plugin.StateIsNull}
+	} else {
+		p.Cgroup = plugin.TValue[[]interface{}]{Data: llx.TArr2Raw(process.Cgroup), State: plugin.StateIsSet}
+	}
 }
 
 type mqlProcessesInternal struct {
@@ -147,11 +332,7 @@ func (p *mqlProcesses) list() ([]interface{}, error) {
 		}
 
 		process := o.(*mqlProcess)
-		process.SocketInodes = plugin.TValue[[]int64]{
-			Data:  proc.SocketInodes,
-			Error: proc.SocketInodesError,
-			State: plugin.StateIsSet,
-		}
+		process.setProcessDetails(proc)
 
 		procs[i] = o
 	}
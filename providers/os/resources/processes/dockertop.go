@@ -51,6 +51,8 @@ func (lpm *DockerTopManager) List() ([]*OSProcess, error) {
 			Executable:   p[2],
 			Command:      p[4],
 			State:        p[3],
+			Uid:          -1,
+			User:         p[1],
 			SocketInodes: nil,
 		})
 	}
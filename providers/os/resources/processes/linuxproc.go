@@ -1,8 +1,12 @@
 package processes
 
 import (
+	"io"
 	"path/filepath"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/rs/zerolog/log"
@@ -11,8 +15,16 @@ import (
 	"go.mondoo.com/cnquery/providers/os/resources/procfs"
 )
 
+// linuxClockTicks is USER_HZ, the unit of the starttime in /proc/<pid>/stat.
+// The kernel reports it as 100 to user space on all architectures.
+const linuxClockTicks = 100
+
 type LinuxProcManager struct {
 	conn shared.Connection
+
+	bootTimeOnce sync.Once
+	bootTime     int64
+	bootTimeErr  error
 }
 
 func (lpm *LinuxProcManager) Name() string {
@@ -99,9 +111,177 @@ func (lpm *LinuxProcManager) Process(pid int64) (*OSProcess, error) {
 		Executable:        status.Executable,
 		State:             status.State,
 		Command:           cmdline,
+		PPid:              status.PPid,
+		Uid:               status.Uid,
+		Rss:               status.VmRSS,
+		Vsize:             status.VmSize,
 		SocketInodes:      socketInodes,
 		SocketInodesError: socketInodesErr,
 	}
 
+	process.StartTime, err = lpm.procStartTime(pidPath)
+	if err != nil {
+		log.Debug().Err(err).Int64("pid", pid).Msg("mql[processes]> could not determine process start time")
+	}
+	process.Cgroup, err = lpm.procCgroup(pidPath)
+	if err != nil {
+		log.Debug().Err(err).Int64("pid", pid).Msg("mql[processes]> could not read process cgroup")
+	}
+
 	return process, nil
 }
+
+// The following details need one request per link or file, so they are only
+// gathered on demand. They are only accessible for processes of the same
+// user or with elevated privileges.
+
+func (lpm *LinuxProcManager) Cwd(pid int64) (string, error) {
+	return lpm.readlink(filepath.Join(procPidPath(pid), "cwd"))
+}
+
+func (lpm *LinuxProcManager) Environment(pid int64) (map[string]string, error) {
+	return lpm.procEnviron(procPidPath(pid))
+}
+
+func (lpm *LinuxProcManager) OpenFiles(pid int64) ([]string, error) {
+	return lpm.procOpenFiles(procPidPath(pid))
+}
+
+func (lpm *LinuxProcManager) Namespaces(pid int64) (map[string]string, error) {
+	return lpm.procNamespaces(procPidPath(pid))
+}
+
+func procPidPath(pid int64) string {
+	return filepath.Join("/proc", strconv.FormatInt(pid, 10))
+}
+
+func (lpm *LinuxProcManager) procStartTime(pidPath string) (*time.Time, error) {
+	lpm.bootTimeOnce.Do(func() {
+		f, err := lpm.conn.FileSystem().Open("/proc/stat")
+		if err != nil {
+			lpm.bootTimeErr = err
+			return
+		}
+		defer f.Close()
+		lpm.bootTime, lpm.bootTimeErr = procfs.ParseBootTime(f)
+	})
+	if lpm.bootTimeErr != nil {
+		return nil, lpm.bootTimeErr
+	}
+
+	f, err := lpm.conn.FileSystem().Open(filepath.Join(pidPath, "stat"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	stat, err := procfs.ParseProcessStat(f)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Unix(lpm.bootTime, 0).Add(clockTicksToDuration(stat.StartTime))
+	return &startTime, nil
+}
+
+// clockTicksToDuration converts clock ticks to a duration. Whole seconds are
+// converted separately, so that tick counts of long-running hosts don't
+// overflow.
+func clockTicksToDuration(ticks uint64) time.Duration {
+	return time.Duration(ticks/linuxClockTicks)*time.Second +
+		time.Duration(ticks%linuxClockTicks)*time.Second/linuxClockTicks
+}
+
+func (lpm *LinuxProcManager) procCgroup(pidPath string) ([]string, error) {
+	f, err := lpm.conn.FileSystem().Open(filepath.Join(pidPath, "cgroup"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return procfs.ParseProcessCgroup(f)
+}
+
+func (lpm *LinuxProcManager) procEnviron(pidPath string) (map[string]string, error) {
+	f, err := lpm.conn.FileSystem().Open(filepath.Join(pidPath, "environ"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return procfs.ParseProcessEnviron(f)
+}
+
+// procOpenFiles resolves all file descriptors of the process to their targets,
+// e.g. file paths, sockets or pipes
+func (lpm *LinuxProcManager) procOpenFiles(pidPath string) ([]string, error) {
+	fdDirPath := filepath.Join(pidPath, "fd")
+	fds, err := lpm.readdirnames(fdDirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []string{}
+	for i := range fds {
+		target, err := lpm.readlink(filepath.Join(fdDirPath, fds[i]))
+		if err != nil {
+			// file descriptors may be closed while we iterate over them
+			continue
+		}
+		res = append(res, target)
+	}
+	return res, nil
+}
+
+// procNamespaces returns the namespaces of the process by their type, e.g.
+// net -> net:[4026531840]
+func (lpm *LinuxProcManager) procNamespaces(pidPath string) (map[string]string, error) {
+	nsDirPath := filepath.Join(pidPath, "ns")
+	names, err := lpm.readdirnames(nsDirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	res := map[string]string{}
+	for i := range names {
+		target, err := lpm.readlink(filepath.Join(nsDirPath, names[i]))
+		if err != nil {
+			return nil, err
+		}
+		res[names[i]] = target
+	}
+	return res, nil
+}
+
+func (lpm *LinuxProcManager) readdirnames(path string) ([]string, error) {
+	dir, err := lpm.conn.FileSystem().Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer dir.Close()
+
+	return dir.Readdirnames(-1)
+}
+
+// readlink uses the filesystem if it is able to resolve links and falls back
+// to the readlink command otherwise
+func (lpm *LinuxProcManager) readlink(path string) (string, error) {
+	if lr, ok := lpm.conn.FileSystem().(afero.LinkReader); ok {
+		return lr.ReadlinkIfPossible(path)
+	}
+
+	c, err := lpm.conn.RunCommand("readlink " + path)
+	if err != nil {
+		return "", err
+	}
+	if c.ExitStatus != 0 {
+		stderr, _ := io.ReadAll(c.Stderr)
+		return "", errors.New("could not read link " + path + ": " + strings.TrimSpace(string(stderr)))
+	}
+
+	out, err := io.ReadAll(c.Stdout)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
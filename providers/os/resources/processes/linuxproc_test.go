@@ -1 +1,51 @@
-package processes_test
+package processes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/providers/os/connection/mock"
+)
+
+func TestClockTicksToDuration(t *testing.T) {
+	assert.Equal(t, 123450*time.Millisecond, clockTicksToDuration(12345))
+	// processes started about 5 years after boot
+	assert.Equal(t, 5*365*24*time.Hour+250*time.Millisecond, clockTicksToDuration(5*365*24*3600*100+25))
+}
+
+func TestLinuxProcManager(t *testing.T) {
+	conn, err := mock.New("./testdata/linux-proc.toml", nil)
+	require.NoError(t, err)
+
+	lpm := &LinuxProcManager{conn: conn}
+	procs, err := lpm.List()
+	require.NoError(t, err)
+	require.Len(t, procs, 1)
+
+	p := procs[0]
+	assert.Equal(t, int64(4711), p.Pid)
+	assert.Equal(t, "payload", p.Executable)
+	assert.Equal(t, "/tmp/payload --listen 4444", p.Command)
+	assert.Equal(t, int64(1), p.PPid)
+	assert.Equal(t, int64(0), p.Uid, "uses the effective uid")
+	assert.Equal(t, int64(1024*1024), p.Rss)
+	assert.Equal(t, int64(8492*1024), p.Vsize)
+	require.NotNil(t, p.StartTime)
+	assert.Equal(t, time.Unix(1700000000, 0).Add(123450*time.Millisecond).Unix(), p.StartTime.Unix())
+	assert.Equal(t, []string{"0::/user.slice/user-1000.slice/session-2.scope"}, p.Cgroup)
+
+	cwd, err := lpm.Cwd(p.Pid)
+	require.NoError(t, err)
+	assert.Equal(t, "/tmp", cwd)
+	env, err := lpm.Environment(p.Pid)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"PATH": "/usr/bin:/bin", "LD_PRELOAD": "/tmp/hook.so"}, env)
+	openFiles, err := lpm.OpenFiles(p.Pid)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"/dev/null", "/var/log/payload.log"}, openFiles)
+	namespaces, err := lpm.Namespaces(p.Pid)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"net": "net:[4026531840]", "pid": "pid:[4026531836]"}, namespaces)
+}
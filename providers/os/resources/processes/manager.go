@@ -2,6 +2,7 @@ package processes
 
 import (
 	"errors"
+	"time"
 
 	"go.mondoo.com/cnquery/providers/os/connection"
 	"go.mondoo.com/cnquery/providers/os/connection/mock"
@@ -9,11 +10,20 @@ import (
 )
 
 type OSProcess struct {
-	Pid               int64
-	Command           string
-	Executable        string
-	State             string
-	Uid               int64
+	Pid        int64
+	Command    string
+	Executable string
+	State      string
+	// PPid is the id of the parent process, 0 if there is no parent or it is unknown
+	PPid int64
+	// Uid is the effective user id, -1 if the platform does not expose it
+	Uid int64
+	// User holds the user name for platforms that do not expose the uid
+	User              string
+	StartTime         *time.Time
+	Rss               int64 // resident set size in bytes
+	Vsize             int64 // virtual memory size in bytes
+	Cgroup            []string
 	SocketInodes      []int64
 	SocketInodesError error
 }
@@ -25,6 +35,16 @@ type OSProcessManager interface {
 	List() ([]*OSProcess, error)
 }
 
+// OSProcessDetailsManager is implemented by process managers that can
+// gather details, which are too expensive to collect for every process.
+// They are only gathered when they are requested.
+type OSProcessDetailsManager interface {
+	Cwd(pid int64) (string, error)
+	Environment(pid int64) (map[string]string, error)
+	OpenFiles(pid int64) ([]string, error)
+	Namespaces(pid int64) (map[string]string, error)
+}
+
 func ResolveManager(conn shared.Connection) (OSProcessManager, error) {
 	var pm OSProcessManager
 
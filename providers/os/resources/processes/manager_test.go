@@ -40,6 +40,10 @@ func TestManagerMacos(t *testing.T) {
 	require.NoError(t, err)
 
 	assert.Equal(t, 41, len(mounts))
+	assert.Equal(t, int64(1), mounts[0].Pid)
+	assert.Equal(t, int64(0), mounts[0].Uid)
+	assert.Equal(t, int64(17948*1024), mounts[0].Rss)
+	assert.Equal(t, int64(5015084*1024), mounts[0].Vsize)
 }
 
 func TestManagerFreebsd(t *testing.T) {
@@ -2,9 +2,11 @@ package processes
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
+	"regexp"
+	"strconv"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"go.mondoo.com/cnquery/providers/os/connection/shared"
@@ -12,7 +14,7 @@ import (
 )
 
 const (
-	Ps1GetProcess = "Get-Process -IncludeUserName | Select-Object Name, Description, Id, PriorityClass, PM, NPM, CPU, VirtualMemorySize, Responding, SessionId, StartTime, TotalProcessorTime, UserName, Path | ConvertTo-Json"
+	Ps1GetProcess = "Get-Process -IncludeUserName | Select-Object Name, Description, Id, PriorityClass, PM, NPM, CPU, VirtualMemorySize, WorkingSet64, Responding, SessionId, StartTime, TotalProcessorTime, UserName, Path | ConvertTo-Json"
 )
 
 // Get-Process -IncludeUserName | Select-Object -Property *
@@ -93,6 +95,7 @@ type WindowsProcess struct {
 	NPM                int64
 	CPU                float64
 	VirtualMemorySize  int64
+	WorkingSet64       int64
 	Responding         bool
 	SessionId          int
 	StartTime          string
@@ -120,9 +123,30 @@ func (p WindowsProcess) ToOSProcess() *OSProcess {
 		Pid:        p.ID,
 		Command:    p.Path,
 		Executable: p.Name,
+		Uid:        -1,
+		User:       p.UserName,
+		StartTime:  parseWindowsDate(p.StartTime),
+		Rss:        p.WorkingSet64,
+		Vsize:      p.VirtualMemorySize,
 	}
 }
 
+var windowsDateRegex = regexp.MustCompile(`^/Date\((-?\d+)\)/$`)
+
+// parseWindowsDate parses dates serialized by ConvertTo-Json, e.g. /Date(1587025497287)/
+func parseWindowsDate(value string) *time.Time {
+	m := windowsDateRegex.FindStringSubmatch(value)
+	if m == nil {
+		return nil
+	}
+	ms, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		return nil
+	}
+	ts := time.UnixMilli(ms)
+	return &ts
+}
+
 func ParseWindowsProcesses(r io.Reader) ([]WindowsProcess, error) {
 	data, err := io.ReadAll(r)
 	if err != nil {
@@ -167,9 +191,29 @@ func (wpm *WindowsProcessManager) List() ([]*OSProcess, error) {
 }
 
 func (wpm *WindowsProcessManager) Exists(pid int64) (bool, error) {
-	return false, errors.New("not implemented")
+	process, err := wpm.Process(pid)
+	if err != nil {
+		return false, err
+	}
+
+	if process == nil {
+		return false, nil
+	}
+
+	return true, nil
 }
 
 func (wpm *WindowsProcessManager) Process(pid int64) (*OSProcess, error) {
-	return nil, errors.New("not implemented")
+	processes, err := wpm.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range processes {
+		if processes[i].Pid == pid {
+			return processes[i], nil
+		}
+	}
+
+	return nil, nil
 }
@@ -78,6 +78,13 @@ func TestWindows2019ServiceParser(t *testing.T) {
 	}
 	found = findProcess(procs, 3820)
 	assert.EqualValues(t, expected, found)
+
+	process := found.ToOSProcess()
+	assert.Equal(t, int64(-1), process.Uid)
+	assert.Equal(t, "Test\\chris", process.User)
+	assert.Equal(t, int64(58183680), process.Vsize)
+	require.NotNil(t, process.StartTime)
+	assert.Equal(t, int64(1587027060471), process.StartTime.UnixMilli())
 }
 
 func TestWindows2022ServiceParser(t *testing.T) {
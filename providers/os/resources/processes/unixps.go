@@ -2,10 +2,13 @@ package processes
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"io"
 	"regexp"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/kballard/go-shellquote"
 	"github.com/rs/zerolog/log"
@@ -14,12 +17,13 @@ import (
 )
 
 var (
-	LINUX_PS_REGEX = regexp.MustCompile(`^\s*([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ].*)?$`)
-	UNIX_PS_REGEX  = regexp.MustCompile(`^\s*([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ].*)$`)
+	LINUX_PS_REGEX = regexp.MustCompile(`^\s*([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ].*)?$`)
+	UNIX_PS_REGEX  = regexp.MustCompile(`^\s*([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ]+)\s+([^ ].*)$`)
 )
 
 type ProcessEntry struct {
 	Pid     int64
+	PPid    int64
 	CPU     string
 	Mem     string
 	Vsz     string
@@ -28,6 +32,7 @@ type ProcessEntry struct {
 	Stat    string
 	Start   string
 	Time    string
+	Elapsed string
 	Uid     int64
 	Command string
 }
@@ -39,12 +44,63 @@ func (p ProcessEntry) ToOSProcess() *OSProcess {
 		executable = args[0]
 	}
 
+	var startTime *time.Time
+	if elapsed, err := parsePsElapsed(p.Elapsed); err == nil {
+		t := time.Now().Add(-elapsed).Truncate(time.Second)
+		startTime = &t
+	}
+
 	return &OSProcess{
 		Pid:        p.Pid,
+		PPid:       p.PPid,
 		Command:    p.Command,
 		Executable: executable,
 		State:      "",
+		Uid:        p.Uid,
+		StartTime:  startTime,
+		Rss:        parsePsKiloBytes(p.Rss),
+		Vsize:      parsePsKiloBytes(p.Vsz),
+	}
+}
+
+// parsePsElapsed parses the etime column of ps, which has the
+// format [[dd-]hh:]mm:ss
+func parsePsElapsed(value string) (time.Duration, error) {
+	var days int64
+	if d, rest, ok := strings.Cut(value, "-"); ok {
+		var err error
+		days, err = strconv.ParseInt(d, 10, 64)
+		if err != nil {
+			return 0, errors.New("invalid elapsed time: " + value)
+		}
+		value = rest
+	}
+
+	parts := strings.Split(value, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, errors.New("invalid elapsed time: " + value)
+	}
+
+	res := time.Duration(days) * 24 * time.Hour
+	unit := time.Second
+	for i := len(parts) - 1; i >= 0; i-- {
+		x, err := strconv.ParseInt(parts[i], 10, 64)
+		if err != nil {
+			return 0, errors.New("invalid elapsed time: " + value)
+		}
+		res += time.Duration(x) * unit
+		unit *= 60
 	}
+	return res, nil
+}
+
+// ps reports rss and vsz in kilobytes
+func parsePsKiloBytes(value string) int64 {
+	kb, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return kb * 1024
 }
 
 func ParseLinuxPsResult(input io.Reader) ([]*ProcessEntry, error) {
@@ -54,7 +110,7 @@ func ParseLinuxPsResult(input io.Reader) ([]*ProcessEntry, error) {
 		line := scanner.Text()
 
 		m := LINUX_PS_REGEX.FindStringSubmatch(line)
-		if len(m) != 12 {
+		if len(m) != 14 {
 			log.Fatal().Str("psoutput", line).Msg("unexpected result while trying to parse process output")
 		}
 		if m[1] == "PID" {
@@ -67,25 +123,32 @@ func ParseLinuxPsResult(input io.Reader) ([]*ProcessEntry, error) {
 			log.Error().Err(err).Msg("cannot parse ps pid " + m[1])
 			continue
 		}
-		uid, err := strconv.ParseInt(m[10], 10, 64)
+		ppid, err := strconv.ParseInt(m[2], 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("cannot parse ps ppid " + m[2])
+			continue
+		}
+		uid, err := strconv.ParseInt(m[12], 10, 64)
 		if err != nil {
-			log.Error().Err(err).Msg("cannot parse ps uid " + m[10])
+			log.Error().Err(err).Msg("cannot parse ps uid " + m[12])
 			continue
 		}
 
-		// PID %CPU %MEM    VSZ   RSS TT       STAT  STARTED     TIME   UID COMMAND
+		// PID PPID %CPU %MEM    VSZ   RSS TT       STAT  STARTED     TIME     ELAPSED   UID COMMAND
 		p := &ProcessEntry{
 			Pid:     pid,
-			CPU:     m[2],
-			Mem:     m[3],
-			Vsz:     m[4],
-			Rss:     m[5],
-			Tty:     m[6],
-			Stat:    m[7],
-			Start:   m[8],
-			Time:    m[9],
+			PPid:    ppid,
+			CPU:     m[3],
+			Mem:     m[4],
+			Vsz:     m[5],
+			Rss:     m[6],
+			Tty:     m[7],
+			Stat:    m[8],
+			Start:   m[9],
+			Time:    m[10],
+			Elapsed: m[11],
 			Uid:     uid,
-			Command: m[11],
+			Command: m[13],
 		}
 		processes = append(processes, p)
 	}
@@ -99,7 +162,7 @@ func ParseUnixPsResult(input io.Reader) ([]*ProcessEntry, error) {
 	for scanner.Scan() {
 		line := scanner.Text()
 		m := UNIX_PS_REGEX.FindStringSubmatch(line)
-		if len(m) != 11 {
+		if len(m) != 13 {
 			log.Fatal().Str("psoutput", line).Msg("unexpected result while trying to parse process output")
 		}
 		if m[1] == "PID" {
@@ -112,24 +175,31 @@ func ParseUnixPsResult(input io.Reader) ([]*ProcessEntry, error) {
 			log.Error().Err(err).Msg("cannot parse unix pid " + m[1])
 			continue
 		}
-		uid, err := strconv.ParseInt(m[9], 10, 64)
+		ppid, err := strconv.ParseInt(m[2], 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("cannot parse unix ppid " + m[2])
+			continue
+		}
+		uid, err := strconv.ParseInt(m[11], 10, 64)
 		if err != nil {
-			log.Error().Err(err).Msg("cannot parse unix uid " + m[9])
+			log.Error().Err(err).Msg("cannot parse unix uid " + m[11])
 			continue
 		}
 
-		// PID %CPU %MEM    VSZ   RSS TTY       STAT  TIME   UID COMMAND
+		// PID PPID %CPU %MEM    VSZ   RSS TTY       STAT  TIME     ELAPSED   UID COMMAND
 		p := &ProcessEntry{
 			Pid:     pid,
-			CPU:     m[2],
-			Mem:     m[3],
-			Vsz:     m[4],
-			Rss:     m[5],
-			Tty:     m[6],
-			Stat:    m[7],
-			Time:    m[8],
+			PPid:    ppid,
+			CPU:     m[3],
+			Mem:     m[4],
+			Vsz:     m[5],
+			Rss:     m[6],
+			Tty:     m[7],
+			Stat:    m[8],
+			Time:    m[9],
+			Elapsed: m[10],
 			Uid:     uid,
-			Command: m[10],
+			Command: m[12],
 		}
 		processes = append(processes, p)
 	}
@@ -150,7 +220,7 @@ func (upm *UnixProcessManager) List() ([]*OSProcess, error) {
 	var entries []*ProcessEntry
 	// NOTE: improve proc parser instead of supporting multiple ps commands
 	if upm.platform.IsFamily("linux") {
-		c, err := upm.conn.RunCommand("ps axo pid,pcpu,pmem,vsz,rss,tty,stat,stime,time,uid,command")
+		c, err := upm.conn.RunCommand("ps axo pid,ppid,pcpu,pmem,vsz,rss,tty,stat,stime,time,etime,uid,command")
 		if err != nil {
 			return nil, fmt.Errorf("processes> could not run command")
 		}
@@ -162,7 +232,7 @@ func (upm *UnixProcessManager) List() ([]*OSProcess, error) {
 	} else if upm.platform.IsFamily("darwin") {
 		// NOTE: special case on darwin is that the ps axo only shows processes for users with terminals
 		// TODO: the same applies to OpenBSD and may result in missing processes
-		c, err := upm.conn.RunCommand("ps Axo pid,pcpu,pmem,vsz,rss,tty,stat,stime,time,uid,command")
+		c, err := upm.conn.RunCommand("ps Axo pid,ppid,pcpu,pmem,vsz,rss,tty,stat,stime,time,etime,uid,command")
 		if err != nil {
 			return nil, fmt.Errorf("processes> could not run command")
 		}
@@ -174,7 +244,7 @@ func (upm *UnixProcessManager) List() ([]*OSProcess, error) {
 	} else {
 		// TODO: consider using different ps calls for different platforms to determine max information
 		// do not use stime since it is not available on FreeBSD
-		c, err := upm.conn.RunCommand("ps axo pid,pcpu,pmem,vsz,rss,tty,stat,time,uid,command")
+		c, err := upm.conn.RunCommand("ps axo pid,ppid,pcpu,pmem,vsz,rss,tty,stat,time,etime,uid,command")
 		if err != nil {
 			return nil, fmt.Errorf("processes> could not run command")
 		}
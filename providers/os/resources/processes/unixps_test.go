@@ -2,8 +2,10 @@ package processes_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
 	"go.mondoo.com/cnquery/providers/os/connection/mock"
 	"go.mondoo.com/cnquery/providers/os/resources/processes"
@@ -18,7 +20,7 @@ func TestLinuxPSProcessParser(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	c, err := mock.RunCommand("ps axo pid,pcpu,pmem,vsz,rss,tty,stat,stime,time,uid,command")
+	c, err := mock.RunCommand("ps axo pid,ppid,pcpu,pmem,vsz,rss,tty,stat,stime,time,etime,uid,command")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -30,10 +32,13 @@ func TestLinuxPSProcessParser(t *testing.T) {
 
 	assert.Equal(t, "/bin/bash", m[0].Command, "process command detected")
 	assert.Equal(t, int64(1), m[0].Pid, "process pid detected")
+	assert.Equal(t, int64(0), m[0].PPid, "process ppid detected")
+	assert.Equal(t, "12-03:04:05", m[0].Elapsed, "process elapsed time detected")
 	assert.Equal(t, int64(0), m[0].Uid, "process uid detected")
 
-	assert.Equal(t, "ps axo pid,pcpu,pmem,vsz,rss,tty,stat,stime,time,uid,command", m[1].Command, "process command detected")
+	assert.Equal(t, "ps axo pid,ppid,pcpu,pmem,vsz,rss,tty,stat,stime,time,etime,uid,command", m[1].Command, "process command detected")
 	assert.Equal(t, int64(46), m[1].Pid, "process pid detected")
+	assert.Equal(t, int64(1), m[1].PPid, "process ppid detected")
 	assert.Equal(t, int64(0), m[1].Uid, "process uid detected")
 
 	assert.Equal(t, "", m[2].Command, "process command matched against empty COMMAND column")
@@ -50,7 +55,7 @@ func TestOSxPSProcessParser(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	c, err := mock.RunCommand("ps Axo pid,pcpu,pmem,vsz,rss,tty,stat,stime,time,uid,command")
+	c, err := mock.RunCommand("ps Axo pid,ppid,pcpu,pmem,vsz,rss,tty,stat,stime,time,etime,uid,command")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -66,6 +71,8 @@ func TestOSxPSProcessParser(t *testing.T) {
 
 	assert.Equal(t, "/usr/sbin/syslogd", m[1].Command, "process command detected")
 	assert.Equal(t, int64(125), m[1].Pid, "process pid detected")
+	assert.Equal(t, int64(1), m[1].PPid, "process ppid detected")
+	assert.Equal(t, "1-00:00:10", m[1].Elapsed, "process elapsed time detected")
 	assert.Equal(t, int64(0), m[1].Uid, "process uid detected")
 }
 
@@ -78,7 +85,7 @@ func TestUnixPSProcessParser(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	c, err := mock.RunCommand("ps axo pid,pcpu,pmem,vsz,rss,tty,stat,time,uid,command")
+	c, err := mock.RunCommand("ps axo pid,ppid,pcpu,pmem,vsz,rss,tty,stat,time,etime,uid,command")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -94,5 +101,26 @@ func TestUnixPSProcessParser(t *testing.T) {
 
 	assert.Equal(t, "[Timer]", m[20].Command, "process command detected")
 	assert.Equal(t, int64(88), m[20].Pid, "process pid detected")
+	assert.Equal(t, int64(0), m[20].PPid, "process ppid detected")
+	assert.Equal(t, "45:07", m[20].Elapsed, "process elapsed time detected")
 	assert.Equal(t, int64(0), m[20].Uid, "process uid detected")
 }
+
+func TestPSProcessStartTime(t *testing.T) {
+	tests := map[string]time.Duration{
+		"00:05":       5 * time.Second,
+		"45:07":       45*time.Minute + 7*time.Second,
+		"02:15:30":    2*time.Hour + 15*time.Minute + 30*time.Second,
+		"12-03:04:05": 12*24*time.Hour + 3*time.Hour + 4*time.Minute + 5*time.Second,
+	}
+
+	for elapsed, expected := range tests {
+		p := processes.ProcessEntry{Pid: 2, PPid: 1, Elapsed: elapsed}.ToOSProcess()
+		assert.Equal(t, int64(1), p.PPid)
+		require.NotNil(t, p.StartTime, elapsed)
+		assert.WithinDuration(t, time.Now().Add(-expected), *p.StartTime, 2*time.Second, elapsed)
+	}
+
+	p := processes.ProcessEntry{Pid: 2, Elapsed: "-"}.ToOSProcess()
+	assert.Nil(t, p.StartTime)
+}
@@ -3,6 +3,7 @@ package procfs
 import (
 	"bufio"
 	"bytes"
+	"errors"
 	"io"
 	"io/ioutil"
 	"regexp"
@@ -25,8 +26,12 @@ type LinuxProcessStatus struct {
 	PPid       int64  `json:"ppid"`       // process id of the parent process
 	Executable string `json:"executable"` // filename of the executable
 	State      string `json:"state"`
-	Tgid       int64  `json:"tgid"` // thread group ID
-	Ngid       int64  `json:"ngid"` // NUMA group ID (0 if none)
+	Tgid       int64  `json:"tgid"`   // thread group ID
+	Ngid       int64  `json:"ngid"`   // NUMA group ID (0 if none)
+	Uid        int64  `json:"uid"`    // effective user id
+	Gid        int64  `json:"gid"`    // effective group id
+	VmRSS      int64  `json:"vmrss"`  // resident set size in bytes
+	VmSize     int64  `json:"vmsize"` // virtual memory size in bytes
 }
 
 var LINUX_PROCES_STATUS_REGEX = regexp.MustCompile(`^(.*):\s*(.*)$`)
@@ -58,7 +63,7 @@ func ParseProcessStatus(input io.Reader) (*LinuxProcessStatus, error) {
 				continue
 			}
 		case "PPid":
-			if lps.Pid, err = strconv.ParseInt(value, 10, 64); err != nil {
+			if lps.PPid, err = strconv.ParseInt(value, 10, 64); err != nil {
 				log.Warn().Err(err).Str("key", key).Msg("process> could not parse value")
 				continue
 			}
@@ -73,11 +78,27 @@ func ParseProcessStatus(input io.Reader) (*LinuxProcessStatus, error) {
 				continue
 			}
 		case "Uid": // Real, effective, saved set, and  file system UIDs
-
+			if lps.Uid, err = parseEffectiveID(value); err != nil {
+				log.Warn().Err(err).Str("key", key).Msg("process> could not parse value")
+				continue
+			}
 		case "Gid": // Real, effective, saved set, and  file system GIDs
-
-		case "Umask", "TracerPid", "FDSize", "Groups", "VmPeak", "VmSize", "VmLck", "VmPin",
-			"VmHWM", "VmRSS", "RssAnon", "RssFile", "RssShmem", "VmData", "VmStk", "VmExe", "VmLib",
+			if lps.Gid, err = parseEffectiveID(value); err != nil {
+				log.Warn().Err(err).Str("key", key).Msg("process> could not parse value")
+				continue
+			}
+		case "VmRSS":
+			if lps.VmRSS, err = parseKiloBytes(value); err != nil {
+				log.Warn().Err(err).Str("key", key).Msg("process> could not parse value")
+				continue
+			}
+		case "VmSize":
+			if lps.VmSize, err = parseKiloBytes(value); err != nil {
+				log.Warn().Err(err).Str("key", key).Msg("process> could not parse value")
+				continue
+			}
+		case "Umask", "TracerPid", "FDSize", "Groups", "VmPeak", "VmLck", "VmPin",
+			"VmHWM", "RssAnon", "RssFile", "RssShmem", "VmData", "VmStk", "VmExe", "VmLib",
 			"VmPTE", "VmSwap", "Threads", "SigQ", "SigPnd", "ShdPnd", "SigBlk", "SigIgn", "SigCgt",
 			"CapInh", "CapPrm", "CapEff", "CapBnd", "CapAmb", "Seccomp", "Cpus_allowed", "Cpus_allowed_list",
 			"Mems_allowed", "Mems_allowed_list", "voluntary_ctxt_switches", "nonvoluntary_ctxt_switches":
@@ -91,6 +112,29 @@ func ParseProcessStatus(input io.Reader) (*LinuxProcessStatus, error) {
 	return lps, nil
 }
 
+// parseEffectiveID returns the effective id from a Uid or Gid status entry,
+// which lists the real, effective, saved set and file system ids
+func parseEffectiveID(value string) (int64, error) {
+	ids := strings.Fields(value)
+	if len(ids) < 2 {
+		return 0, errors.New("unexpected id format: " + value)
+	}
+	return strconv.ParseInt(ids[1], 10, 64)
+}
+
+// parseKiloBytes parses memory entries like "3360 kB" into bytes
+func parseKiloBytes(value string) (int64, error) {
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return 0, errors.New("unexpected memory format: " + value)
+	}
+	kb, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return kb * 1024, nil
+}
+
 func ParseProcessCmdline(content io.Reader) (string, error) {
 	data, err := ioutil.ReadAll(content)
 	if err != nil {
@@ -105,3 +149,105 @@ func ParseProcessCmdline(content io.Reader) (string, error) {
 
 	return strings.Join(strParts, " "), nil
 }
+
+// ParseProcessEnviron parses /proc/<pid>/environ, which holds the initial
+// environment of the process as null-separated KEY=VALUE entries
+func ParseProcessEnviron(content io.Reader) (map[string]string, error) {
+	data, err := ioutil.ReadAll(content)
+	if err != nil {
+		return nil, err
+	}
+
+	res := map[string]string{}
+	for _, entry := range bytes.Split(data, []byte{0}) {
+		kv := strings.TrimSpace(string(entry))
+		if kv == "" {
+			continue
+		}
+		key, value, _ := strings.Cut(kv, "=")
+		res[key] = value
+	}
+	return res, nil
+}
+
+type LinuxProcessStat struct {
+	Pid  int64 `json:"pid"`
+	PPid int64 `json:"ppid"`
+	// time the process started after system boot, in clock ticks
+	StartTime uint64 `json:"starttime"`
+}
+
+// ParseProcessStat parses /proc/<pid>/stat. The executable name in the
+// second field is wrapped in parentheses and may contain spaces, therefore we
+// split the remaining fields after its closing parenthesis.
+func ParseProcessStat(content io.Reader) (*LinuxProcessStat, error) {
+	data, err := ioutil.ReadAll(content)
+	if err != nil {
+		return nil, err
+	}
+
+	line := strings.TrimSpace(string(data))
+	start := strings.IndexByte(line, '(')
+	end := strings.LastIndexByte(line, ')')
+	if start < 0 || end < start {
+		return nil, errors.New("unexpected process stat format")
+	}
+
+	pid, err := strconv.ParseInt(strings.TrimSpace(line[:start]), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	// fields start with the state (3rd field in proc(5)), so starttime (22nd)
+	// is at index 19
+	fields := strings.Fields(line[end+1:])
+	if len(fields) < 20 {
+		return nil, errors.New("unexpected number of process stat fields")
+	}
+
+	ppid, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime, err := strconv.ParseUint(fields[19], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LinuxProcessStat{
+		Pid:       pid,
+		PPid:      ppid,
+		StartTime: startTime,
+	}, nil
+}
+
+// ParseBootTime reads the boot time in seconds since epoch from /proc/stat
+func ParseBootTime(content io.Reader) (int64, error) {
+	scanner := bufio.NewScanner(content)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[0] == "btime" {
+			return strconv.ParseInt(fields[1], 10, 64)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	return 0, errors.New("could not find btime in /proc/stat")
+}
+
+// ParseProcessCgroup parses /proc/<pid>/cgroup and returns one
+// hierarchy-ID:controller-list:cgroup-path entry per hierarchy
+func ParseProcessCgroup(content io.Reader) ([]string, error) {
+	res := []string{}
+	scanner := bufio.NewScanner(content)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		res = append(res, line)
+	}
+	return res, scanner.Err()
+}
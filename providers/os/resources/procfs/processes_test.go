@@ -1,6 +1,7 @@
 package procfs
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -21,6 +22,76 @@ func TestParseProcessStatus(t *testing.T) {
 
 	assert.NotNil(t, processStatus, "process is not nil")
 	assert.Equal(t, "bash", processStatus.Executable, "detected process name")
+	assert.Equal(t, int64(1), processStatus.Pid)
+	assert.Equal(t, int64(0), processStatus.PPid)
+	assert.Equal(t, int64(0), processStatus.Uid)
+	assert.Equal(t, int64(3360*1024), processStatus.VmRSS)
+	assert.Equal(t, int64(18504*1024), processStatus.VmSize)
+}
+
+func TestParseProcessStat(t *testing.T) {
+	trans, err := mock.New("./testdata/process-pid1.toml", nil)
+	require.NoError(t, err)
+
+	f, err := trans.FileSystem().Open("/proc/1/stat")
+	require.NoError(t, err)
+	defer f.Close()
+
+	stat, err := ParseProcessStat(f)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), stat.Pid)
+	assert.Equal(t, int64(0), stat.PPid)
+	assert.Equal(t, uint64(6947542), stat.StartTime)
+
+	stat, err = ParseProcessStat(strings.NewReader("4711 (tmux: server (1)) S 1 4711 4711 0 -1 4194368 292 0 0 0 4 2 0 0 20 0 1 0 2731 8695808 830"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(4711), stat.Pid)
+	assert.Equal(t, int64(1), stat.PPid)
+	assert.Equal(t, uint64(2731), stat.StartTime)
+}
+
+func TestParseBootTime(t *testing.T) {
+	trans, err := mock.New("./testdata/process-pid1.toml", nil)
+	require.NoError(t, err)
+
+	f, err := trans.FileSystem().Open("/proc/stat")
+	require.NoError(t, err)
+	defer f.Close()
+
+	btime, err := ParseBootTime(f)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1062191376), btime)
+}
+
+func TestParseProcessEnviron(t *testing.T) {
+	trans, err := mock.New("./testdata/process-pid1.toml", nil)
+	require.NoError(t, err)
+
+	f, err := trans.FileSystem().Open("/proc/1/environ")
+	require.NoError(t, err)
+	defer f.Close()
+
+	env, err := ParseProcessEnviron(f)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"PATH":     "/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin",
+		"HOSTNAME": "8f2e3b1c7d4a",
+		"TERM":     "xterm",
+		"HOME":     "/root",
+	}, env)
+}
+
+func TestParseProcessCgroup(t *testing.T) {
+	trans, err := mock.New("./testdata/process-pid1.toml", nil)
+	require.NoError(t, err)
+
+	f, err := trans.FileSystem().Open("/proc/1/cgroup")
+	require.NoError(t, err)
+	defer f.Close()
+
+	cgroup, err := ParseProcessCgroup(f)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0::/system.slice/docker-8f2e3b1c7d4a.scope"}, cgroup)
 }
 
 func TestParseProcessCmdline(t *testing.T) {
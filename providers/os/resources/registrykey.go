@@ -0,0 +1,147 @@
+package resources
+
+import (
+	"errors"
+	"strings"
+
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/providers/os/resources/powershell"
+	"go.mondoo.com/cnquery/providers/os/resources/windows"
+)
+
+func (k *mqlRegistrykey) id() (string, error) {
+	return k.Path.Data, nil
+}
+
+func (k *mqlRegistrykey) exists() (bool, error) {
+	o, err := CreateResource(k.MqlRuntime, "command", map[string]*llx.RawData{
+		"command": llx.StringData(powershell.Encode(windows.GetRegistryKeyItemScript(k.Path.Data))),
+	})
+	if err != nil {
+		return false, err
+	}
+	cmd := o.(*mqlCommand)
+
+	exitcode := cmd.GetExitcode()
+	if exitcode.Error != nil {
+		return false, exitcode.Error
+	}
+	if exitcode.Data != 0 {
+		stderr := cmd.GetStderr()
+		// this would be an expected error and would ensure that we do not throw an error on windows systems
+		// TODO: revisit how this is handled for non-english systems
+		if stderr.Error == nil && (strings.Contains(stderr.Data, "not exist") || strings.Contains(stderr.Data, "ObjectNotFound")) {
+			return false, nil
+		}
+
+		return false, errors.New("could not retrieve registry key")
+	}
+	return true, nil
+}
+
+func (k *mqlRegistrykey) properties() (map[string]interface{}, error) {
+	out, err := runCommand(k.MqlRuntime, powershell.Encode(windows.GetRegistryKeyItemScript(k.Path.Data)))
+	if err != nil {
+		return nil, errors.New("could not retrieve registry key: " + err.Error())
+	}
+
+	entries, err := windows.ParseRegistryKeyItems(strings.NewReader(out))
+	if err != nil {
+		return nil, err
+	}
+
+	res := map[string]interface{}{}
+	for i := range entries {
+		rkey := entries[i]
+		res[rkey.Key] = rkey.GetValue()
+	}
+	return res, nil
+}
+
+func (k *mqlRegistrykey) children() ([]interface{}, error) {
+	out, err := runCommand(k.MqlRuntime, powershell.Encode(windows.GetRegistryKeyChildItemsScript(k.Path.Data)))
+	if err != nil {
+		return nil, errors.New("could not retrieve registry key: " + err.Error())
+	}
+
+	children, err := windows.ParseRegistryKeyChildren(strings.NewReader(out))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(children))
+	for i := range children {
+		res[i] = children[i].Path
+	}
+	return res, nil
+}
+
+func (p *mqlRegistrykeyProperty) id() (string, error) {
+	return p.Path.Data + " - " + p.Name.Data, nil
+}
+
+func initRegistrykeyProperty(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	rawPath, ok := args["path"]
+	if !ok {
+		return args, nil, nil
+	}
+	path, ok := rawPath.Value.(string)
+	if !ok {
+		return args, nil, nil
+	}
+
+	rawName, ok := args["name"]
+	if !ok {
+		return args, nil, nil
+	}
+	name, ok := rawName.Value.(string)
+	if !ok {
+		return args, nil, nil
+	}
+
+	o, err := CreateResource(runtime, "registrykey", map[string]*llx.RawData{
+		"path": llx.StringData(path),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	registryKey := o.(*mqlRegistrykey)
+
+	exists := registryKey.GetExists()
+	if exists.Error != nil {
+		return nil, nil, exists.Error
+	}
+
+	// NOTE: we do not set a value here so that MQL throws an error when a user
+	// tries to gather the data for a non-existing key
+	args["exists"] = llx.BoolFalse
+	if !exists.Data {
+		return args, nil, nil
+	}
+
+	properties := registryKey.GetProperties()
+	if properties.Error != nil {
+		return nil, nil, properties.Error
+	}
+
+	for k := range properties.Data {
+		if strings.EqualFold(k, name) {
+			args["exists"] = llx.BoolTrue
+			args["value"] = llx.StringData(properties.Data[k].(string))
+			break
+		}
+	}
+
+	return args, nil, nil
+}
+
+func (p *mqlRegistrykeyProperty) exists() (bool, error) {
+	// NOTE: will not be called since it is always set in init
+	return false, errors.New("could not determine if the property exists")
+}
+
+func (p *mqlRegistrykeyProperty) value() (string, error) {
+	// NOTE: if we reach here the value has not been set in init, therefore we return an error
+	return "", errors.New("requested property does not exist")
+}
@@ -0,0 +1,37 @@
+package resources_test
+
+import (
+	"testing"
+
+	"go.mondoo.com/cnquery/providers-sdk/v1/testutils"
+)
+
+func TestResource_Registrykey(t *testing.T) {
+	x := testutils.InitTester(testutils.WindowsMock("../../../providers-sdk/v1/testutils"))
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			Code:        "registrykey('HKEY_LOCAL_MACHINE\\SOFTWARE\\Microsoft\\Windows\\CurrentVersion\\Policies\\System').exists",
+			Expectation: true,
+		},
+		{
+			Code:        "registrykey('HKEY_LOCAL_MACHINE\\SOFTWARE\\Microsoft\\Windows\\CurrentVersion\\Policies\\System').properties['ConsentPromptBehaviorAdmin']",
+			Expectation: "5",
+		},
+		{
+			Code:        "registrykey('HKEY_LOCAL_MACHINE\\SOFTWARE\\Mondoo\\Missing').exists",
+			Expectation: false,
+		},
+		{
+			Code:        "registrykey('HKEY_LOCAL_MACHINE\\SOFTWARE\\Microsoft\\Windows\\CurrentVersion\\Policies').children.length",
+			Expectation: int64(5),
+		},
+		{
+			Code:        "registrykey.property(path: 'HKEY_LOCAL_MACHINE\\SOFTWARE\\Microsoft\\Windows\\CurrentVersion\\Policies\\System', name: 'consentpromptbehavioruser').value",
+			Expectation: "3",
+		},
+		{
+			Code:        "registrykey.property(path: 'HKEY_LOCAL_MACHINE\\SOFTWARE\\Microsoft\\Windows\\CurrentVersion\\Policies\\System', name: 'NotThere').exists",
+			Expectation: false,
+		},
+	})
+}
@@ -0,0 +1,156 @@
+package resources
+
+import (
+	"errors"
+	"strings"
+
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/providers/os/resources/packages"
+	"go.mondoo.com/cnquery/providers/os/resources/powershell"
+	"go.mondoo.com/cnquery/providers/os/resources/windows"
+)
+
+func (w *mqlWindows) computerInfo() (map[string]interface{}, error) {
+	out, err := runCommand(w.MqlRuntime, powershell.Encode(windows.PSGetComputerInfo))
+	if err != nil {
+		return nil, err
+	}
+
+	return windows.ParseComputerInfo(strings.NewReader(out))
+}
+
+func (w *mqlWindows) hotfixes() ([]interface{}, error) {
+	out, err := runCommand(w.MqlRuntime, powershell.Encode(packages.WINDOWS_QUERY_HOTFIXES))
+	if err != nil {
+		return nil, err
+	}
+
+	hotfixes, err := packages.ParseWindowsHotfixes(strings.NewReader(out))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(hotfixes))
+	for i := range hotfixes {
+		hf := hotfixes[i]
+
+		installedOn := llx.NilData
+		if ts := hf.InstalledOnTime(); ts != nil {
+			installedOn = llx.TimeData(*ts)
+		}
+
+		o, err := CreateResource(w.MqlRuntime, "windows.hotfix", map[string]*llx.RawData{
+			"hotfixId":    llx.StringData(hf.HotFixId),
+			"caption":     llx.StringData(hf.Caption),
+			"description": llx.StringData(hf.Description),
+			"installedOn": installedOn,
+			"installedBy": llx.StringData(hf.InstalledBy),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = o.(*mqlWindowsHotfix)
+	}
+
+	return res, nil
+}
+
+func (w *mqlWindows) features() ([]interface{}, error) {
+	out, err := runCommand(w.MqlRuntime, powershell.Encode(windows.QUERY_FEATURES))
+	if err != nil {
+		return nil, err
+	}
+
+	features, err := windows.ParseWindowsFeatures(strings.NewReader(out))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(features))
+	for i := range features {
+		feature := features[i]
+		o, err := CreateResource(w.MqlRuntime, "windows.feature", map[string]*llx.RawData{
+			"path":         llx.StringData(feature.Path),
+			"name":         llx.StringData(feature.Name),
+			"displayName":  llx.StringData(feature.DisplayName),
+			"description":  llx.StringData(feature.Description),
+			"installed":    llx.BoolData(feature.Installed),
+			"installState": llx.IntData(feature.InstallState),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = o.(*mqlWindowsFeature)
+	}
+
+	return res, nil
+}
+
+func (h *mqlWindowsHotfix) id() (string, error) {
+	return h.HotfixId.Data, nil
+}
+
+func initWindowsHotfix(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	// we only look up the hotfix, if we have been supplied by its id and nothing else
+	raw, ok := args["hotfixId"]
+	if !ok || len(args) != 1 {
+		return args, nil, nil
+	}
+	id, ok := raw.Value.(string)
+	if !ok {
+		return args, nil, nil
+	}
+
+	o, err := CreateResource(runtime, "windows", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	hotfixes := o.(*mqlWindows).GetHotfixes()
+	if hotfixes.Error != nil {
+		return nil, nil, hotfixes.Error
+	}
+
+	for i := range hotfixes.Data {
+		hf := hotfixes.Data[i].(*mqlWindowsHotfix)
+		if hf.HotfixId.Data == id {
+			return nil, hf, nil
+		}
+	}
+
+	return nil, nil, errors.New("could not find hotfix " + id)
+}
+
+func (f *mqlWindowsFeature) id() (string, error) {
+	return f.Path.Data, nil
+}
+
+func initWindowsFeature(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	// we only look up the feature, if we have been supplied by its name and nothing else
+	raw, ok := args["name"]
+	if !ok || len(args) != 1 {
+		return args, nil, nil
+	}
+	name, ok := raw.Value.(string)
+	if !ok {
+		return args, nil, nil
+	}
+
+	o, err := CreateResource(runtime, "windows", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	features := o.(*mqlWindows).GetFeatures()
+	if features.Error != nil {
+		return nil, nil, features.Error
+	}
+
+	for i := range features.Data {
+		feature := features.Data[i].(*mqlWindowsFeature)
+		if feature.Name.Data == name {
+			return nil, feature, nil
+		}
+	}
+
+	return nil, nil, errors.New("could not find feature " + name)
+}
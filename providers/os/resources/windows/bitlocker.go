@@ -52,7 +52,7 @@ var protectionStatusValues = map[int64]string{
 	2: "Unknown",
 }
 
-const bitlockerStatusScript = `
+const BitlockerStatusScript = `
 $encryptedVolumes = Get-WmiObject -namespace "Root\cimv2\security\MicrosoftVolumeEncryption" -ClassName "Win32_Encryptablevolume" 
 
 $bitlockerStatus = @()
@@ -140,7 +140,7 @@ type statusCode struct {
 }
 
 func GetBitLockerVolumes(p shared.Connection) ([]bitlockerVolumeStatus, error) {
-	c, err := p.RunCommand(powershell.Encode(bitlockerStatusScript))
+	c, err := p.RunCommand(powershell.Encode(BitlockerStatusScript))
 	if err != nil {
 		return nil, err
 	}
@@ -26,7 +26,7 @@ var securityHealthStatusValues = map[int64]string{
 
 // The available security provider are documented in
 // https://docs.microsoft.com/en-us/windows/win32/api/wscapi/ne-wscapi-wsc_security_provider
-const windowsSecurityHealthScript = `
+const SecurityHealthScript = `
 $MethodDefinition = @"
 [DllImport("wscapi.dll",CharSet = CharSet.Unicode, SetLastError = true)]
 private static extern int WscGetSecurityProviderHealth(int inValue, ref int outValue);
@@ -82,7 +82,7 @@ type windowsSecurityHealth struct {
 }
 
 func GetSecurityProviderHealth(p shared.Connection) (*windowsSecurityHealth, error) {
-	c, err := p.RunCommand(powershell.Encode(windowsSecurityHealthScript))
+	c, err := p.RunCommand(powershell.Encode(SecurityHealthScript))
 	if err != nil {
 		return nil, err
 	}
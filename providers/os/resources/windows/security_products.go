@@ -22,7 +22,7 @@ import (
 // https://social.msdn.microsoft.com/Forums/en-US/8da083a9-59bf-4e93-9f1b-209a2c5b9c72/how-to-use-wscapidll-in-c-for-getting-details-about-antivirus-softwares?forum=csharpgeneral
 // https://social.msdn.microsoft.com/Forums/vstudio/en-US/8da083a9-59bf-4e93-9f1b-209a2c5b9c72/how-to-use-wscapidll-in-c-for-getting-details-about-antivirus-softwares?forum=csharpgeneral
 
-const windowsSecurityProducts = `
+const SecurityProductsScript = `
 $securityProducts = New-Object PSObject
 Add-Member -InputObject $securityProducts -MemberType NoteProperty -Name firewall -Value @(Get-CimInstance -Namespace root/SecurityCenter2 -Classname FirewallProduct)
 Add-Member -InputObject $securityProducts -MemberType NoteProperty -Name antiVirus -Value @(Get-CimInstance -Namespace root/SecurityCenter2 -Classname AntiVirusProduct)
@@ -150,7 +150,7 @@ func parseProductState(state uint32) productState {
 }
 
 func GetSecurityProducts(p shared.Connection) ([]securityProduct, error) {
-	c, err := p.RunCommand(powershell.Encode(windowsSecurityProducts))
+	c, err := p.RunCommand(powershell.Encode(SecurityProductsScript))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,65 @@
+package resources
+
+import (
+	"strings"
+
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/util/convert"
+	"go.mondoo.com/cnquery/providers/os/resources/powershell"
+	"go.mondoo.com/cnquery/providers/os/resources/windows"
+)
+
+func (b *mqlWindowsBitlocker) volumes() ([]interface{}, error) {
+	out, err := runCommand(b.MqlRuntime, powershell.Encode(windows.BitlockerStatusScript))
+	if err != nil {
+		return nil, err
+	}
+
+	volumes, err := windows.ParseWindowsBitlockerStatus(strings.NewReader(out))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(volumes))
+	for i := range volumes {
+		v := volumes[i]
+
+		cs, err := convert.JsonToDict(v.ConversionStatus)
+		if err != nil {
+			return nil, err
+		}
+		em, err := convert.JsonToDict(v.EncryptionMethod)
+		if err != nil {
+			return nil, err
+		}
+		ps, err := convert.JsonToDict(v.ProtectionStatus)
+		if err != nil {
+			return nil, err
+		}
+		version, err := convert.JsonToDict(v.Version)
+		if err != nil {
+			return nil, err
+		}
+
+		o, err := CreateResource(b.MqlRuntime, "windows.bitlocker.volume", map[string]*llx.RawData{
+			"deviceID":           llx.StringData(v.DeviceID),
+			"driveLetter":        llx.StringData(v.DriveLetter),
+			"conversionStatus":   llx.DictData(cs),
+			"encryptionMethod":   llx.DictData(em),
+			"lockStatus":         llx.IntData(v.LockStatus),
+			"persistentVolumeID": llx.StringData(v.PersistentVolumeID),
+			"protectionStatus":   llx.DictData(ps),
+			"version":            llx.DictData(version),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = o.(*mqlWindowsBitlockerVolume)
+	}
+
+	return res, nil
+}
+
+func (v *mqlWindowsBitlockerVolume) id() (string, error) {
+	return "bitlocker.volume/" + v.DeviceID.Data, nil
+}
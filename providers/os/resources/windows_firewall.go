@@ -0,0 +1,115 @@
+package resources
+
+import (
+	"strings"
+
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/util/convert"
+	"go.mondoo.com/cnquery/providers/os/resources/powershell"
+	"go.mondoo.com/cnquery/providers/os/resources/windows"
+)
+
+func (w *mqlWindowsFirewall) settings() (map[string]interface{}, error) {
+	out, err := runCommand(w.MqlRuntime, powershell.Encode(windows.FIREWALL_SETTINGS))
+	if err != nil {
+		return nil, err
+	}
+
+	fwSettings, err := windows.ParseWindowsFirewallSettings(strings.NewReader(out))
+	if err != nil {
+		return nil, err
+	}
+	return convert.JsonToDict(fwSettings)
+}
+
+func (w *mqlWindowsFirewall) profiles() ([]interface{}, error) {
+	out, err := runCommand(w.MqlRuntime, powershell.Encode(windows.FIREWALL_PROFILES))
+	if err != nil {
+		return nil, err
+	}
+
+	fwProfiles, err := windows.ParseWindowsFirewallProfiles(strings.NewReader(out))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(fwProfiles))
+	for i := range fwProfiles {
+		p := fwProfiles[i]
+		o, err := CreateResource(w.MqlRuntime, "windows.firewall.profile", map[string]*llx.RawData{
+			"instanceID":                      llx.StringData(p.InstanceID),
+			"name":                            llx.StringData(p.Name),
+			"enabled":                         llx.IntData(p.Enabled),
+			"defaultInboundAction":            llx.IntData(p.DefaultInboundAction),
+			"defaultOutboundAction":           llx.IntData(p.DefaultOutboundAction),
+			"allowInboundRules":               llx.IntData(p.AllowInboundRules),
+			"allowLocalFirewallRules":         llx.IntData(p.AllowLocalFirewallRules),
+			"allowLocalIPsecRules":            llx.IntData(p.AllowLocalIPsecRules),
+			"allowUserApps":                   llx.IntData(p.AllowUserApps),
+			"allowUserPorts":                  llx.IntData(p.AllowUserPorts),
+			"allowUnicastResponseToMulticast": llx.IntData(p.AllowUnicastResponseToMulticast),
+			"notifyOnListen":                  llx.IntData(p.NotifyOnListen),
+			"enableStealthModeForIPsec":       llx.IntData(p.EnableStealthModeForIPsec),
+			"logMaxSizeKilobytes":             llx.IntData(p.LogMaxSizeKilobytes),
+			"logAllowed":                      llx.IntData(p.LogAllowed),
+			"logBlocked":                      llx.IntData(p.LogBlocked),
+			"logIgnored":                      llx.IntData(p.LogIgnored),
+			"logFileName":                     llx.StringData(p.LogFileName),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = o.(*mqlWindowsFirewallProfile)
+	}
+
+	return res, nil
+}
+
+func (w *mqlWindowsFirewall) rules() ([]interface{}, error) {
+	out, err := runCommand(w.MqlRuntime, powershell.Encode(windows.FIREWALL_RULES))
+	if err != nil {
+		return nil, err
+	}
+
+	fwRules, err := windows.ParseWindowsFirewallRules(strings.NewReader(out))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(fwRules))
+	for i := range fwRules {
+		r := fwRules[i]
+		o, err := CreateResource(w.MqlRuntime, "windows.firewall.rule", map[string]*llx.RawData{
+			"instanceID":            llx.StringData(r.InstanceID),
+			"name":                  llx.StringData(r.Name),
+			"displayName":           llx.StringData(r.DisplayName),
+			"description":           llx.StringData(r.Description),
+			"displayGroup":          llx.StringData(r.DisplayGroup),
+			"enabled":               llx.IntData(r.Enabled),
+			"direction":             llx.IntData(r.Direction),
+			"action":                llx.IntData(r.Action),
+			"edgeTraversalPolicy":   llx.IntData(r.EdgeTraversalPolicy),
+			"looseSourceMapping":    llx.BoolData(r.LooseSourceMapping),
+			"localOnlyMapping":      llx.BoolData(r.LocalOnlyMapping),
+			"primaryStatus":         llx.IntData(r.PrimaryStatus),
+			"status":                llx.StringData(r.Status),
+			"enforcementStatus":     llx.StringData(r.EnforcementStatus),
+			"policyStoreSource":     llx.StringData(r.PolicyStoreSource),
+			"policyStoreSourceType": llx.IntData(r.PolicyStoreSourceType),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = o.(*mqlWindowsFirewallRule)
+	}
+
+	return res, nil
+}
+
+func (p *mqlWindowsFirewallProfile) id() (string, error) {
+	return p.InstanceID.Data, nil
+}
+
+func (r *mqlWindowsFirewallRule) id() (string, error) {
+	return r.InstanceID.Data, nil
+}
@@ -0,0 +1,88 @@
+package resources
+
+import (
+	"strings"
+
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/providers-sdk/v1/util/convert"
+	"go.mondoo.com/cnquery/providers/os/resources/powershell"
+	"go.mondoo.com/cnquery/providers/os/resources/windows"
+)
+
+func (s *mqlWindowsSecurity) products() ([]interface{}, error) {
+	out, err := runCommand(s.MqlRuntime, powershell.Encode(windows.SecurityProductsScript))
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := windows.ParseWindowsSecurityProducts(strings.NewReader(out))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(products))
+	for i := range products {
+		p := products[i]
+		o, err := CreateResource(s.MqlRuntime, "windows.security.product", map[string]*llx.RawData{
+			"type":           llx.StringData(p.Type),
+			"guid":           llx.StringData(p.Guid),
+			"name":           llx.StringData(p.Name),
+			"state":          llx.IntData(p.State),
+			"productState":   llx.StringData(p.ProductStatus),
+			"signatureState": llx.StringData(p.SignatureStatus),
+			"timestamp":      llx.TimeData(p.Timestamp),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = o.(*mqlWindowsSecurityProduct)
+	}
+
+	return res, nil
+}
+
+func (p *mqlWindowsSecurityProduct) id() (string, error) {
+	// the same product may register for multiple types, e.g. Windows Defender
+	// is both an antivirus and an antispyware product
+	return "windows.security.product/" + p.Type.Data + "/" + p.Guid.Data, nil
+}
+
+func initWindowsSecurityHealth(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if len(args) > 0 {
+		return args, nil, nil
+	}
+
+	out, err := runCommand(runtime, powershell.Encode(windows.SecurityHealthScript))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	health, err := windows.ParseSecurityProviderHealth(strings.NewReader(out))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fields := map[string]interface{}{
+		"firewall":              health.Firewall,
+		"autoUpdate":            health.AutoUpdate,
+		"antiVirus":             health.AntiVirus,
+		"antiSpyware":           health.AntiSpyware,
+		"internetSettings":      health.InternetSettings,
+		"uac":                   health.Uac,
+		"securityCenterService": health.SecurityCenterService,
+	}
+	for k, v := range fields {
+		dict, err := convert.JsonToDict(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		args[k] = llx.DictData(dict)
+	}
+
+	return args, nil, nil
+}
+
+func (h *mqlWindowsSecurityHealth) id() (string, error) {
+	return "windows.security.health", nil
+}
@@ -0,0 +1,95 @@
+package resources_test
+
+import (
+	"testing"
+
+	"go.mondoo.com/cnquery/providers-sdk/v1/testutils"
+)
+
+func TestResource_Windows(t *testing.T) {
+	x := testutils.InitTester(testutils.WindowsMock("../../../providers-sdk/v1/testutils"))
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			Code:        "windows.computerInfo['WindowsProductName']",
+			Expectation: "Windows Server Datacenter",
+		},
+		{
+			Code:        "windows.hotfixes.length",
+			Expectation: int64(6),
+		},
+		{
+			Code:        "windows.hotfixes.where(description == 'Security Update').map(hotfixId)",
+			Expectation: []interface{}{"KB4470788", "KB4487038"},
+		},
+		{
+			Code:        "windows.hotfix('KB4486553').installedOn.unix",
+			Expectation: int64(1599609600),
+		},
+		{
+			Code:        "windows.features.length",
+			Expectation: int64(5),
+		},
+		{
+			Code:        "windows.feature('PowerShell-V2').displayName",
+			Expectation: "Windows PowerShell 2.0 Engine",
+		},
+	})
+}
+
+func TestResource_WindowsFirewall(t *testing.T) {
+	x := testutils.InitTester(testutils.WindowsMock("../../../providers-sdk/v1/testutils"))
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			Code:        "windows.firewall.settings['Name']",
+			Expectation: "Global IPsec SettingData",
+		},
+		{
+			Code:        "windows.firewall.profiles.map(name)",
+			Expectation: []interface{}{"Private", "Public"},
+		},
+		{
+			Code:        "windows.firewall.profiles.all(enabled == 1)",
+			Expectation: true,
+		},
+		{
+			Code:        "windows.firewall.rules.where(name == 'sshd')[0].displayName",
+			Expectation: "OpenSSH Server (sshd)",
+		},
+	})
+}
+
+func TestResource_WindowsBitlocker(t *testing.T) {
+	x := testutils.InitTester(testutils.WindowsMock("../../../providers-sdk/v1/testutils"))
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			Code:        "windows.bitlocker.volumes.map(driveLetter)",
+			Expectation: []interface{}{"C:", "E:"},
+		},
+		{
+			Code:        "windows.bitlocker.volumes.where(driveLetter == 'C:')[0].protectionStatus['text']",
+			Expectation: "Protected",
+		},
+	})
+}
+
+func TestResource_WindowsSecurity(t *testing.T) {
+	x := testutils.InitTester(testutils.WindowsMock("../../../providers-sdk/v1/testutils"))
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			Code:        "windows.security.products.where(type == 'antivirus').map(name)",
+			Expectation: []interface{}{"Sophos Anti-Virus", "Windows Defender", "Sophos Intercept X"},
+		},
+		{
+			Code:        "windows.security.products.where(type == 'antispyware').map(name)",
+			Expectation: []interface{}{"ESET Security", "Windows Defender"},
+		},
+		{
+			Code:        "windows.security.health.firewall['text']",
+			Expectation: "POOR",
+		},
+		{
+			Code:        "windows.security.health.antiVirus['text']",
+			Expectation: "GOOD",
+		},
+	})
+}
@@ -0,0 +1,153 @@
+package providers
+
+import (
+	"errors"
+
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/utils/multierr"
+)
+
+// ProviderCrashedError is returned for requests that were running while
+// the plugin of their provider crashed. The provider is restarted and can
+// be used again for new requests.
+type ProviderCrashedError struct {
+	Provider string
+}
+
+func (e ProviderCrashedError) Error() string {
+	return "provider " + e.Provider + " crashed"
+}
+
+// connect establishes the connection to the provider. The request is kept,
+// so that the connection can be re-established after a crash of the plugin.
+func (c *ConnectedProvider) connect(req *plugin.ConnectReq, callbacks plugin.ProviderCallback) error {
+	generation := c.Instance.currentGeneration()
+	res, err := c.Instance.impl().Connect(req, callbacks)
+	if err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.Connection = res
+	c.connectReq = req
+	c.callbacks = callbacks
+	c.generation = generation
+	c.stored = nil
+	return nil
+}
+
+// remember keeps resources that were stored in the provider, so that they
+// can be stored again after a crash of the plugin
+func (c *ConnectedProvider) remember(resources []*plugin.ResourceData) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.stored == nil {
+		c.stored = map[string]*plugin.ResourceData{}
+	}
+	for i := range resources {
+		resource := resources[i]
+		c.stored[resource.Name+"\x00"+resource.Id] = resource
+	}
+}
+
+// rememberCreated keeps a resource that was created in the provider with
+// the given arguments, so that it can be created again after a crash of
+// the plugin. Resources that were stored with all their data are kept.
+func (c *ConnectedProvider) rememberCreated(resource *plugin.ResourceData) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.stored == nil {
+		c.stored = map[string]*plugin.ResourceData{}
+	}
+	key := resource.Name + "\x00" + resource.Id
+	if _, ok := c.stored[key]; !ok {
+		c.stored[key] = resource
+	}
+}
+
+// reconnect re-establishes the connection to the provider, if its plugin
+// was restarted since the connection was established. All resources that
+// were created or stored in the old plugin, e.g. references to resources of
+// other providers, are stored again.
+func (r *Runtime) reconnect(provider *ConnectedProvider) error {
+	provider.lock.Lock()
+	defer provider.lock.Unlock()
+
+	generation := provider.Instance.currentGeneration()
+	if provider.connectReq == nil || provider.generation == generation {
+		return nil
+	}
+
+	name := provider.Instance.Name
+	log.Debug().Str("provider", name).Msg("re-establishing connection to restarted provider")
+
+	conn, err := provider.Instance.impl().Connect(provider.connectReq, provider.callbacks)
+	if err != nil {
+		return multierr.Wrap(err, "failed to reconnect to provider "+name)
+	}
+
+	if len(provider.stored) != 0 {
+		resources := make([]*plugin.ResourceData, 0, len(provider.stored))
+		for _, resource := range provider.stored {
+			resources = append(resources, resource)
+		}
+		_, err = provider.Instance.impl().StoreData(&plugin.StoreReq{
+			Connection: conn.Id,
+			Resources:  resources,
+		})
+		if err != nil {
+			return multierr.Wrap(err, "failed to restore resources in provider "+name)
+		}
+	}
+
+	if provider == r.Provider && provider.Connection != nil && conn.Id != provider.Connection.Id {
+		asset := provider.connectReq.Asset
+		if len(asset.Connections) != 0 {
+			r.Recording.EnsureAsset(conn.Asset, name, conn.Id, asset.Connections[0])
+		}
+	}
+
+	provider.Connection = conn
+	provider.generation = generation
+	return nil
+}
+
+// call runs a request against the provider's plugin with the current
+// connection. If the plugin crashed while it was running, the plugin is
+// restarted, the connection is re-established and a ProviderCrashedError
+// is returned for the request.
+func (r *Runtime) call(provider *ConnectedProvider, f func(impl plugin.ProviderPlugin, conn uint32) error) error {
+	if err := r.reconnect(provider); err != nil {
+		return err
+	}
+
+	provider.lock.Lock()
+	generation := provider.generation
+	conn := provider.Connection.Id
+	canReconnect := provider.connectReq != nil
+	provider.lock.Unlock()
+
+	err := f(provider.Instance.impl(), conn)
+	if err == nil || !canReconnect || !provider.Instance.crashed(generation) {
+		return err
+	}
+
+	name := provider.Instance.Name
+	log.Warn().Err(err).Str("provider", name).Msg("provider crashed, restarting it")
+	if err := r.coordinator.restart(provider.Instance, generation); err != nil {
+		return multierr.Wrap(err, "failed to restart crashed provider "+name)
+	}
+	if err := r.reconnect(provider); err != nil {
+		return err
+	}
+	return ProviderCrashedError{Provider: name}
+}
+
+func isProviderCrashed(err error) bool {
+	var crashed ProviderCrashedError
+	return errors.As(err, &crashed)
+}
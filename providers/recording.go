@@ -5,6 +5,7 @@ import (
 	"errors"
 	"os"
 	"sort"
+	"sync"
 
 	"github.com/rs/zerolog/log"
 	"go.mondoo.com/cnquery/llx"
@@ -27,6 +28,12 @@ type recording struct {
 	// assets is used for fast connection to asset lookup
 	assets          map[uint32]*assetRecording `json:"-"`
 	prettyPrintJSON bool                       `json:"-"`
+	// rules that are applied to all fields before the recording is saved
+	redactRules []RedactRule `json:"-"`
+	// drop unused resources and merge duplicate assets when saving
+	compact bool `json:"-"`
+	// providers may push data asynchronously, so access is synchronized
+	lock sync.Mutex `json:"-"`
 }
 
 type assetRecording struct {
@@ -64,6 +71,8 @@ type resourceRecording struct {
 	Resource string
 	ID       string
 	Fields   map[string]*llx.RawData
+	// fields whose values were scrubbed before saving
+	Redacted []string `json:",omitempty"`
 }
 
 type nullRecording struct{}
@@ -97,6 +106,9 @@ func (n *readOnlyRecording) Save() error {
 func (n *readOnlyRecording) EnsureAsset(asset *inventory.Asset, provider string, connectionID uint32, conf *inventory.Config) {
 	// For read-only recordings we are still loading from file, so that means
 	// we are severly lacking connection IDs.
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
 	found, _ := n.findAssetConnID(asset, conf)
 	if found != -1 {
 		n.assets[connectionID] = &n.Assets[found]
@@ -109,6 +121,10 @@ func (n *readOnlyRecording) AddData(connectionID uint32, resource string, id str
 type RecordingOptions struct {
 	DoRecord        bool
 	PrettyPrintJSON bool
+	// RedactRules scrub field data before the recording is saved
+	RedactRules []RedactRule
+	// Compact drops unused resources and merges duplicate assets on save
+	Compact bool
 }
 
 // NewRecording loads and creates a new recording based on user settings.
@@ -136,6 +152,8 @@ func NewRecording(path string, opts RecordingOptions) (Recording, error) {
 
 		if opts.DoRecord {
 			res.prettyPrintJSON = opts.PrettyPrintJSON
+			res.redactRules = opts.RedactRules
+			res.compact = opts.Compact
 			return res, nil
 		}
 		return &readOnlyRecording{res}, nil
@@ -145,6 +163,8 @@ func NewRecording(path string, opts RecordingOptions) (Recording, error) {
 			res := &recording{
 				Path:            path,
 				prettyPrintJSON: opts.PrettyPrintJSON,
+				redactRules:     opts.RedactRules,
+				compact:         opts.Compact,
 			}
 			res.refreshCache() // only for initialization
 			return res, nil
@@ -180,15 +200,43 @@ func LoadRecordingFile(path string) (*recording, error) {
 	return pres, err
 }
 
+// SaveRecordingFile loads a recording and stores it in a new location,
+// applying the redaction and compaction options on the way.
+func SaveRecordingFile(src string, dst string, opts RecordingOptions) error {
+	res, err := LoadRecordingFile(src)
+	if err != nil {
+		return multierr.Wrap(err, "failed to load recording")
+	}
+
+	res.Path = dst
+	res.prettyPrintJSON = opts.PrettyPrintJSON
+	res.redactRules = opts.RedactRules
+	res.compact = opts.Compact
+	return res.Save()
+}
+
 func (r *recording) Save() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
 	r.finalize()
 
+	// compaction and redaction only apply to the stored file, the data
+	// in this recording stays untouched
+	out := &recording{Assets: r.Assets}
+	if r.compact {
+		out.Assets = compactAssets(out.Assets)
+	}
+	if len(r.redactRules) != 0 {
+		out.Assets = redactAssets(out.Assets, r.redactRules)
+	}
+
 	var raw []byte
 	var err error
 	if r.prettyPrintJSON {
-		raw, err = json.MarshalIndent(r, "", "  ")
+		raw, err = json.MarshalIndent(out, "", "  ")
 	} else {
-		raw, err = json.Marshal(r)
+		raw, err = json.Marshal(out)
 	}
 	if err != nil {
 		return multierr.Wrap(err, "failed to marshal json for recording")
@@ -387,6 +435,9 @@ func (r *recording) findAssetConnID(asset *inventory.Asset, conf *inventory.Conf
 }
 
 func (r *recording) EnsureAsset(asset *inventory.Asset, provider string, connectionID uint32, conf *inventory.Config) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
 	found, _ := r.findAssetConnID(asset, conf)
 
 	if found == -1 {
@@ -430,6 +481,9 @@ func (r *recording) EnsureAsset(asset *inventory.Asset, provider string, connect
 }
 
 func (r *recording) AddData(connectionID uint32, resource string, id string, field string, data *llx.RawData) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
 	asset, ok := r.assets[connectionID]
 	if !ok {
 		log.Error().Uint32("connectionID", connectionID).Msg("cannot store recording, cannot find connection ID")
@@ -452,6 +506,9 @@ func (r *recording) AddData(connectionID uint32, resource string, id string, fie
 }
 
 func (r *recording) GetData(connectionID uint32, resource string, id string, field string) (*llx.RawData, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
 	asset, ok := r.assets[connectionID]
 	if !ok {
 		return nil, false
@@ -471,6 +528,9 @@ func (r *recording) GetData(connectionID uint32, resource string, id string, fie
 }
 
 func (r *recording) GetResource(connectionID uint32, resource string, id string) (map[string]*llx.RawData, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
 	asset, ok := r.assets[connectionID]
 	if !ok {
 		return nil, false
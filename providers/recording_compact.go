@@ -0,0 +1,170 @@
+package providers
+
+import (
+	"sort"
+
+	"go.mondoo.com/cnquery/llx"
+)
+
+// compactAssets returns a copy of the assets, where duplicate assets are
+// merged and resources that were neither created nor referenced are dropped.
+// Assets are duplicates if they share their ID or any platform ID.
+func compactAssets(assets []assetRecording) []assetRecording {
+	var res []assetRecording
+	for i := range assets {
+		cur := assets[i]
+		idx := -1
+		for j := range res {
+			if isSameAsset(&res[j].Asset, &cur.Asset) {
+				idx = j
+				break
+			}
+		}
+
+		if idx == -1 {
+			cur.Asset.PlatformIDs = append([]string{}, cur.Asset.PlatformIDs...)
+			res = append(res, assetRecording{
+				Asset:       cur.Asset,
+				Connections: append([]connectionRecording{}, cur.Connections...),
+				Resources:   append([]resourceRecording{}, cur.Resources...),
+			})
+			continue
+		}
+		mergeAssets(&res[idx], &cur)
+	}
+
+	for i := range res {
+		res[i].Resources = usedResources(res[i].Resources)
+	}
+	return res
+}
+
+func isSameAsset(a *assetInfo, b *assetInfo) bool {
+	if a.ID != "" && a.ID == b.ID {
+		return true
+	}
+	for _, x := range a.PlatformIDs {
+		for _, y := range b.PlatformIDs {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// mergeAssets adds all connections, resources and fields of src to dst,
+// which aren't in dst yet
+func mergeAssets(dst *assetRecording, src *assetRecording) {
+	for _, id := range src.Asset.PlatformIDs {
+		if !containsString(dst.Asset.PlatformIDs, id) {
+			dst.Asset.PlatformIDs = append(dst.Asset.PlatformIDs, id)
+		}
+	}
+
+	for _, conn := range src.Connections {
+		found := false
+		for i := range dst.Connections {
+			if dst.Connections[i].Url == conn.Url {
+				found = true
+				break
+			}
+		}
+		if !found {
+			dst.Connections = append(dst.Connections, conn)
+		}
+	}
+
+	for _, resource := range src.Resources {
+		idx := -1
+		for i := range dst.Resources {
+			if dst.Resources[i].Resource == resource.Resource && dst.Resources[i].ID == resource.ID {
+				idx = i
+				break
+			}
+		}
+		if idx == -1 {
+			dst.Resources = append(dst.Resources, resource)
+			continue
+		}
+
+		existing := &dst.Resources[idx]
+		fields := make(map[string]*llx.RawData, len(existing.Fields)+len(resource.Fields))
+		for k, v := range resource.Fields {
+			fields[k] = v
+		}
+		for k, v := range existing.Fields {
+			fields[k] = v
+		}
+		existing.Fields = fields
+
+		redacted := append([]string{}, existing.Redacted...)
+		for _, field := range resource.Redacted {
+			if !containsString(redacted, field) {
+				redacted = append(redacted, field)
+			}
+		}
+		sort.Strings(redacted)
+		existing.Redacted = redacted
+	}
+
+	sort.Slice(dst.Resources, func(i, j int) bool {
+		a := dst.Resources[i]
+		b := dst.Resources[j]
+		if a.Resource == b.Resource {
+			return a.ID < b.ID
+		}
+		return a.Resource < b.Resource
+	})
+}
+
+// usedResources drops all resources that have no fields, no init entry and
+// are not referenced by any field of another resource. Resources that were
+// created are recorded with an empty set of fields, their entry is needed
+// to replay e.g. file("/x") or user(name: "x").
+func usedResources(resources []resourceRecording) []resourceRecording {
+	referenced := map[string]struct{}{}
+	for i := range resources {
+		for _, field := range resources[i].Fields {
+			if field != nil {
+				collectResourceRefs(field.Value, referenced)
+			}
+		}
+	}
+
+	res := make([]resourceRecording, 0, len(resources))
+	for i := range resources {
+		cur := resources[i]
+		if cur.Fields == nil {
+			if _, ok := referenced[cur.Resource+"\x00"+cur.ID]; !ok {
+				continue
+			}
+		}
+		res = append(res, cur)
+	}
+	return res
+}
+
+func collectResourceRefs(v interface{}, res map[string]struct{}) {
+	switch x := v.(type) {
+	case llx.Resource:
+		res[x.MqlName()+"\x00"+x.MqlID()] = struct{}{}
+	case []interface{}:
+		for i := range x {
+			collectResourceRefs(x[i], res)
+		}
+	case map[string]interface{}:
+		for _, val := range x {
+			collectResourceRefs(val, res)
+		}
+	}
+}
+
+func containsString(list []string, s string) bool {
+	for i := range list {
+		if list[i] == s {
+			return true
+		}
+	}
+	return false
+}
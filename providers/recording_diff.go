@@ -0,0 +1,252 @@
+package providers
+
+import (
+	"sort"
+
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/utils/multierr"
+)
+
+type DiffStatus string
+
+const (
+	DiffAdded   DiffStatus = "added"
+	DiffRemoved DiffStatus = "removed"
+	DiffChanged DiffStatus = "changed"
+)
+
+// RecordingDiff contains all differences between two recordings.
+// Anything that is equal in both recordings is left out.
+type RecordingDiff struct {
+	Assets []AssetDiff `json:"assets"`
+}
+
+type AssetDiff struct {
+	ID          string         `json:"id"`
+	Name        string         `json:"name,omitempty"`
+	PlatformIDs []string       `json:"platformIDs,omitempty"`
+	Status      DiffStatus     `json:"status"`
+	Resources   []ResourceDiff `json:"resources,omitempty"`
+}
+
+type ResourceDiff struct {
+	Resource string      `json:"resource"`
+	ID       string      `json:"id"`
+	Status   DiffStatus  `json:"status"`
+	Fields   []FieldDiff `json:"fields,omitempty"`
+}
+
+type FieldDiff struct {
+	Field  string     `json:"field"`
+	Status DiffStatus `json:"status"`
+	Old    *DiffValue `json:"old,omitempty"`
+	New    *DiffValue `json:"new,omitempty"`
+}
+
+// DiffValue is the data of a field in a diff. Unlike llx.RawData it keeps
+// its error and type when it is marshaled to JSON.
+type DiffValue struct {
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+	Error string      `json:"error,omitempty"`
+
+	data *llx.RawData
+}
+
+func newDiffValue(data *llx.RawData) *DiffValue {
+	if data == nil {
+		return nil
+	}
+	res := &DiffValue{
+		Type:  data.Type.Label(),
+		Value: data.Value,
+		data:  data,
+	}
+	if data.Error != nil {
+		res.Error = data.Error.Error()
+	}
+	return res
+}
+
+func (v *DiffValue) String() string {
+	if v.Error != "" {
+		return "error: " + v.Error
+	}
+	return v.data.String()
+}
+
+// IsEmpty returns true if there are no differences
+func (d *RecordingDiff) IsEmpty() bool {
+	return len(d.Assets) == 0
+}
+
+// DiffRecordingFiles loads two recordings and compares them
+func DiffRecordingFiles(oldPath string, newPath string) (*RecordingDiff, error) {
+	oldRec, err := LoadRecordingFile(oldPath)
+	if err != nil {
+		return nil, multierr.Wrap(err, "failed to load recording '"+oldPath+"'")
+	}
+	newRec, err := LoadRecordingFile(newPath)
+	if err != nil {
+		return nil, multierr.Wrap(err, "failed to load recording '"+newPath+"'")
+	}
+	return diffRecordings(oldRec, newRec), nil
+}
+
+// diffRecordings compares two recordings. Assets are matched by their
+// platform IDs (or their ID if they have none), resources by their
+// name and ID.
+func diffRecordings(oldRec *recording, newRec *recording) *RecordingDiff {
+	res := &RecordingDiff{}
+	matched := make(map[*assetRecording]struct{}, len(newRec.Assets))
+
+	for i := range oldRec.Assets {
+		oldAsset := &oldRec.Assets[i]
+		newAsset := newRec.findAsset(oldAsset)
+		if newAsset == nil {
+			res.Assets = append(res.Assets, assetDiff(oldAsset, DiffRemoved, resourcesDiff(oldAsset, nil)))
+			continue
+		}
+		matched[newAsset] = struct{}{}
+
+		if resources := resourcesDiff(oldAsset, newAsset); len(resources) != 0 {
+			res.Assets = append(res.Assets, assetDiff(newAsset, DiffChanged, resources))
+		}
+	}
+
+	for i := range newRec.Assets {
+		newAsset := &newRec.Assets[i]
+		if _, ok := matched[newAsset]; ok {
+			continue
+		}
+		res.Assets = append(res.Assets, assetDiff(newAsset, DiffAdded, resourcesDiff(nil, newAsset)))
+	}
+
+	return res
+}
+
+func assetDiff(asset *assetRecording, status DiffStatus, resources []ResourceDiff) AssetDiff {
+	return AssetDiff{
+		ID:          asset.Asset.ID,
+		Name:        asset.Asset.Name,
+		PlatformIDs: asset.Asset.PlatformIDs,
+		Status:      status,
+		Resources:   resources,
+	}
+}
+
+// findAsset returns the asset in this recording that matches the given asset
+func (r *recording) findAsset(asset *assetRecording) *assetRecording {
+	for i := range r.Assets {
+		cur := &r.Assets[i]
+		if len(asset.Asset.PlatformIDs) == 0 && len(cur.Asset.PlatformIDs) == 0 {
+			if asset.Asset.ID == cur.Asset.ID {
+				return cur
+			}
+			continue
+		}
+
+		for _, id := range asset.Asset.PlatformIDs {
+			for _, curID := range cur.Asset.PlatformIDs {
+				if id == curID {
+					return cur
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// resourcesDiff compares all resources of two assets. Either asset may be
+// nil, in which case all resources of the other one are added or removed.
+func resourcesDiff(oldAsset *assetRecording, newAsset *assetRecording) []ResourceDiff {
+	oldResources := assetResources(oldAsset)
+	newResources := assetResources(newAsset)
+
+	keys := make([]string, 0, len(oldResources)+len(newResources))
+	for k := range oldResources {
+		keys = append(keys, k)
+	}
+	for k := range newResources {
+		if _, ok := oldResources[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var res []ResourceDiff
+	for _, k := range keys {
+		oldResource, hasOld := oldResources[k]
+		newResource, hasNew := newResources[k]
+
+		switch {
+		case !hasNew:
+			res = append(res, ResourceDiff{
+				Resource: oldResource.Resource,
+				ID:       oldResource.ID,
+				Status:   DiffRemoved,
+				Fields:   fieldsDiff(oldResource.Fields, nil),
+			})
+		case !hasOld:
+			res = append(res, ResourceDiff{
+				Resource: newResource.Resource,
+				ID:       newResource.ID,
+				Status:   DiffAdded,
+				Fields:   fieldsDiff(nil, newResource.Fields),
+			})
+		default:
+			fields := fieldsDiff(oldResource.Fields, newResource.Fields)
+			if len(fields) == 0 {
+				continue
+			}
+			res = append(res, ResourceDiff{
+				Resource: newResource.Resource,
+				ID:       newResource.ID,
+				Status:   DiffChanged,
+				Fields:   fields,
+			})
+		}
+	}
+	return res
+}
+
+func assetResources(asset *assetRecording) map[string]*resourceRecording {
+	if asset == nil {
+		return nil
+	}
+	res := make(map[string]*resourceRecording, len(asset.Resources))
+	for i := range asset.Resources {
+		cur := &asset.Resources[i]
+		res[cur.Resource+"\x00"+cur.ID] = cur
+	}
+	return res
+}
+
+func fieldsDiff(oldFields map[string]*llx.RawData, newFields map[string]*llx.RawData) []FieldDiff {
+	keys := make([]string, 0, len(oldFields)+len(newFields))
+	for k := range oldFields {
+		keys = append(keys, k)
+	}
+	for k := range newFields {
+		if _, ok := oldFields[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var res []FieldDiff
+	for _, k := range keys {
+		oldField, hasOld := oldFields[k]
+		newField, hasNew := newFields[k]
+
+		switch {
+		case !hasNew:
+			res = append(res, FieldDiff{Field: k, Status: DiffRemoved, Old: newDiffValue(oldField)})
+		case !hasOld:
+			res = append(res, FieldDiff{Field: k, Status: DiffAdded, New: newDiffValue(newField)})
+		case !oldField.Equal(newField):
+			res = append(res, FieldDiff{Field: k, Status: DiffChanged, Old: newDiffValue(oldField), New: newDiffValue(newField)})
+		}
+	}
+	return res
+}
@@ -0,0 +1,121 @@
+package providers
+
+import (
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/types"
+)
+
+func saveTestRecording(t *testing.T, path string, assets ...assetRecording) {
+	rec := &recording{Path: path, Assets: assets}
+	rec.refreshCache()
+	require.NoError(t, rec.Save())
+}
+
+func TestDiffRecordings(t *testing.T) {
+	dir := t.TempDir()
+	oldPath := filepath.Join(dir, "old.json")
+	newPath := filepath.Join(dir, "new.json")
+
+	saveTestRecording(t, oldPath,
+		assetRecording{
+			Asset: assetInfo{ID: "1", Name: "host", PlatformIDs: []string{"//platformid/host"}},
+			Resources: []resourceRecording{
+				{Resource: "os", ID: "", Fields: map[string]*llx.RawData{
+					"hostname": llx.StringData("host"),
+					"uptime":   llx.IntData(1),
+					"removed":  llx.BoolTrue,
+				}},
+				{Resource: "user", ID: "bob", Fields: map[string]*llx.RawData{
+					"name": llx.StringData("bob"),
+				}},
+			},
+		},
+		assetRecording{
+			Asset: assetInfo{ID: "2", Name: "gone", PlatformIDs: []string{"//platformid/gone"}},
+		},
+	)
+	saveTestRecording(t, newPath,
+		assetRecording{
+			// the asset ID is different, but it shares a platform ID
+			Asset: assetInfo{ID: "3", Name: "host", PlatformIDs: []string{"//platformid/other", "//platformid/host"}},
+			Resources: []resourceRecording{
+				{Resource: "os", ID: "", Fields: map[string]*llx.RawData{
+					"hostname": llx.StringData("host"),
+					"uptime":   llx.IntData(2),
+					"added":    llx.StringData("yes"),
+				}},
+				{Resource: "user", ID: "alice", Fields: map[string]*llx.RawData{
+					"name": llx.StringData("alice"),
+				}},
+			},
+		},
+		assetRecording{
+			Asset: assetInfo{ID: "4", Name: "new", PlatformIDs: []string{"//platformid/new"}},
+		},
+	)
+
+	diff, err := DiffRecordingFiles(oldPath, newPath)
+	require.NoError(t, err)
+	require.Len(t, diff.Assets, 3)
+
+	host := diff.Assets[0]
+	assert.Equal(t, "host", host.Name)
+	assert.Equal(t, DiffChanged, host.Status)
+	require.Len(t, host.Resources, 3)
+
+	osDiff := host.Resources[0]
+	assert.Equal(t, "os", osDiff.Resource)
+	assert.Equal(t, DiffChanged, osDiff.Status)
+	assert.Equal(t, []FieldDiff{
+		{Field: "added", Status: DiffAdded, New: newDiffValue(llx.StringData("yes"))},
+		{Field: "removed", Status: DiffRemoved, Old: newDiffValue(llx.BoolTrue)},
+		{Field: "uptime", Status: DiffChanged, Old: newDiffValue(llx.IntData(1)), New: newDiffValue(llx.IntData(2))},
+	}, osDiff.Fields)
+
+	assert.Equal(t, "user", host.Resources[1].Resource)
+	assert.Equal(t, "alice", host.Resources[1].ID)
+	assert.Equal(t, DiffAdded, host.Resources[1].Status)
+	assert.Equal(t, "bob", host.Resources[2].ID)
+	assert.Equal(t, DiffRemoved, host.Resources[2].Status)
+
+	assert.Equal(t, "gone", diff.Assets[1].Name)
+	assert.Equal(t, DiffRemoved, diff.Assets[1].Status)
+	assert.Equal(t, "new", diff.Assets[2].Name)
+	assert.Equal(t, DiffAdded, diff.Assets[2].Status)
+
+	t.Run("no differences", func(t *testing.T) {
+		diff, err := DiffRecordingFiles(newPath, newPath)
+		require.NoError(t, err)
+		assert.True(t, diff.IsEmpty())
+	})
+}
+
+func TestFieldDiff_JSON(t *testing.T) {
+	fields := fieldsDiff(
+		map[string]*llx.RawData{
+			"uptime": llx.IntData(1),
+			"broken": llx.StringData("ok"),
+		},
+		map[string]*llx.RawData{
+			"uptime": llx.IntData(0),
+			"broken": {Type: types.String, Error: errors.New("permission denied")},
+		},
+	)
+
+	raw, err := json.Marshal(fields)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"field":"broken","status":"changed","old":{"type":"string","value":"ok"},"new":{"type":"string","value":null,"error":"permission denied"}},
+		{"field":"uptime","status":"changed","old":{"type":"int","value":1},"new":{"type":"int","value":0}}
+	]`, string(raw))
+
+	assert.Equal(t, "error: permission denied", fields[0].New.String())
+	assert.Equal(t, "1", fields[1].Old.String())
+}
@@ -0,0 +1,192 @@
+package providers
+
+import (
+	"errors"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"go.mondoo.com/cnquery/llx"
+)
+
+// RedactedValue replaces all data that is scrubbed from a recording
+const RedactedValue = "[REDACTED]"
+
+// RedactRule scrubs data from recorded resource fields. Resource and Field
+// are glob patterns (see path.Match), e.g. "file" and "content" or "*" and
+// "*". If Regex is set, only the matching parts of string values are
+// replaced, otherwise the entire field value is redacted.
+type RedactRule struct {
+	Resource string
+	Field    string
+	Regex    *regexp.Regexp
+}
+
+// DefaultRedactRules cover the fields that typically contain secrets
+var DefaultRedactRules = []RedactRule{
+	{Resource: "file", Field: "content"},
+	{Resource: "command", Field: "stdout"},
+	{Resource: "command", Field: "stderr"},
+	{Resource: "powershell", Field: "stdout"},
+	{Resource: "powershell", Field: "stderr"},
+	{Resource: "os", Field: "env"},
+	{Resource: "process", Field: "env"},
+	{Resource: "*", Field: "*", Regex: regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`)},
+	{Resource: "*", Field: "*", Regex: regexp.MustCompile(`(?i)(?:password|passwd|secret|token|api[_-]?key)\s*[=:]\s*\S+`)},
+}
+
+// ParseRedactRule parses a rule of the form "resource.field" or
+// "resource.field:regex". Resource names may contain dots, e.g.
+// "sshd.config.content", the field is the part after the last dot.
+func ParseRedactRule(s string) (RedactRule, error) {
+	spec, regex, hasRegex := strings.Cut(s, ":")
+	idx := strings.LastIndex(spec, ".")
+	if idx == -1 || idx == 0 || idx == len(spec)-1 {
+		return RedactRule{}, errors.New("invalid redaction rule '" + s + "', expected resource.field or resource.field:regex")
+	}
+	resource, field := spec[:idx], spec[idx+1:]
+
+	if _, err := path.Match(resource, ""); err != nil {
+		return RedactRule{}, errors.New("invalid resource pattern in redaction rule '" + s + "'")
+	}
+	if _, err := path.Match(field, ""); err != nil {
+		return RedactRule{}, errors.New("invalid field pattern in redaction rule '" + s + "'")
+	}
+
+	res := RedactRule{Resource: resource, Field: field}
+	if hasRegex {
+		re, err := regexp.Compile(regex)
+		if err != nil {
+			return RedactRule{}, errors.New("invalid regex in redaction rule '" + s + "': " + err.Error())
+		}
+		res.Regex = re
+	}
+	return res, nil
+}
+
+func (r RedactRule) matches(resource string, field string) bool {
+	if ok, _ := path.Match(r.Resource, resource); !ok {
+		return false
+	}
+	ok, _ := path.Match(r.Field, field)
+	return ok
+}
+
+// redact applies the rule to a field and returns the redacted data.
+// It returns nil if nothing was redacted.
+func (r RedactRule) redact(data *llx.RawData) *llx.RawData {
+	if data == nil || data.Value == nil {
+		return nil
+	}
+
+	value, changed := redactValue(data.Value, r.Regex)
+	if !changed {
+		return nil
+	}
+	return &llx.RawData{Type: data.Type, Value: value, Error: data.Error}
+}
+
+// redactValue scrubs strings in a value. Without a regex, all strings are
+// replaced and numbers, bools and times are set to their zero value, so that
+// they keep their type. Resources are left untouched, they are redacted on
+// their own.
+func redactValue(v interface{}, re *regexp.Regexp) (interface{}, bool) {
+	switch x := v.(type) {
+	case string:
+		if re == nil {
+			return RedactedValue, x != RedactedValue
+		}
+		res := re.ReplaceAllString(x, RedactedValue)
+		return res, res != x
+
+	case []interface{}:
+		res := make([]interface{}, len(x))
+		changed := false
+		for i := range x {
+			var c bool
+			res[i], c = redactValue(x[i], re)
+			changed = changed || c
+		}
+		return res, changed
+
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(x))
+		changed := false
+		for k := range x {
+			var c bool
+			res[k], c = redactValue(x[k], re)
+			changed = changed || c
+		}
+		return res, changed
+
+	case bool:
+		if re == nil {
+			return false, x
+		}
+		return v, false
+
+	case int64:
+		if re == nil {
+			return int64(0), x != 0
+		}
+		return v, false
+
+	case float64:
+		if re == nil {
+			return float64(0), x != 0
+		}
+		return v, false
+
+	case *time.Time:
+		if re == nil && x != nil {
+			return &time.Time{}, !x.IsZero()
+		}
+		return v, false
+
+	default:
+		return v, false
+	}
+}
+
+// redactAssets returns a copy of the assets with all rules applied. Every
+// resource lists the fields that were redacted.
+func redactAssets(assets []assetRecording, rules []RedactRule) []assetRecording {
+	res := make([]assetRecording, len(assets))
+	for i := range assets {
+		res[i] = assets[i]
+		res[i].Resources = make([]resourceRecording, len(assets[i].Resources))
+
+		for j := range assets[i].Resources {
+			resource := assets[i].Resources[j]
+			fields := make(map[string]*llx.RawData, len(resource.Fields))
+			redacted := map[string]struct{}{}
+			for _, field := range resource.Redacted {
+				redacted[field] = struct{}{}
+			}
+
+			for field, data := range resource.Fields {
+				for _, rule := range rules {
+					if !rule.matches(resource.Resource, field) {
+						continue
+					}
+					if x := rule.redact(data); x != nil {
+						data = x
+						redacted[field] = struct{}{}
+					}
+				}
+				fields[field] = data
+			}
+
+			resource.Fields = fields
+			resource.Redacted = nil
+			for field := range redacted {
+				resource.Redacted = append(resource.Redacted, field)
+			}
+			sort.Strings(resource.Redacted)
+			res[i].Resources[j] = resource
+		}
+	}
+	return res
+}
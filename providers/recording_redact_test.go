@@ -0,0 +1,175 @@
+package providers
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/types"
+)
+
+func TestParseRedactRule(t *testing.T) {
+	rule, err := ParseRedactRule("file.content")
+	require.NoError(t, err)
+	assert.Equal(t, "file", rule.Resource)
+	assert.Equal(t, "content", rule.Field)
+	assert.Nil(t, rule.Regex)
+
+	rule, err = ParseRedactRule("sshd.config.content")
+	require.NoError(t, err)
+	assert.Equal(t, "sshd.config", rule.Resource)
+	assert.Equal(t, "content", rule.Field)
+	assert.True(t, rule.matches("sshd.config", "content"))
+
+	rule, err = ParseRedactRule("windows.firewall.rule.*")
+	require.NoError(t, err)
+	assert.True(t, rule.matches("windows.firewall.rule", "name"))
+	assert.False(t, rule.matches("windows.firewall", "rules"))
+
+	rule, err = ParseRedactRule("*.*:token=\\w+")
+	require.NoError(t, err)
+	assert.True(t, rule.matches("command", "stdout"))
+	require.NotNil(t, rule.Regex)
+	assert.Equal(t, "token=\\w+", rule.Regex.String())
+
+	_, err = ParseRedactRule("file")
+	assert.Error(t, err)
+	_, err = ParseRedactRule("file.")
+	assert.Error(t, err)
+	_, err = ParseRedactRule("file.content:(")
+	assert.Error(t, err)
+}
+
+func TestRecording_Redact(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording.json")
+	rec := &recording{
+		Path: path,
+		Assets: []assetRecording{{
+			Asset: assetInfo{ID: "1", PlatformIDs: []string{"//platformid/host"}},
+			Resources: []resourceRecording{
+				{Resource: "file", ID: "/etc/shadow", Fields: map[string]*llx.RawData{
+					"path":    llx.StringData("/etc/shadow"),
+					"content": llx.StringData("root:secret"),
+				}},
+				{Resource: "command", ID: "env", Fields: map[string]*llx.RawData{
+					"stdout":   llx.StringData("HOME=/root\nAPI_KEY=abc123\n"),
+					"exitcode": llx.IntData(0),
+				}},
+				{Resource: "os", ID: "", Fields: map[string]*llx.RawData{
+					"env": llx.MapData(map[string]interface{}{"HOME": "/root"}, types.String),
+				}},
+			},
+		}},
+		redactRules: []RedactRule{
+			{Resource: "file", Field: "content"},
+			{Resource: "os", Field: "env"},
+			DefaultRedactRules[len(DefaultRedactRules)-1],
+		},
+	}
+	rec.refreshCache()
+	require.NoError(t, rec.Save())
+
+	// the data in the recording itself is not redacted
+	assert.Equal(t, "root:secret", rec.Assets[0].resources["file\x00/etc/shadow"].Fields["content"].Value)
+
+	loaded, err := LoadRecordingFile(path)
+	require.NoError(t, err)
+	resources := loaded.Assets[0].Resources
+	require.Len(t, resources, 3)
+
+	command := resources[0]
+	assert.Equal(t, "command", command.Resource)
+	assert.Equal(t, "HOME=/root\n[REDACTED]\n", command.Fields["stdout"].Value)
+	assert.Equal(t, int64(0), command.Fields["exitcode"].Value)
+	assert.Equal(t, []string{"stdout"}, command.Redacted)
+
+	file := resources[1]
+	assert.Equal(t, RedactedValue, file.Fields["content"].Value)
+	assert.Equal(t, "/etc/shadow", file.Fields["path"].Value)
+	assert.Equal(t, []string{"content"}, file.Redacted)
+
+	osResource := resources[2]
+	assert.Equal(t, map[string]interface{}{"HOME": RedactedValue}, osResource.Fields["env"].Value)
+	assert.Equal(t, []string{"env"}, osResource.Redacted)
+}
+
+func TestRecording_Compact(t *testing.T) {
+	assets := []assetRecording{
+		{
+			Asset:       assetInfo{ID: "1", PlatformIDs: []string{"//platformid/host"}},
+			Connections: []connectionRecording{{Url: "local://"}},
+			Resources: []resourceRecording{
+				{Resource: "os", ID: "", Fields: map[string]*llx.RawData{
+					"hostname": llx.StringData("host"),
+				}},
+				{Resource: "unused", ID: "1"},
+				// created resources are recorded without fields
+				{Resource: "file", ID: "/x", Fields: map[string]*llx.RawData{}},
+				{Resource: "user", ID: "bob", Fields: map[string]*llx.RawData{}},
+				{Resource: "users", ID: "", Fields: map[string]*llx.RawData{
+					"list": llx.ArrayData([]interface{}{&llx.MockResource{Name: "user", ID: "bob"}}, types.Resource("user")),
+				}},
+			},
+		},
+		{
+			Asset:       assetInfo{ID: "2", PlatformIDs: []string{"//platformid/other", "//platformid/host"}},
+			Connections: []connectionRecording{{Url: "ssh://host"}},
+			Resources: []resourceRecording{
+				{Resource: "os", ID: "", Fields: map[string]*llx.RawData{
+					"hostname": llx.StringData("other"),
+					"uptime":   llx.IntData(1),
+				}},
+			},
+		},
+	}
+
+	res := compactAssets(assets)
+	require.Len(t, res, 1)
+	assert.Equal(t, []string{"//platformid/host", "//platformid/other"}, res[0].Asset.PlatformIDs)
+	assert.Len(t, res[0].Connections, 2)
+
+	names := []string{}
+	for _, r := range res[0].Resources {
+		names = append(names, r.Resource)
+	}
+	assert.Equal(t, []string{"file", "os", "user", "users"}, names)
+
+	// fields of the first asset win, missing fields are added
+	assert.Equal(t, "host", res[0].Resources[1].Fields["hostname"].Value)
+	assert.Equal(t, int64(1), res[0].Resources[1].Fields["uptime"].Value)
+
+	// the original assets are untouched
+	assert.Len(t, assets[0].Resources[0].Fields, 1)
+	assert.Equal(t, []string{"//platformid/host"}, assets[0].Asset.PlatformIDs)
+}
+
+func TestRedactRule_KeepsTypes(t *testing.T) {
+	rule := RedactRule{Resource: "*", Field: "*"}
+	now := time.Now()
+
+	tests := []struct {
+		data     *llx.RawData
+		expected interface{}
+	}{
+		{llx.StringData("secret"), RedactedValue},
+		{llx.IntData(42), int64(0)},
+		{llx.FloatData(1.5), float64(0)},
+		{llx.BoolTrue, false},
+		{llx.TimeData(now), &time.Time{}},
+		{llx.ArrayData([]interface{}{"a", int64(1)}, types.Any), []interface{}{RedactedValue, int64(0)}},
+	}
+
+	for i := range tests {
+		cur := tests[i]
+		res := rule.redact(cur.data)
+		require.NotNil(t, res, cur.data.Type.Label())
+		assert.Equal(t, cur.data.Type, res.Type)
+		assert.Equal(t, cur.expected, res.Value)
+	}
+
+	// zero values have nothing to redact
+	assert.Nil(t, rule.redact(llx.IntData(0)))
+}
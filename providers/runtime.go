@@ -1,8 +1,11 @@
 package providers
 
 import (
+	"context"
 	"errors"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"go.mondoo.com/cnquery/llx"
@@ -11,6 +14,8 @@ import (
 	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
 	"go.mondoo.com/cnquery/providers-sdk/v1/resources"
 	"go.mondoo.com/cnquery/providers-sdk/v1/upstream"
+	"go.mondoo.com/cnquery/providers-sdk/v1/vault"
+	"go.mondoo.com/cnquery/providers-sdk/v1/vault/credentials_resolver"
 	"go.mondoo.com/cnquery/types"
 	"go.mondoo.com/cnquery/utils/multierr"
 	protobuf "google.golang.org/protobuf/proto"
@@ -23,8 +28,20 @@ type Runtime struct {
 	UpstreamConfig *upstream.UpstreamConfig
 	Recording      Recording
 	AutoUpdate     UpdateProvidersConfig
+	// WatchInterval is the interval in which watched fields are re-resolved.
+	// If it is not set, fields are only updated when providers report changes.
+	WatchInterval time.Duration
+	// FieldTimeout is the time providers get to compute a field. Fields that
+	// take longer return a timeout error. If it is not set, there is no limit.
+	FieldTimeout time.Duration
 
 	features []byte
+	// ctx is the context of all requests to providers, it is cancelled
+	// when the runtime is closed. Both are guarded by ctxLock, since
+	// the poller of watched fields uses them concurrently.
+	ctx     context.Context
+	cancel  context.CancelFunc
+	ctxLock sync.Mutex
 	// coordinator is used to grab providers
 	coordinator *coordinator
 	// providers for with open connections
@@ -32,11 +49,26 @@ type Runtime struct {
 	// schema aggregates all resources executable on this asset
 	schema   extensibleSchema
 	isClosed bool
+	// watchers of resource fields on this runtime
+	watchers    *fieldWatchers
+	pollOnce    sync.Once
+	stopPolling chan struct{}
 }
 
 type ConnectedProvider struct {
 	Instance   *RunningProvider
 	Connection *plugin.ConnectRes
+
+	// connectReq and callbacks re-establish the connection after the
+	// provider's plugin was restarted
+	connectReq *plugin.ConnectReq
+	callbacks  plugin.ProviderCallback
+	// generation of the plugin this connection was established with
+	generation int
+	// resources created or stored in the provider, which are stored again
+	// after the connection was re-established
+	stored map[string]*plugin.ResourceData
+	lock   sync.Mutex
 }
 
 func (c *coordinator) NewRuntime() *Runtime {
@@ -49,9 +81,12 @@ func (c *coordinator) NewRuntime() *Runtime {
 				Resources: map[string]*resources.ResourceInfo{},
 			},
 		},
-		Recording: nullRecording{},
+		Recording:   nullRecording{},
+		watchers:    newFieldWatchers(),
+		stopPolling: make(chan struct{}),
 	}
 	res.schema.runtime = res
+	res.ctx, res.cancel = context.WithCancel(context.Background())
 
 	// TODO: do this dynamically in the future
 	res.schema.loadAllSchemas()
@@ -63,15 +98,62 @@ func (r *Runtime) Close() {
 		return
 	}
 	r.isClosed = true
+	close(r.stopPolling)
+	r.ctxLock.Lock()
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.ctxLock.Unlock()
 
 	if err := r.Recording.Save(); err != nil {
 		log.Error().Err(err).Msg("failed to save recording")
 	}
 
-	r.coordinator.Close(r.Provider.Instance)
+	for _, provider := range r.providers {
+		if provider.Connection != nil {
+			impl, id := provider.Instance.impl(), provider.Connection.Id
+			err := callWithTimeout(shutdownTimeout, func() error {
+				_, err := impl.Disconnect(&plugin.DisconnectReq{Connection: id})
+				return err
+			})
+			// older plugins don't support disconnects, their connections
+			// are closed when they shut down
+			if err != nil && !isUnimplemented(err) {
+				log.Warn().Err(err).Str("provider", provider.Instance.Name).Msg("failed to disconnect")
+			}
+		}
+		r.coordinator.Close(provider.Instance)
+	}
 	r.schema.Close()
 }
 
+// SetContext ties all requests to providers to the given context. Once it
+// is done, e.g. after a timeout or an interrupt, running requests are
+// cancelled, incl. the commands and file reads they started.
+func (r *Runtime) SetContext(ctx context.Context) {
+	r.ctxLock.Lock()
+	defer r.ctxLock.Unlock()
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.ctx, r.cancel = context.WithCancel(ctx)
+}
+
+// requestContext returns the context for a request to a provider, which is
+// limited by the field timeout
+func (r *Runtime) requestContext() (context.Context, context.CancelFunc) {
+	r.ctxLock.Lock()
+	ctx := r.ctx
+	r.ctxLock.Unlock()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if r.FieldTimeout > 0 {
+		return context.WithTimeout(ctx, r.FieldTimeout)
+	}
+	return context.WithCancel(ctx)
+}
+
 func (r *Runtime) DeactivateProviderDiscovery() {
 	r.schema.allLoaded = true
 }
@@ -116,6 +198,7 @@ func (r *Runtime) addProvider(id string) (*ConnectedProvider, error) {
 		}
 	}
 
+	r.coordinator.Retain(running)
 	res := &ConnectedProvider{Instance: running}
 	r.AddConnectedProvider(res)
 
@@ -146,6 +229,10 @@ func (r *Runtime) DetectProvider(asset *inventory.Asset) error {
 			errs.Add(err)
 			continue
 		}
+		if provider == nil {
+			errs.Add(errors.New("cannot find provider for connection type '" + conn.Type + "'"))
+			continue
+		}
 
 		return r.UseProvider(provider.ID)
 	}
@@ -175,32 +262,45 @@ func (r *Runtime) Connect(req *plugin.ConnectReq) error {
 		Spec: &inventory.InventorySpec{
 			Assets: []*inventory.Asset{asset},
 		},
-	}, r))
+	}, r), manager.WithCachedCredsResolver())
 	if err != nil {
 		return multierr.Wrap(err, "failed to resolve inventory for connection")
 	}
 
 	inventoryAsset := manager.GetAssets()[0]
-
 	creds := manager.GetCredsResolver()
-	if creds != nil {
-		inventoryAsset = protobuf.Clone(inventoryAsset).(*inventory.Asset)
+	origReq := req
+
+	// resolve all credentials which reference a secret, refresh bypasses any
+	// cached secrets after the provider rejected the credentials
+	resolve := func(refresh bool) error {
+		if creds == nil {
+			return nil
+		}
+
+		asset := protobuf.Clone(inventoryAsset).(*inventory.Asset)
 		req = &plugin.ConnectReq{
-			Features:     req.Features,
-			Asset:        inventoryAsset,
-			HasRecording: req.HasRecording,
-			Upstream:     req.Upstream,
+			Features:     origReq.Features,
+			Asset:        asset,
+			HasRecording: origReq.HasRecording,
+			Upstream:     origReq.Upstream,
 		}
 
-		for j := range inventoryAsset.Connections {
-			conn := inventoryAsset.Connections[j]
+		for j := range asset.Connections {
+			conn := asset.Connections[j]
 			for k := range conn.Credentials {
 				credential := conn.Credentials[k]
 				if credential.SecretId == "" {
 					continue
 				}
 
-				resolvedCredential, err := creds.GetCredential(credential)
+				var resolvedCredential *vault.Credential
+				var err error
+				if refresher, ok := creds.(credentials_resolver.Refresher); ok && refresh {
+					resolvedCredential, err = refresher.RefreshCredential(credential)
+				} else {
+					resolvedCredential, err = creds.GetCredential(credential)
+				}
 				if err != nil {
 					log.Debug().Str("secret-id", credential.SecretId).Err(err).Msg("could not fetch secret for motor connection")
 					return err
@@ -209,13 +309,16 @@ func (r *Runtime) Connect(req *plugin.ConnectReq) error {
 				conn.Credentials[k] = resolvedCredential
 			}
 		}
+		return nil
 	}
 
 	callbacks := providerCallbacks{
 		runtime: r,
 	}
 
-	r.Provider.Connection, err = r.Provider.Instance.Plugin.Connect(req, &callbacks)
+	err = credentials_resolver.RetryOnAuthError(resolve, func() error {
+		return r.Provider.connect(req, &callbacks)
+	})
 	if err != nil {
 		return err
 	}
@@ -230,10 +333,17 @@ func (r *Runtime) CreateResource(name string, args map[string]*llx.Primitive) (l
 		return nil, err
 	}
 
-	res, err := provider.Instance.Plugin.GetData(&plugin.DataReq{
-		Connection: provider.Connection.Id,
-		Resource:   name,
-		Args:       args,
+	ctx, cancel := r.requestContext()
+	defer cancel()
+	var res *plugin.DataRes
+	err = r.call(provider, func(impl plugin.ProviderPlugin, conn uint32) error {
+		var err error
+		res, err = impl.GetData(ctx, &plugin.DataReq{
+			Connection: conn,
+			Resource:   name,
+			Args:       args,
+		})
+		return err
 	})
 	if err != nil {
 		return nil, err
@@ -244,7 +354,13 @@ func (r *Runtime) CreateResource(name string, args map[string]*llx.Primitive) (l
 	}
 
 	typ := types.Type(res.Data.Type)
-	return &llx.MockResource{Name: typ.ResourceName(), ID: string(res.Data.Value)}, nil
+	resource := &llx.MockResource{Name: typ.ResourceName(), ID: string(res.Data.Value)}
+	provider.rememberCreated(&plugin.ResourceData{
+		Name:   resource.Name,
+		Id:     resource.ID,
+		Fields: PrimitiveArgsToResultArgs(args),
+	})
+	return resource, nil
 }
 
 func (r *Runtime) CreateResourceWithID(name string, id string, args map[string]*llx.Primitive) (llx.Resource, error) {
@@ -255,14 +371,11 @@ func (r *Runtime) CreateResourceWithID(name string, id string, args map[string]*
 
 	args["__id"] = llx.StringPrimitive(id)
 
-	_, err = provider.Instance.Plugin.StoreData(&plugin.StoreReq{
-		Connection: provider.Connection.Id,
-		Resources: []*plugin.ResourceData{{
-			Name:   name,
-			Id:     id,
-			Fields: PrimitiveArgsToResultArgs(args),
-		}},
-	})
+	err = r.storeData(provider, []*plugin.ResourceData{{
+		Name:   name,
+		Id:     id,
+		Fields: PrimitiveArgsToResultArgs(args),
+	}})
 	if err != nil {
 		return nil, err
 	}
@@ -270,25 +383,64 @@ func (r *Runtime) CreateResourceWithID(name string, id string, args map[string]*
 	return &llx.MockResource{Name: name, ID: id}, nil
 }
 
+// Unregister a watcher and all fields it subscribed to. Fields that are no
+// longer watched by anyone are removed from the runtime.
 func (r *Runtime) Unregister(watcherUID string) error {
-	// TODO: we don't unregister just yet...
+	r.watchers.remove(watcherUID)
 	return nil
 }
 
 func fieldUID(resource string, id string, field string) string {
-	return resource + "\x00" + id + "\x00" + field
+	return plugin.FieldUID(resource, id, field)
+}
+
+func parseFieldUID(uid string) (string, string, string, bool) {
+	parts := strings.SplitN(uid, "\x00", 3)
+	if len(parts) != 3 {
+		return "", "", "", false
+	}
+	return parts[0], parts[1], parts[2], true
 }
 
 // WatchAndUpdate a resource field and call the function if it changes with its current value
 func (r *Runtime) WatchAndUpdate(resource llx.Resource, field string, watcherUID string, callback func(res interface{}, err error)) error {
-	raw, err := r.watchAndUpdate(resource.MqlName(), resource.MqlID(), field, watcherUID)
+	name := resource.MqlName()
+	id := resource.MqlID()
+
+	raw, err := r.watchAndUpdate(name, id, field, watcherUID)
 	if raw != nil {
 		callback(raw.Value, raw.Error)
 	}
-	return err
+
+	// Fields that are not ready yet will be pushed by the provider.
+	// Watchers are called once the data arrives.
+	_, isNotReady := err.(resources.NotReadyError)
+	if err != nil && !isNotReady {
+		return err
+	}
+
+	if watcherUID != "" {
+		checksum := ""
+		if raw != nil {
+			checksum = rawDataChecksum(raw)
+		}
+		if pending := r.watchers.add(name, id, field, watcherUID, checksum, callback); pending != nil {
+			r.notifyWatchers(fieldUID(name, id, field), pending)
+		}
+		r.startPolling()
+	} else if isNotReady {
+		return err
+	}
+	return nil
 }
 
 func (r *Runtime) watchAndUpdate(resource string, resourceID string, field string, watcherUID string) (*llx.RawData, error) {
+	return r.fetchField(resource, resourceID, field, true)
+}
+
+// fetchField retrieves a field from its provider. If useCache is set,
+// it will first try to get the data from the recording.
+func (r *Runtime) fetchField(resource string, resourceID string, field string, useCache bool) (*llx.RawData, error) {
 	provider, info, fieldInfo, err := r.lookupFieldProvider(resource, field)
 	if err != nil {
 		return nil, err
@@ -300,32 +452,59 @@ func (r *Runtime) watchAndUpdate(resource string, resourceID string, field strin
 	if info.Provider != fieldInfo.Provider {
 		// technically we don't need to look up the resource provider, since
 		// it had to have been called beforehand to get here
-		_, err := provider.Instance.Plugin.StoreData(&plugin.StoreReq{
-			Connection: provider.Connection.Id,
-			Resources: []*plugin.ResourceData{{
-				Name: resource,
-				Id:   resourceID,
-			}},
-		})
+		err := r.storeData(provider, []*plugin.ResourceData{{
+			Name: resource,
+			Id:   resourceID,
+		}})
 		if err != nil {
 			return nil, multierr.Wrap(err, "failed to create reference resource "+resource+" in provider "+provider.Instance.Name)
 		}
 	}
 
-	if cached, ok := r.Recording.GetData(provider.Connection.Id, resource, resourceID, field); ok {
-		return cached, nil
+	if useCache {
+		if cached, ok := r.Recording.GetData(provider.Connection.Id, resource, resourceID, field); ok {
+			return cached, nil
+		}
 	}
 
-	data, err := provider.Instance.Plugin.GetData(&plugin.DataReq{
-		Connection: provider.Connection.Id,
-		Resource:   resource,
-		ResourceId: resourceID,
-		Field:      field,
+	ctx, cancel := r.requestContext()
+	defer cancel()
+	var data *plugin.DataRes
+	err = r.call(provider, func(impl plugin.ProviderPlugin, conn uint32) error {
+		var err error
+		data, err = impl.GetData(ctx, &plugin.DataReq{
+			Connection: conn,
+			Resource:   resource,
+			ResourceId: resourceID,
+			Field:      field,
+		})
+		return err
 	})
 	if err != nil {
+		// fields that time out or were computed while their provider crashed
+		// are reported as errors of the field, so that the remaining fields
+		// can still be computed
+		if r.FieldTimeout > 0 && ctx.Err() == context.DeadlineExceeded {
+			return &llx.RawData{
+				Type:  types.Type(fieldInfo.Type),
+				Error: errors.New("timed out after " + r.FieldTimeout.String() + " while computing " + resource + "." + field),
+			}, nil
+		}
+		if isProviderCrashed(err) {
+			return &llx.RawData{
+				Type:  types.Type(fieldInfo.Type),
+				Error: errors.New(err.Error() + " while computing " + resource + "." + field),
+			}, nil
+		}
 		return nil, err
 	}
 
+	// providers send an empty response for fields that they will push
+	// asynchronously via Collect
+	if data.Data == nil && data.Error == "" {
+		return nil, resources.NotReadyError{}
+	}
+
 	var raw *llx.RawData
 	if data.Error != "" {
 		raw = &llx.RawData{Error: errors.New(data.Error)}
@@ -337,6 +516,23 @@ func (r *Runtime) watchAndUpdate(resource string, resourceID string, field strin
 	return raw, nil
 }
 
+// storeData stores resources in the provider, e.g. references to resources
+// of other providers. They are stored again if the provider is restarted.
+func (r *Runtime) storeData(provider *ConnectedProvider, resources []*plugin.ResourceData) error {
+	err := r.call(provider, func(impl plugin.ProviderPlugin, conn uint32) error {
+		_, err := impl.StoreData(&plugin.StoreReq{
+			Connection: conn,
+			Resources:  resources,
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	provider.remember(resources)
+	return nil
+}
+
 type providerCallbacks struct {
 	recording *assetRecording
 	runtime   *Runtime
@@ -386,8 +582,39 @@ func (p *providerCallbacks) GetData(req *plugin.DataReq) (*plugin.DataRes, error
 	}, err
 }
 
+// Collect receives data that providers push asynchronously. The ID of the
+// request identifies the resource field (see plugin.FieldUID). The data is
+// added to the recording and sent to all watchers of this field.
+// If no data is provided, the field is re-requested from its provider.
 func (p *providerCallbacks) Collect(req *plugin.DataRes) error {
-	panic("NOT YET IMPLEMENTED")
+	resource, id, field, ok := parseFieldUID(req.Id)
+	if !ok {
+		return errors.New("cannot collect data, invalid field ID '" + req.Id + "'")
+	}
+
+	uid := fieldUID(resource, id, field)
+	if req.Data == nil && req.Error == "" {
+		err := p.runtime.refreshField(uid)
+		if _, ok := err.(resources.NotReadyError); ok {
+			return nil
+		}
+		return err
+	}
+
+	provider, _, _, err := p.runtime.lookupFieldProvider(resource, field)
+	if err != nil {
+		return err
+	}
+
+	var raw *llx.RawData
+	if req.Error != "" {
+		raw = &llx.RawData{Error: errors.New(req.Error)}
+	} else {
+		raw = req.Data.RawData()
+	}
+
+	p.runtime.Recording.AddData(provider.Connection.Id, resource, id, field, raw)
+	p.runtime.notifyWatchers(uid, raw)
 	return nil
 }
 
@@ -418,14 +645,13 @@ func (r *Runtime) SetRecording(recording *recording, providerID string, readOnly
 			runtime:   r,
 		}
 
-		res, err := provider.Instance.Plugin.Connect(&plugin.ConnectReq{
+		err := provider.connect(&plugin.ConnectReq{
 			Asset:        asset,
 			HasRecording: true,
 		}, &callbacks)
 		if err != nil {
 			return multierr.Wrap(err, "failed to set mock connection for recording")
 		}
-		provider.Connection = res
 	}
 
 	if provider.Connection == nil {
@@ -453,16 +679,14 @@ func (r *Runtime) lookupResourceProvider(resource string) (*ConnectedProvider, *
 		return nil, nil, multierr.Wrap(err, "failed to start provider '"+info.Provider+"'")
 	}
 
-	conn, err := res.Instance.Plugin.Connect(&plugin.ConnectReq{
+	err = res.connect(&plugin.ConnectReq{
 		Features: r.features,
 		Asset:    r.Provider.Connection.Asset,
-	}, nil)
+	}, &providerCallbacks{runtime: r})
 	if err != nil {
 		return nil, nil, err
 	}
 
-	res.Connection = conn
-
 	return res, info, nil
 }
 
@@ -484,16 +708,14 @@ func (r *Runtime) lookupFieldProvider(resource string, field string) (*Connected
 		return nil, nil, nil, multierr.Wrap(err, "failed to start provider '"+fieldInfo.Provider+"'")
 	}
 
-	conn, err := res.Instance.Plugin.Connect(&plugin.ConnectReq{
+	err = res.connect(&plugin.ConnectReq{
 		Features: r.features,
 		Asset:    r.Provider.Connection.Asset,
-	}, nil)
+	}, &providerCallbacks{runtime: r})
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
-	res.Connection = conn
-
 	return res, resourceInfo, fieldInfo, nil
 }
 
@@ -0,0 +1,178 @@
+package providers
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/providers-sdk/v1/vault"
+)
+
+func TestCollect(t *testing.T) {
+	p := &testPlugin{notReady: true}
+	r := newTestRuntime(p)
+	callbacks := &providerCallbacks{runtime: r}
+	resource := &llx.MockResource{Name: "test", ID: "1"}
+
+	var values []interface{}
+	var errs []error
+	err := r.WatchAndUpdate(resource, "value", "w1", func(res interface{}, err error) {
+		values = append(values, res)
+		errs = append(errs, err)
+	})
+	require.NoError(t, err)
+	assert.Empty(t, values)
+
+	t.Run("push data to watchers", func(t *testing.T) {
+		err := callbacks.Collect(&plugin.DataRes{
+			Id:   plugin.FieldUID("test", "1", "value"),
+			Data: llx.StringPrimitive("one"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{"one"}, values)
+
+		// the same data doesn't trigger watchers again
+		err = callbacks.Collect(&plugin.DataRes{
+			Id:   plugin.FieldUID("test", "1", "value"),
+			Data: llx.StringPrimitive("one"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{"one"}, values)
+	})
+
+	t.Run("push errors to watchers", func(t *testing.T) {
+		err := callbacks.Collect(&plugin.DataRes{
+			Id:    plugin.FieldUID("test", "1", "value"),
+			Error: "failed to compute",
+		})
+		require.NoError(t, err)
+		require.Len(t, errs, 2)
+		assert.EqualError(t, errs[1], "failed to compute")
+	})
+
+	t.Run("re-request changed fields", func(t *testing.T) {
+		p.notReady = false
+		p.value = "two"
+		err := callbacks.Collect(&plugin.DataRes{
+			Id: plugin.FieldUID("test", "1", "value"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{"one", nil, "two"}, values)
+	})
+
+	t.Run("invalid field ID", func(t *testing.T) {
+		err := callbacks.Collect(&plugin.DataRes{Id: "test"})
+		assert.Error(t, err)
+	})
+}
+
+func TestCollect_BeforeWatch(t *testing.T) {
+	p := &testPlugin{notReady: true}
+	r := newTestRuntime(p)
+	callbacks := &providerCallbacks{runtime: r}
+
+	err := callbacks.Collect(&plugin.DataRes{
+		Id:   plugin.FieldUID("test", "1", "value"),
+		Data: llx.StringPrimitive("early"),
+	})
+	require.NoError(t, err)
+
+	var values []interface{}
+	err = r.WatchAndUpdate(&llx.MockResource{Name: "test", ID: "1"}, "value", "w1", func(res interface{}, err error) {
+		values = append(values, res)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"early"}, values)
+}
+
+func TestFetchField_Timeout(t *testing.T) {
+	p := &testPlugin{value: "slow", delay: time.Second}
+	r := newTestRuntime(p)
+	r.FieldTimeout = 10 * time.Millisecond
+	resource := &llx.MockResource{Name: "test", ID: "1"}
+
+	var errs []error
+	err := r.WatchAndUpdate(resource, "value", "", func(res interface{}, err error) {
+		errs = append(errs, err)
+	})
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	assert.EqualError(t, errs[0], "timed out after 10ms while computing test.value")
+
+	// timeouts are not cached
+	p.delay = 0
+	var values []interface{}
+	err = r.WatchAndUpdate(resource, "value", "", func(res interface{}, err error) {
+		values = append(values, res)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"slow"}, values)
+}
+
+func TestFetchField_Cancel(t *testing.T) {
+	p := &testPlugin{value: "slow", delay: time.Second}
+	r := newTestRuntime(p)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.SetContext(ctx)
+	cancel()
+
+	_, err := r.fetchField("test", "1", "value", false)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestSetContext_WhileFetching(t *testing.T) {
+	r := newTestRuntime(&testPlugin{value: "fast"})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			r.SetContext(context.Background())
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		// requests may be cancelled when their context is replaced
+		_, err := r.fetchField("test", "1", "value", false)
+		if err != nil {
+			assert.Equal(t, context.Canceled, err)
+		}
+	}
+	wg.Wait()
+}
+
+func TestConnect_InlineCredentialsTwice(t *testing.T) {
+	p := &testPlugin{}
+	r := newTestRuntime(p)
+
+	// inline credentials get a new secret id on every connect
+	for i := 0; i < 2; i++ {
+		require.NoError(t, r.Connect(&plugin.ConnectReq{
+			Asset: &inventory.Asset{
+				Name: "test",
+				Connections: []*inventory.Config{{
+					Type: "test",
+					Credentials: []*vault.Credential{{
+						Type:   vault.CredentialType_password,
+						User:   "admin",
+						Secret: []byte("secret"),
+					}},
+				}},
+			},
+		}))
+	}
+
+	require.Len(t, p.connects, 2)
+	for _, req := range p.connects {
+		cred := req.Asset.Connections[0].Credentials[0]
+		assert.Equal(t, "admin", cred.User)
+		assert.Equal(t, []byte("secret"), cred.Secret)
+	}
+}
@@ -0,0 +1,292 @@
+package providers
+
+import (
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/checksums"
+	"go.mondoo.com/cnquery/llx"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxPendingFields limits the data that is kept for fields that were pushed
+// before anyone watched them. The oldest data is dropped first.
+const maxPendingFields = 1000
+
+// fieldCallback is called whenever a watched field changes its value
+type fieldCallback func(res interface{}, err error)
+
+// watchedField is one resource field that is watched by one or more watchers
+type watchedField struct {
+	resource string
+	id       string
+	field    string
+	// checksum of the last value we sent to watchers
+	checksum string
+	// callbacks by watcher UID
+	callbacks map[string]fieldCallback
+}
+
+// fieldWatchers keeps track of all fields that are watched on a runtime.
+// Watchers are registered by their watcher UID, which is handed to us by
+// the executor (see llx.Runtime). Every watched field is identified
+// via its fieldUID.
+type fieldWatchers struct {
+	lock sync.Mutex
+	// fields by fieldUID
+	fields map[string]*watchedField
+	// fieldUIDs by watcher UID
+	watchers map[string]map[string]struct{}
+	// data that was pushed for fields before anyone watched them
+	pending map[string]pendingData
+	// fieldUIDs of pending data in the order they arrived, entries may be
+	// outdated if the data was consumed or replaced since
+	pendingOrder []pendingRef
+	pendingSeq   uint64
+}
+
+type pendingData struct {
+	raw *llx.RawData
+	seq uint64
+}
+
+type pendingRef struct {
+	uid string
+	seq uint64
+}
+
+func newFieldWatchers() *fieldWatchers {
+	return &fieldWatchers{
+		fields:   map[string]*watchedField{},
+		watchers: map[string]map[string]struct{}{},
+		pending:  map[string]pendingData{},
+	}
+}
+
+// add a watcher for a given field. The checksum is that of the value
+// the watcher has already received. If data was pushed for this field
+// before it was watched, it is returned.
+func (w *fieldWatchers) add(resource string, id string, field string, watcherUID string, checksum string, callback fieldCallback) *llx.RawData {
+	uid := fieldUID(resource, id, field)
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	wf, ok := w.fields[uid]
+	if !ok {
+		wf = &watchedField{
+			resource:  resource,
+			id:        id,
+			field:     field,
+			checksum:  checksum,
+			callbacks: map[string]fieldCallback{},
+		}
+		w.fields[uid] = wf
+	}
+	wf.callbacks[watcherUID] = callback
+
+	fields, ok := w.watchers[watcherUID]
+	if !ok {
+		fields = map[string]struct{}{}
+		w.watchers[watcherUID] = fields
+	}
+	fields[uid] = struct{}{}
+
+	pending, ok := w.pending[uid]
+	if !ok {
+		return nil
+	}
+	delete(w.pending, uid)
+	return pending.raw
+}
+
+// remove a watcher and all its subscriptions. Fields that have no more
+// watchers are dropped entirely, incl. data that was pushed for them.
+// Once no watchers are left, all pending data is dropped, since the code
+// that requested it is done.
+func (w *fieldWatchers) remove(watcherUID string) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	fields, ok := w.watchers[watcherUID]
+	if ok {
+		delete(w.watchers, watcherUID)
+	}
+
+	for uid := range fields {
+		wf, ok := w.fields[uid]
+		if !ok {
+			continue
+		}
+		delete(wf.callbacks, watcherUID)
+		if len(wf.callbacks) == 0 {
+			delete(w.fields, uid)
+			delete(w.pending, uid)
+		}
+	}
+
+	if len(w.watchers) == 0 {
+		w.pending = map[string]pendingData{}
+		w.pendingOrder = nil
+	}
+}
+
+// get a copy of the currently watched field
+func (w *fieldWatchers) get(uid string) (watchedField, bool) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	wf, ok := w.fields[uid]
+	if !ok {
+		return watchedField{}, false
+	}
+	return *wf, true
+}
+
+// list all fieldUIDs that are currently watched
+func (w *fieldWatchers) list() []string {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	res := make([]string, 0, len(w.fields))
+	for uid := range w.fields {
+		res = append(res, uid)
+	}
+	return res
+}
+
+// update the data of a field and return all callbacks that need to be
+// informed. If nothing changed, no callbacks are returned. Data for fields
+// that aren't watched is kept until the first watcher is added.
+func (w *fieldWatchers) update(uid string, checksum string, raw *llx.RawData) []fieldCallback {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	wf, ok := w.fields[uid]
+	if !ok {
+		w.addPending(uid, raw)
+		return nil
+	}
+	if wf.checksum == checksum {
+		return nil
+	}
+	wf.checksum = checksum
+
+	res := make([]fieldCallback, 0, len(wf.callbacks))
+	for _, cb := range wf.callbacks {
+		res = append(res, cb)
+	}
+	return res
+}
+
+// addPending keeps data for a field that isn't watched yet. If there is
+// too much pending data, the oldest is dropped.
+func (w *fieldWatchers) addPending(uid string, raw *llx.RawData) {
+	w.pendingSeq++
+	w.pending[uid] = pendingData{raw: raw, seq: w.pendingSeq}
+	w.pendingOrder = append(w.pendingOrder, pendingRef{uid: uid, seq: w.pendingSeq})
+
+	for len(w.pending) > maxPendingFields {
+		ref := w.pendingOrder[0]
+		w.pendingOrder = w.pendingOrder[1:]
+		if cur, ok := w.pending[ref.uid]; ok && cur.seq == ref.seq {
+			delete(w.pending, ref.uid)
+		}
+	}
+
+	// drop outdated references, so that the order doesn't grow unbounded
+	if len(w.pendingOrder) > 2*maxPendingFields {
+		order := make([]pendingRef, 0, len(w.pending))
+		for _, ref := range w.pendingOrder {
+			if cur, ok := w.pending[ref.uid]; ok && cur.seq == ref.seq {
+				order = append(order, ref)
+			}
+		}
+		w.pendingOrder = order
+	}
+}
+
+func (w *fieldWatchers) isEmpty() bool {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return len(w.fields) == 0
+}
+
+// size returns the number of watched fields, watchers and fields with
+// pending data
+func (w *fieldWatchers) size() (int, int, int) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return len(w.fields), len(w.watchers), len(w.pending)
+}
+
+// rawDataChecksum computes a checksum over the value and error of a field.
+// It is used to determine if watchers need to be informed about an update.
+func rawDataChecksum(raw *llx.RawData) string {
+	res := raw.Result()
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(res)
+	if err != nil {
+		// we can't marshal this value, so we can't compare it either;
+		// use the error so that watchers at least learn about it once
+		return checksums.New.Add(err.Error()).String()
+	}
+	return checksums.New.Add(string(data)).String()
+}
+
+// refreshField re-resolves a watched field from its provider and informs all
+// watchers if its value changed.
+func (r *Runtime) refreshField(uid string) error {
+	wf, ok := r.watchers.get(uid)
+	if !ok {
+		return nil
+	}
+
+	raw, err := r.fetchField(wf.resource, wf.id, wf.field, false)
+	if raw == nil {
+		return err
+	}
+
+	r.notifyWatchers(uid, raw)
+	return err
+}
+
+// notifyWatchers sends new data of a field to all its watchers, if the
+// data changed since they last received it.
+func (r *Runtime) notifyWatchers(uid string, raw *llx.RawData) {
+	callbacks := r.watchers.update(uid, rawDataChecksum(raw), raw)
+	for i := range callbacks {
+		callbacks[i](raw.Value, raw.Error)
+	}
+}
+
+// startPolling re-resolves all watched fields in the configured interval.
+// It is only started if a WatchInterval is set and stops once the
+// runtime is closed.
+func (r *Runtime) startPolling() {
+	if r.WatchInterval <= 0 {
+		return
+	}
+
+	r.pollOnce.Do(func() {
+		ticker := time.NewTicker(r.WatchInterval)
+		go func() {
+			defer ticker.Stop()
+			for {
+				select {
+				case <-r.stopPolling:
+					return
+				case <-ticker.C:
+					if r.watchers.isEmpty() {
+						continue
+					}
+					for _, uid := range r.watchers.list() {
+						if err := r.refreshField(uid); err != nil {
+							log.Debug().Err(err).Msg("failed to refresh watched field")
+						}
+					}
+				}
+			}
+		}()
+	})
+}
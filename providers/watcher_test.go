@@ -0,0 +1,280 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/providers-sdk/v1/resources"
+	"go.mondoo.com/cnquery/types"
+)
+
+// testPlugin is a minimal provider, which returns the current value
+// for any field that is requested
+type testPlugin struct {
+	lock     sync.Mutex
+	value    string
+	notReady bool
+	calls    int
+	// connections that were disconnected
+	disconnected []uint32
+	// if set, heartbeats block until it is closed
+	hung chan struct{}
+	// time it takes to compute a field
+	delay time.Duration
+	// if set, requests for fields fail with this error
+	err error
+	// connect requests and stored resources it received
+	connects []*plugin.ConnectReq
+	stored   []*plugin.ResourceData
+	// if set, only fields of resources that were created or stored in
+	// this plugin can be requested, like in real providers
+	resources map[string]struct{}
+}
+
+func (t *testPlugin) set(value string) {
+	t.lock.Lock()
+	t.value = value
+	t.lock.Unlock()
+}
+
+func (t *testPlugin) ParseCLI(req *plugin.ParseCLIReq) (*plugin.ParseCLIRes, error) {
+	return &plugin.ParseCLIRes{}, nil
+}
+
+func (t *testPlugin) Connect(req *plugin.ConnectReq, callback plugin.ProviderCallback) (*plugin.ConnectRes, error) {
+	t.lock.Lock()
+	t.connects = append(t.connects, req)
+	t.lock.Unlock()
+	return &plugin.ConnectRes{Id: 1, Asset: req.Asset}, nil
+}
+
+func (t *testPlugin) GetData(ctx context.Context, req *plugin.DataReq) (*plugin.DataRes, error) {
+	if t.delay > 0 {
+		select {
+		case <-time.After(t.delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.calls++
+	if t.err != nil {
+		return nil, t.err
+	}
+	if t.notReady {
+		return &plugin.DataRes{}, nil
+	}
+	if t.resources != nil {
+		if req.Field == "" {
+			id := string(req.Args["id"].Value)
+			t.resources[req.Resource+"\x00"+id] = struct{}{}
+			resource := &llx.MockResource{Name: req.Resource, ID: id}
+			return &plugin.DataRes{Data: llx.ResourceData(resource, req.Resource).Result().Data}, nil
+		}
+		if _, ok := t.resources[req.Resource+"\x00"+req.ResourceId]; !ok {
+			return nil, errors.New("resource '" + req.Resource + "' (id: " + req.ResourceId + ") doesn't exist")
+		}
+	}
+	return &plugin.DataRes{Data: llx.StringPrimitive(t.value)}, nil
+}
+
+func (t *testPlugin) StoreData(req *plugin.StoreReq) (*plugin.StoreRes, error) {
+	t.lock.Lock()
+	t.stored = append(t.stored, req.Resources...)
+	if t.resources != nil {
+		for _, resource := range req.Resources {
+			t.resources[resource.Name+"\x00"+resource.Id] = struct{}{}
+		}
+	}
+	t.lock.Unlock()
+	return &plugin.StoreRes{}, nil
+}
+
+func (t *testPlugin) Disconnect(req *plugin.DisconnectReq) (*plugin.DisconnectRes, error) {
+	t.lock.Lock()
+	t.disconnected = append(t.disconnected, req.Connection)
+	t.lock.Unlock()
+	return &plugin.DisconnectRes{}, nil
+}
+
+func (t *testPlugin) Shutdown(req *plugin.ShutdownReq) (*plugin.ShutdownRes, error) {
+	return &plugin.ShutdownRes{}, nil
+}
+
+func (t *testPlugin) Heartbeat(req *plugin.HeartbeatReq) (*plugin.HeartbeatRes, error) {
+	if t.hung != nil {
+		<-t.hung
+	}
+	return &plugin.HeartbeatRes{}, nil
+}
+
+func newTestRuntime(p plugin.ProviderPlugin) *Runtime {
+	provider := &ConnectedProvider{
+		Instance: &RunningProvider{
+			Name:   "test",
+			ID:     "test",
+			Plugin: p,
+			Schema: &resources.Schema{
+				Resources: map[string]*resources.ResourceInfo{
+					"test": {
+						Id:       "test",
+						Provider: "test",
+						Fields: map[string]*resources.Field{
+							"value": {Name: "value", Type: string(types.String), Provider: "test"},
+						},
+					},
+				},
+			},
+		},
+		Connection: &plugin.ConnectRes{Id: 1},
+	}
+
+	r := &Runtime{
+		coordinator: &coordinator{},
+		providers:   map[string]*ConnectedProvider{},
+		schema: extensibleSchema{
+			loaded:    map[string]struct{}{},
+			allLoaded: true,
+			Schema: resources.Schema{
+				Resources: map[string]*resources.ResourceInfo{},
+			},
+		},
+		Recording:   nullRecording{},
+		watchers:    newFieldWatchers(),
+		stopPolling: make(chan struct{}),
+	}
+	r.schema.runtime = r
+	r.AddConnectedProvider(provider)
+	r.Provider = provider
+	return r
+}
+
+func TestWatchAndUpdate(t *testing.T) {
+	p := &testPlugin{value: "one"}
+	r := newTestRuntime(p)
+	resource := &llx.MockResource{Name: "test", ID: "1"}
+	uid := fieldUID("test", "1", "value")
+
+	var values []interface{}
+	err := r.WatchAndUpdate(resource, "value", "w1", func(res interface{}, err error) {
+		values = append(values, res)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"one"}, values)
+
+	t.Run("no callback without changes", func(t *testing.T) {
+		require.NoError(t, r.refreshField(uid))
+		assert.Equal(t, []interface{}{"one"}, values)
+	})
+
+	t.Run("callback on changes", func(t *testing.T) {
+		p.set("two")
+		require.NoError(t, r.refreshField(uid))
+		assert.Equal(t, []interface{}{"one", "two"}, values)
+	})
+
+	t.Run("unregister removes the field", func(t *testing.T) {
+		require.NoError(t, r.Unregister("w1"))
+		assert.True(t, r.watchers.isEmpty())
+
+		p.set("three")
+		calls := p.calls
+		require.NoError(t, r.refreshField(uid))
+		assert.Equal(t, []interface{}{"one", "two"}, values)
+		assert.Equal(t, calls, p.calls)
+	})
+}
+
+func TestWatchAndUpdate_SharedField(t *testing.T) {
+	p := &testPlugin{value: "one"}
+	r := newTestRuntime(p)
+	resource := &llx.MockResource{Name: "test", ID: "1"}
+
+	var w1, w2 int
+	require.NoError(t, r.WatchAndUpdate(resource, "value", "w1", func(res interface{}, err error) { w1++ }))
+	require.NoError(t, r.WatchAndUpdate(resource, "value", "w2", func(res interface{}, err error) { w2++ }))
+
+	require.NoError(t, r.Unregister("w1"))
+	assert.False(t, r.watchers.isEmpty())
+
+	p.set("two")
+	require.NoError(t, r.refreshField(fieldUID("test", "1", "value")))
+	assert.Equal(t, 1, w1)
+	assert.Equal(t, 2, w2)
+}
+
+func TestWatchAndUpdate_Polling(t *testing.T) {
+	p := &testPlugin{value: "one"}
+	r := newTestRuntime(p)
+	r.WatchInterval = 10 * time.Millisecond
+	defer close(r.stopPolling)
+
+	updates := make(chan interface{}, 10)
+	err := r.WatchAndUpdate(&llx.MockResource{Name: "test", ID: "1"}, "value", "w1", func(res interface{}, err error) {
+		updates <- res
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "one", <-updates)
+
+	p.set("two")
+	select {
+	case res := <-updates:
+		assert.Equal(t, "two", res)
+	case <-time.After(time.Second):
+		t.Fatal("watched field was not refreshed")
+	}
+}
+
+func TestUnregister_FreesState(t *testing.T) {
+	p := &testPlugin{value: "one"}
+	r := newTestRuntime(p)
+	callbacks := &providerCallbacks{runtime: r}
+
+	err := r.WatchAndUpdate(&llx.MockResource{Name: "test", ID: "1"}, "value", "w1", func(res interface{}, err error) {})
+	require.NoError(t, err)
+
+	// data pushed for a field that nobody watches yet
+	err = callbacks.Collect(&plugin.DataRes{
+		Id:   plugin.FieldUID("test", "2", "value"),
+		Data: llx.StringPrimitive("two"),
+	})
+	require.NoError(t, err)
+
+	fields, watchers, pending := r.watchers.size()
+	assert.Equal(t, 1, fields)
+	assert.Equal(t, 1, watchers)
+	assert.Equal(t, 1, pending)
+
+	require.NoError(t, r.Unregister("w1"))
+	fields, watchers, pending = r.watchers.size()
+	assert.Equal(t, 0, fields)
+	assert.Equal(t, 0, watchers)
+	assert.Equal(t, 0, pending)
+}
+
+func TestFieldWatchers_PendingIsBounded(t *testing.T) {
+	w := newFieldWatchers()
+	for i := 0; i < 3*maxPendingFields; i++ {
+		w.update(fieldUID("test", strconv.Itoa(i), "value"), "", llx.IntData(int64(i)))
+	}
+
+	_, _, pending := w.size()
+	assert.Equal(t, maxPendingFields, pending)
+	assert.LessOrEqual(t, len(w.pendingOrder), 2*maxPendingFields)
+
+	// the oldest data was dropped
+	assert.Nil(t, w.add("test", "0", "value", "w1", "", func(res interface{}, err error) {}))
+	raw := w.add("test", strconv.Itoa(3*maxPendingFields-1), "value", "w1", "", func(res interface{}, err error) {})
+	require.NotNil(t, raw)
+	assert.Equal(t, int64(3*maxPendingFields-1), raw.Value)
+}
@@ -312,3 +312,62 @@ var Equal = map[Type]func(interface{}, interface{}) bool{
 		return left.(int32) == right.(int32)
 	},
 }
+
+// Compare provides a set of functions for a range of types to order 2 values
+// of that type. They return -1 if left < right, 0 if both are equal, and 1
+// if left > right.
+var Compare = map[Type]func(interface{}, interface{}) int{
+	Bool: func(left, right interface{}) int {
+		l := left.(bool)
+		r := right.(bool)
+		switch {
+		case l == r:
+			return 0
+		case !l:
+			return -1
+		default:
+			return 1
+		}
+	},
+	Int: func(left, right interface{}) int {
+		return compareOrdered(left.(int64), right.(int64))
+	},
+	Float: func(left, right interface{}) int {
+		return compareOrdered(left.(float64), right.(float64))
+	},
+	String: func(left, right interface{}) int {
+		return compareOrdered(left.(string), right.(string))
+	},
+	Time: func(left, right interface{}) int {
+		l := left.(*time.Time)
+		r := right.(*time.Time)
+		switch {
+		case l == nil && r == nil:
+			return 0
+		case l == nil:
+			return -1
+		case r == nil:
+			return 1
+		case l.Before(*r):
+			return -1
+		case l.After(*r):
+			return 1
+		default:
+			return 0
+		}
+	},
+	Score: func(left, right interface{}) int {
+		return compareOrdered(left.(int32), right.(int32))
+	},
+}
+
+func compareOrdered[T int32 | int64 | float64 | string](left, right T) int {
+	switch {
+	case left < right:
+		return -1
+	case left > right:
+		return 1
+	default:
+		return 0
+	}
+}